@@ -231,6 +231,7 @@ module "nomad" {
   orchestrator_proxy_port     = var.orchestrator_proxy_port
   fc_env_pipeline_bucket_name = module.init.fc_env_pipeline_bucket_name
   envd_timeout                = var.envd_timeout
+  team_network_secret         = random_password.team_network_secret.result
 
   # Template manager
   builder_node_pool               = var.build_node_pool
@@ -266,6 +266,10 @@ variable "allow_sandbox_internet" {
   type = bool
 }
 
+variable "team_network_secret" {
+  type = string
+}
+
 # Template manager
 variable "template_manager_port" {
   type = number
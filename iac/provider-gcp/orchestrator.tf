@@ -0,0 +1,16 @@
+resource "random_password" "team_network_secret" {
+  length  = 32
+  special = false
+}
+
+resource "google_secret_manager_secret" "team_network_secret" {
+  secret_id = "${var.prefix}team-network-secret"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "team_network_secret" {
+  secret      = google_secret_manager_secret.team_network_secret.id
+  secret_data = random_password.team_network_secret.result
+}
@@ -18,110 +18,163 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w92W7cOLa/Qujeh2lAsR0n07htYB4cJ+nJdJw2Unb6AmkjoKVTVRxLlJqkvEzgfx9w",
-	"kyiJlFTl8pLET4lLFJez8ez6GiVFXhYUqODR3teoxAznIICpv3CSAOfHxTnQd6/lD4RGe1GJxTKKI4pz",
-	"iPY6Y+KIwV8VYZBGe4JVEEc8WUKO5cviupQvcMEIXUQ3N3GES/IbXIento9Xm/WsIlkanNQ+XW1OWqQQ",
-	"nNI8XG1Gjml6VlwFJ22erzavAJwHJzUPV50xLzMsYGDWesAqM9/IwbwsKAdFbS93duQ/SUEFUKHorywz",
-	"kmBBCrr9b15Q+Vsz3/8ymEd70f9sNyS8rZ/y7TeMFUyvkQJPGCnlJNFe9AqnSG4RuIhu4ujlzvO7X3O/",
-	"EkugwsyKQI+Ti7+4+8XfFuyMpClQveLLu1/xQyHQvKhoqlf85e5XPCjoPCOJwujf74OKZsAugFlM3lgq",
-	"V2S8/8fsIywIF+xaSVRWlMAE0TSOL/m+EphSsKXylw6p/DFDegD6Da7Ru9doXjD05uAjwi0iiuIuO8Vy",
-	"brmwPmB/Wv0MXS6BARJLULMys1NEOMqKBAtIA1PPIGEg6s3719CD3BNM377+oTvr8XUJqJg3G+1NBLTK",
-	"o73Pco/RaeyRX41E+qyfxl00eA/oArSZtzj7N2hCeyUvkvfF4g31YjqDC8jGCOx9sXivxt3EUQ6c44UH",
-	"BO+LBTIPkSVrD/y4gLL/8kxAiQhVCFdXHypZobDDQMrsFIlCPcyKBQJ1FB9uSA5c4NyzwLF9JLHUnWhe",
-	"sByLaC9KsYBncpZoFEP1Ug1IYgPNUwv2mcCi4h8BG3bugF4jxfyVwhxXmYj2Pp/GHsiCHtkFB1crIKaX",
-	"iCMiIOdj6GyTRE3TEWYMXw/i+NDg95KIZX/9GCUVY0BFdo0YlAUThC5QQTPNX0oMmTdWpAyxxALNMcl8",
-	"fN/BjN28xMLB0clBUWn52pHFRycoKRhwtTV1FK3JuORAqHixKxFMKMkl+z6vFydUwALU/XjAQKJkv9Ew",
-	"+7hOzBgxQplaTUVCzoLUS1p6TKHQOCIeUf0ulWJoToBZynfXcKeuKuKVqjnm52Mk1axyiPk5oYvXIDDJ",
-	"uHxfq1/dfX3AOQR21OdrC9QO5JaA5lWWXSMD3pGJOoSiTqs2Z1cwZ40ddJ02CD4GnO8fvTO3ynr43T96",
-	"h87henXUmgVeqbVxlv0+j/Y+D+NE7veESxo9jSNaZRk+y0Dru5Npxex3Cpmc+27bj/gSXeCsgv6EvQky",
-	"zMUJB8++3mMukIQMEkvCayBeYo4qrkSCF4jtMz8IZQeP66NFPdCQoCHMNiW+Jvz8EAQjCe/TYAoXJPHs",
-	"57X6HVlK7wJhTjLg11xAfuxVbd7Wz5F8F/0NthZbMYIr8TJGV3P+k1dmSKl7VBCf6D2Uz1ApH1owpUSd",
-	"2cP4AmevroU9YIuv5DPES5yA1BzO1CiXTgkVP7+MfBJbEk1gVkmA60zavYSa88cWMT1QuxtpndWiekb+",
-	"A4evPBgl/Bxx8h/oXl5yz4fk1eAdtuODyBt68Qkbb0qaErkOzo465OVu4Q29IKygOVCBLjAjks98d2mf",
-	"7N/Qi/QTMO61AMwDSxdAL1LEKkqlImHUw+DccaQNob5wLlIPXavBSD3zgKsPoqBSpFcd43CzkKudvGVF",
-	"/i7HC3ANsZTIuXNCsdBnyXFZygm1WRYSU645F0eLpAwN/PXgyBnI6pUDo4ECw1n9xk1sYXv9wXhV5Klv",
-	"4qigMOFOcrd5Ew+PdXc6Ora7Twlfd4IeUXBgkiv3k0Sy6r+4jxpnegwyg9C/Zr9/UDT+68HRPZiKEotT",
-	"TUXPcXzWYBdOPbCUmPPLgnku4SPzRJoeFW9ED2uoaeMQqOc+9UxecWD+G/jEPJm+VT9Q6xXiBi4+qAZ1",
-	"hB545eUO6SepER0xmJMrD5zV70qxkSJPv4Eu2oJRGwgFC+lSzjqzau5dR/9+y3XK4UMou41Y6PDelMgA",
-	"ujev0hnfA12IpUcdVL8PbzF0MZsNt1eIPXjxwVAKlfeEC0hn5hLqe84ygj3X5b78ud6xcUR79fyMABXa",
-	"h51CyUA7u4wGO6au67e985ZVbQkPCdLaYr6J5VXkqCBDbznKyo3k3qAhhC6X0LrG0SXJMgRXJWEw2RiC",
-	"tgox6Bt1hqpLPC/Y9fiBDu049Y7AKRajblhDE4d2eDd6Moa8AcWGC8wGzEsPVDFH5qXJUOVC0uS0Q87U",
-	"2F7UZeyIdjSasyJHl0uSLBHhrZ0bg2dcRLvRHDcKVXOQCzaHARwiaJG4pVsLiDaZKda3blCPk0oeqodH",
-	"e42lcFYtojgidF5EcXSJmbrklN7ou9kO8ZU03rWl50E54Bzl6qHxxDnOyLY46nhEh+VJz0dq1ljFTeo4",
-	"YU+o72YYXEReRPI1bez/jUNS0JQjTmgCCMoiWf7UUdYDFp6S7n6PUY6vpCHUdkuYWBukdjvG2FiQC6BI",
-	"TswucNYsRav8zHO7uIhow8FuSdLRoSOEuv5V+WQdq+757v/54PABLgf9krf1zXXOr6Y71esOXJFZcflF",
-	"wZSC+KIX8F2ZWXFZg0AU9U6WgOzLzYbOiiIDrGQ8rkRxhCsOLbf6HGccPCHPIsdS8cyya1TKl9rSCM8F",
-	"aFxIdBaVf0VorOeRu0gNu+WdAknFwGesyN8RzjJk3DVJkecVtfFcJSl6d4xzitVEuUX7oDZjgeYi4vnf",
-	"fXJHIjgjF16PhhEDW6u6NcI3hiHRIY/u5nx7DVMYP0THO5FVXACbBnUz2KvhFXlOfJEO9budoGDJErhg",
-	"yrsQ9DO/tdZL5/RK6smp2re1Cs1Mdb7pV2aV4mZYZRVevzNtpWkubqodM30TqvGzDnGoRKp1ybYycFbX",
-	"3mmR4zS4HwOMQDyrBzTgteOsoO5BW5AL+Lp4reWpGN74mmYgmtnFO7zqX0X7LN5RLjBNvHLHemCIGdMY",
-	"k6P4M4HGCejTYVqlNkz0Sw5zUZf/bd6VcvL3Dx07IqDedgffDTn2GajNtAHkNWerJYUVSdpZ4RFMOFlC",
-	"qoLFHi6VdrAEhx6lg/YckbRDbXVAOuAbaoLOT3LwSQ6uIAdhgCbHROCkLIm2o8dDsE/ia4L40vLJlSTj",
-	"AqwnqRoitDLLCYJ2s/pSa1LynjUsLQ9FiQdHJ0P8Vo9DdZrIxIuzflNbcoEg474KD7ZX0k6JVSOZrlvP",
-	"Fx6l9ZmahJfV1YGkrI6AJeBVAiTA5eSVygwq9TidDjVl7pTwc+4LWguV7mNxqTOIcLJUseLtvIkhT+Vn",
-	"N3buzXmS8D8eDThTTWDrIEu/dRIOPn9w5rZ++bVD0C1iD1BmC7X9DXq8Zg6ALO4sT85qidV3jlW8I++a",
-	"CA9OpQmVMkykpFZMTykkQv9R0SXgTCw9IaA4unomp3l2gVWUhsv5mo18NDM3v7xu1mh+PHBXa34+adZt",
-	"He9gielic1bcaFbN6tdAhwzMBPIUH4FX+VDsou04Gb62N+Q6eWAPwU0cfXOhnLTIMfFc8q8wB6QfOunT",
-	"tQON4fmcJIhw40gjZ9mkJCmgF93cxg5A3JxFJbaUrKYXadsDtdlIzqZCK486gNGNQBhaDVlsT8HHB+CO",
-	"e4h1PkL2ewqkPgVS1w6kmrO/Lxb+MhIdC2yHNhGmKcoIhZ5Vp370ziOfDNWiPFC9iNpwGw6B6pw5AeN8",
-	"C2VlhtxqTXLSvVf4PBRU1f7dahwDvTak+XghTtt4YVUiKgap3Cvvi5hJtmcX0R77MzNb64H89mv2l+uA",
-	"Ua0du3BwYHboSO1pucH2jVF53FrEm+pw6CYHTBUIYafIh747ZFryb1JW0iw+SgKlREPOj3lWYNFPHdAy",
-	"U9nTIV9DqvK8g8noYU+DfNFfSqFSx4O+hUHfxeBWBzwig5P6d3k44gMJT/ljJryskIbiXN8OUTe4cFDt",
-	"0JFLrI5smFlNZ6V0KOOUV1m1qn7HlwNVRxL/IGIZrHlpBQNC0mmarinlz03Pe1LPL099DDj3WF2q/4NH",
-	"MTdlStZfIeTbvqo9/tp6PrpT/LEEsYTmdavjGVdJZ0rHrTKevRDaTdOYYVwH9c3Q0y5NKweTR22A5Z7a",
-	"Qvapti7oBfzhS+MM9XjLMzeUKpkU1JQvz8IRxuMlODGV5hUn5Nhh9wnKhRuw/+gVqL7sDmOTSaXD+D8n",
-	"KR1PF+TYBemhAw+OLOUpKdCTWZAbB12nVEz+bI9ZcX8CwzTpYd4eER0+XtJ70/s3vkC/JxFCvkTweROn",
-	"Z3qoXJFRDV3hpe3skFJNviym8ZXTYWkMmlLA2ryKeZWZHhSSlXUG8KDXdA3v5sid1vihWmdvXDoPdLGt",
-	"XxGyrp9RImZW4ku6MrAUSm93B67h4yyrs8x3EbU1ObNNwpEejwqm+2Q0zmd0du3RshwVj0uorMtFXbgM",
-	"GFRr+SV91FiV6Ro0r9GoX13TSeQ6OJu+ahP8mAaZLru6x3AZrEupLfy0RF6bG+Ja1LoCWaXK9KXyCgJN",
-	"DfWqklO7zqg9aKcXr51gG2sx07i7JmxgpduF1e12RjfY6s/TCrYPZTA4NG4tWgVtbdJeYmKSCWxqQ7jO",
-	"Z1O8NY3g69Qsv7uvRXtvSQYnZVZgDxWWDLg3FciVcXOSKfmGMwUGZF6ydTUqI8wr1irm0ZtOWOYE7dTc",
-	"fFlUWYrOAFVqn6on0iho7N57B/5omu1tPqa6TuyzSM6ByWN6HHj1M8fSCC+/zh2mMHaQe9RQlVODkiUk",
-	"5yq4iKkqB4crSCoBFrm1/G5SQILiSFkx3rWUqr2hVTbs1HDwEyKkT7uPg5TWwf+GoaWP3QOUwq8PTPOC",
-	"JRNqt1xpc7ksMts4rhEMaiJFOqyiiMECszQDXsM6LITmtjOHBwjyZ9tYAHOE0RnmfV4M0+Lc1/VjCDX9",
-	"NiFmFtd867o9zC5usc/vTwpwAeVorz2bQi7HDq1nV5mkDll8zASU3mhcL2rbemOwXWJ7R7ZvYj/3mY0q",
-	"XPtsUeVy302KnVx9FeVLdTj6J+aerg3yV8t5algd3nFW6nPL6sJATrURKTDcvSS8a18zEVf8nSgLIqhx",
-	"3JcJKfept7JGHSRcqoZiNaWsWAxp61iJuJ5JHtFrOXlO+5Xu/HEGmAF7a60+fbgvthpZ8Zc6lBrWrL4U",
-	"QjHafpoT2ppQNYZeAk7VcNMa+v+fqYHPjttVziZYIedR/xub4+jds99cGDTvz6oSS/n7fMpe7ODwduyI",
-	"XYW5qbO1yMBOJlGh+hKobgFCqpzRm91XEqFOKcZetLP1fGtHNXoqgeKSRHvRi62drR0VxRNLhb9tjZ5n",
-	"Cj2algvui5jrOiaMKFx2C8wl7anwzbs02ouOCi4cquCmczdw8apIrzfWQblTJt8JAxrnV6sL+O4GO3J7",
-	"Goj62nP3WoNC6rgss2unUbhvtXr723JQ04J6eKwc5HKrciD6qPnz6Y00cfFCpeG3CUHxe5s4tr+2OvLf",
-	"aCLJQHgbGMrfEabDtKKHudSy32n67342IOAHbYZstz8ZIE/XoYCXI8mY+jy3Q5JphT429uWDILQkz87h",
-	"WkFjASJQj4ezTMeuzRXBe4j7FYSWr5q9WzBerUv6RGWsvu36qli/h7qDPMRAVIxC6jnUAzOf907ooNCi",
-	"S+oiEwSzez6/YHaQdicy2cXUg4jk7gY8weVW1sEjk8irEYXL0ttf7VdNJknmYVoxgllTy37ztZQVxbF9",
-	"cZokbiHnW5fEK3M3FonHBNPa/hi6juTLG8bW5sVDz3KZJCF2RgjFBHd+EEKRHK/LHINX+D/VY+3s8V3c",
-	"+nk0BdDG4NUlFTV8V4OuQvI2LVKYoHXoYZ5NfzAPNqNrTAusq+Y/qi3t+hqHPtC9XSpd47lDR/KpISK1",
-	"se2vulXATRAzv4LQ9dOmEZ4fMR9sw4HVJI7pU3ATr1J/q2zmvypQ2UzGZG61M6jRPZZoc3pLchqjHVPz",
-	"N5le6lrrRym9ppFWUE1VRdj24yPFXCqsuqy8r6RugqTu6ArrVZXf9L825tdtDG4tBFRukJriW7i5pouV",
-	"Vnb3sKy3jV7ctiM98eIm9HUoIVDBokSDdlSLAs1JZoMMTR6m+h4C+lM1i/4HPkv+rHZ2dn/GZfmPkhXp",
-	"n9FPW+gNTpZKvcA01X0nOcorLtAZoJOP7xHQpEgh3QoIpLpucugzdaf3e511uuPc7l7rI08R484UYty5",
-	"x/vQcQJ/PpUXzdpKWLuuYMQYtxWdqtazEyTuCzyXyO/ILq/Rfr9GeWvZvkR0S3bD1vgPQlQt8bnt9PAK",
-	"i1G3047Oep0mTA+bbktDMvWgyHP8jIMcJFGTtZt1oXevVbx1Aa2dRHEEV2WmOmeayJ9PRJpJvpCUD37c",
-	"MxzJzPHVO/3w+c5OR5jFUUXJXxWYAYrO71Th8xY/3U6k6vKHvGmA9IOywte6xH3Qs/UbybJG9AZcWjWa",
-	"Zk7Z/GoqZlNwP9Gt1RF05yTLvg2t764uz6Cl2VycZ9dI2WxhGXZHCNy4RFjHCuRNC8MfhiyCPL9tE4+D",
-	"ZGOJxmTJTqCZ93rk2nQTe5OepPQWnn4JXH9VpUlwqZFNKMpJlhFTJBWwJlSuld+1YasAhptq94wl01C/",
-	"qYsb2mVgVxnRjR6bXTXtwHbkhbxaX697YEWF9XUYUVPWEzdKbhxTTF2GbLp6TuDJoFJ6C7as6xA1SzZJ",
-	"cpjVfYXtpyJipy1drIbqJj1NfeMd8qdvWlD1oS57TTga0HS9g6225dP7CPt3Kv3X9Ve4jHwP2vR3yvel",
-	"bTPpd32oLpSd7hEDno6a63X3yvvWwXUXi5YOrjx9Cab65lNtN+8S8y93fpky9pdvjEoYzBnwJQykEn7U",
-	"Q1psCVcCaKo+GSq4aY6ge4dOJKOP9bq3JaX13G+d7/pWesOe9AvzpCOGLRwa5escSoGw6p7aSG/VT+FK",
-	"S+UXP0sda+RjKp0k9YnBio4Y1ZC9J8vkEVCw5P0h8pXP15B0+sUHos+ha7bTZPjxuoiNWL4318l3KqOd",
-	"Bs5+Ep+BcLtAd9s3b6Fjf4dUdGUFlRP4IHn3k21b6ABnmTKWl4RLlWxZpCivMkHKDEz9e3EB7JIRYUrh",
-	"j4/fx7qZvJqw4vp1QLabi9Nkjjc6vhylP1suCpQD5pUpSLVHs5J6ayITH9eNsR/+lmk14u7W5svDNRdH",
-	"gw8XXqYCK3gN9fvervMNL7PL043cRtyQZt0F2Mz+o+noAnA+MYvba34fmwf3GYBW1Sy3jDvrA91ffKNb",
-	"3jSExlZOhPzNQdX2V12iO81/4sb1nHIwPxaP1cTrek9M5fCT6+T7cp04HdZu5TcRTTe2O3aavJgy9sWj",
-	"EcijDL6d46tBJhfOl219DB/8oO0EMXCIr54kwaOXBHGgd7IoJBMyAhfQohL9/WOdYhHIOmOq+VE4m8K2",
-	"v2la5n3h/Z55XxQyvjDVNe9+E2fbH9J+klUbl1U6D22S7miHekVO87AjZnyUWfeUDTHi5EYlp/ets5q8",
-	"vVvrrRZej193bfY6uexwILnRpZS78F55e0JN8mHtbnwPISeW7vNxifWnakphQwuPLp1rEyTTEjNSLbKt",
-	"zabWJQaISY+oyenYbZm2qqZTvzo9fNRqcbiJ6sRNXg2b4vXBIsQwm8vX7gQxdycu2h1d1q5E7HXpDFYj",
-	"fpe8HgeDJFrkYTrxcvg2iOZbvGO+g3tjW3+qfPur6Yx5MxC6UEap21NsEtHpDx2/qhtvrk+B8eho297T",
-	"c/Xs+iWMRu3S+a7Zd4vZ7aaha9hx0m6fFqpMHUPzzDZXvRdk92tcaQpXTVcyE6w6sx10gymj+psInUbu",
-	"vvTMYsF/n885BHI0V07QDDhY7Ee8pkmx5qtld+pFaHdlXtGLYOXsowwp+flxqrNgDQ5Vjf+2vy4xXw6X",
-	"iWNq+vqijNBz5SLDSGCmm/9KtOpvOVsax9egn/GJ3Pu27lR4S55VZFxi1QTBUPFSTxt2nI10RpzkqXh+",
-	"N/TtdH4O6AYuXkxT5sL+qGjeYOk7SIu8O/642F2lunmwEO/T7vdc19y76t7qzTYbPbtGBQVUMJQXTNfE",
-	"K0hMqhs0XyhdL5e4+Xhrpw0qF9eqkaK8Ez239UHFeMEk5HmtYapyyDkr8gCwKFyJY7ft5TRo9Ysz1AFN",
-	"VKBiVH0/qNSfLFy9MGPo2n9+l+HKpyr1B8gTudhtu/xv6839tPsQ/txPu4/X2jYw+K4q10euwXux0h1K",
-	"ewx2+h0Tum2tP53MH5eb4LaEpSZkFxaR6pMiqiU039vexiXZgt2zLVyWkTPD1ya62ATXvnb6C7R/VJFQ",
-	"9+9Wj1T3gW25dnN6898AAAD//yuTxj3LtgAA",
+	"H4sIAAAAAAAC/+x9a2/cuLLgXyG0C9x7FvIjTjLYCXA/OHaS8Z04Y9hOzlmMg7m0VN3NY4nUIam2+wT+",
+	"7wu+JEqi1Op2+5HEnxK3+Kwq1ovFqm9RwvKCUaBSRG++RQXmOAcJXP+FkwSEOGdXQI8O1Q+ERm+iAstZ",
+	"FEcU5xC9abWJIw7/KgmHNHojeQlxJJIZ5Fh1lotCdRCSEzqNbm/jCBfkd1j0D+0+rzbqZUmytHdQ93W1",
+	"MWdMSDNAcNDq82qjUpZC70Ltx9VGFJiml+ymd9D6+2rjSsB576D246oj5kWGJQyMWjVYZeRb1VgUjArQ",
+	"NPxqd1f9kzAqgUr1X1wUGUmwJIzu/FMwqn6rx/vfHCbRm+h/7dQHY8d8FTvvOGfczJGCSDgp1CDRm+gt",
+	"TpFaIggZ3cbRq90X9z/nfilnQKUdFYFppyZ/ef+Tv2f8kqQpUDPjq/uf8ROTaMJKmpoZf73/GQ8YnWQk",
+	"0Rh9/RBUdAZ8Dtxh8tZRuSbj/b+fncKUCMkX6s+CswK4JIbG8bXY12xYsctU/dIilb+fIdMA/Q4LdHSI",
+	"JoyjdwenCDeIKIrbxylWY6uJGQ0Pa76h6xlwQHIGelRuV4qIQBlLsIS0Z+gzSDjIavHhOUwjfwfjl29+",
+	"aI96vigAsUm90M5AQMs8evOnWmP0NQ7wr5oj/Wm+xm00BDfoA7Qel13+EwyhvVXi6SObvqNBTGcwh2wZ",
+	"gX1k04+63W0c5SAEngZA8JFNkf2IHFkH4CckFN3OZxIKRKhGuBaoqOBMY4eD4tkpkkx/zNgUgd5KCDck",
+	"ByFxHpjg3H1SWGoPNGE8xzJ6E6VYwpYaJVqKoWqqGiSxheZXB/YziWUpTgHb49wCvUGK/SuFCS4zGb35",
+	"82scgCyYlm1wCD0D4maKOCIScrEMnU2SqGg6wpzjxSCOjy1+r4mcdeePUVJyDlRmC8ShYFwSOkWMZuZ8",
+	"aTZke6xIGXKGJZpgkkG6FDNu8QoLByefD1hJZXfYg5PPKGEchF6a3orRZHxyIFS+3FMIJpTk6vi+qCYn",
+	"VMIUtHw84KBQsl/rrV1cJ7aNXEKZRvlFUo2CdCfDPcZQaByRAKs+SoFKMiHAHeX7c/hDlyUJctUci6tl",
+	"JFXPcozFFaHTQ5CYZCK6depXe12fcA49K+qeawfUFuRmgCZlli2QBe+SgVqEondrlWzXQ+819tD1tUbw",
+	"OeB8/+TISpX18Lt/coSuYLE6au0Eb/XcOMv+mERv/hzGiVrvZ6Fo9Gsc0TLL8GUGRt8dTSt2vWPI5Cok",
+	"bU/xNZrjrITugJ0BMizkZwGBdX3EQiIFGSRnRFRAvMYClQJSf3U+EJt7fhTK7t1uiBZNQ0uCljCblHhI",
+	"xNUxSE4S0aXBFOYkCaznUP+OHKW3gTAhGYiFkJCfB1Wb99V3pPqi/4Tt6XaM4Ea+itHNRPwtyDMU1z1h",
+	"JMR6j9U3VKiPDkwpEVehYSSTOHu7kCC6w5yrb0gUOAGlOVzqVj6dEip/eRWFOLYimp5RFQGuM2hbCNX7",
+	"jx1iOqD2F9LYq0P1Gfk3HL8NYJSIKyTIv6EtvNSaj8nbQRm2G4LIOzr/gq2PJk2JmgdnJy3y8pfwjs4J",
+	"ZzQHKtEcc6LOWUiWdsn+HZ2nX4CLoAVgPzi6ADpPES8pVYoEocNjx5ExhLrMmaUButaNkf4WAFcXRL1K",
+	"kZl12Qm3E/nayXvGryA9s/sJLLvSXKxq+KJjw5b5pWHWCSsICAc3Rw6SWdEYpAh8Y7Wa3WUqzhVAccZK",
+	"nkBjPYatNpf0O2h9DZDQ7aulOCziiQRDJBPGr2LE5Az4NREQ6iQkXghUYMvl7cIuGcsAU6fxs7IFpddx",
+	"QAQrWGRkXh+ZiYa+mwqEoi8BCaOp2F71AClkv+csP8rxFHyzOiVqHTmhWBrKzHFRKPIwRnaf0PGN8zia",
+	"JkVfww8HJ15DXs3c0xoocJxVPW5jR3KLT9ZHpjZ2G0eMwggNw1/mbTzc1l/p0rbtdarT4g/QOSsCuOKx",
+	"+4k+NP8tQrzlzLRBthH677M/Pmlq+HBw8gCGv8LiWMM/sJ2Qbd+GUwcsBRbimvGASnViv6hjUYr6VPCa",
+	"mjYOgWrsr4HBSwE8rE99tl/GLzUM1GqGuIZLCKq9Gl8HvEpVg/SL0m9POEzITQDO+netpioGY3qgeVPM",
+	"GXOP8T7N2JvnrJwE5zG/33GeYngT2gonDjqiMySygO6Mqy2Aj0CnchZQ7vXvw0vsU7PsgpszxAG8hGCo",
+	"mMpHIuSACMYZwQHlZ1/9XK3YXisErbaMAJXmRiKFgoNxXQYFZ9f4Mr2D4xZl5dcYYqSV/0O5hhsK5VAv",
+	"T/W8Vae316xVXtqGUoauSZYhuCkIh9GmLTQVwkFPt9dUq2Q544vlGzp27XQfiVMslzrVLU0cu+btu7Bl",
+	"yBtQU4XEXMIqUMUC2U6joSokljByk2e6becObdkWXWs04SxH1zOSzBARjZVb83U5i/bv5vw7xeoE+WDz",
+	"DoBHBA0Sd3TrANEkM330nVM74HJUm+rg0YmxFC7LaRRHhE5YFEfXmGshp62AkGQ7xjfKFWPs9gDKAeco",
+	"1x+tX9VzLTfZUcu/PcxPOh5vO8cqTm/Ppf6ZhiTD4CRKEKluekfoP61yjQShCSAoWDL7W0vR7rHXNXcP",
+	"+/+sEdN0MtmbU0jdcqzpOCVzoEgNzOc4q6ei2owa9PE34eCWpOjo2GNCbW+5+rKOjf5i7/+G4PAJrge9",
+	"zHf1tLb2r4f7auYdEJEZu/5Lw5SC/MtMEBKZGbv27VK3khkg1zlo4eFSshNlADZsvAnOBAQusFmOleKp",
+	"fMLaamxyo9r2dHZjaMZkBslV4XxXI9jnQdVB3fASJ9ScQ2WJQNPN7iaYKMhrxq9G9vxkWtdrFZCUHEIG",
+	"k/od4SxD1gGYsDwvqYsQ0NyqI+d863wlceJIb1CjuoPB752/te38Xqllj8nQHcHmvMX1wbSereZESVYK",
+	"CXwc1G3joJbJ8pyE7s70724AxpMZCMm1h6P35uK9s6D6HFhNjUFf9o1155ouZ6XmKLDKLKLqM26mcZcm",
+	"1Lj6umZc7bkfOqYKqc7J34jpWt2CoCzHae96LDB6bkg7QANROfEY9TfagFyP91RUmqa+FV4+p22Iztzk",
+	"rbMansX4TY6okJgmQb7jvEDEtqkN2qX4s1fXI9BnLv616jLS0z18itrn30Xy6Wuj7qZjjwVUy27huybH",
+	"7gFqHtoe5NV7qziFY0nGYRJgTDiZQarDDwKnVNni2pWtW5kwEIFI2qK2KsShxz9VhzE888FnPrgCH4QB",
+	"mlzGAkfF3TSdTQGCfWZfI9iX4U8+J1nOwDqcqiZCx7O8a/V2nGjqzFoRxQHrR1PiwcnnofNWtUNV4NFI",
+	"wVn1NNZkz7X1vr5wbs5kHCOr3o37rsXQhTut9lTtZA11ICnKE+AJUNkDcDV4qWPNCtMOT8eOrbxAIhQG",
+	"IXUAmcOliUnDyUxHH+zkdVTC2PPsR2MEo+gU/M+XhjBQQ2DrIMv0+twfzvDJG9vdDawd1NAg9h7KbKC2",
+	"u8CA584DkMOdO5NnFcfqOuhK0eJ39S0TThdqKI6J4tT60FMKiTR/lHQGOJOzwDVUHN1sqWG25ljfFAk1",
+	"Xr2QUzty/cthPUf944E/W/3z53rexvYOZphON2fFLY3TWl0MtMjADqB2cQqizIfuT5rOm2GxvSH3zSN7",
+	"CG7j6Lu7TkpZjklAyL/FApD56AXkOyhJjicTkiAirDOPXGajwu6UJ77lx2wBxI+C1WxL82oVDNTwQG32",
+	"NmlT1ztP+hKlfQvS58Ls8lylcJVKftb+0YponVJsJ42bp0kgQXEhZkxKSJU8Jyy1p1y5EFkp0SXo7pIV",
+	"BaQXFNMUJZiiS0AchGTcRLxjunAzXkEh/ZVsX9COclY5+sPXbJcgr8HetPl7qk//4OH/JRhIx0ECNbN4",
+	"/Od1f+CWml0hTTS2Y/ZXRzpoGCwJ4loSxdUilgo4HhX02e3P1+CPwCMf4Nb9CTLh5yv95yv9ta/07d5/",
+	"895WNxmZ/+q65csrhWQ5cg0QZ6X/zMruvWBcxohIlJfChbC3mlhWQCg6/HSm1niD8yIzr8+LbfvXdsLy",
+	"EIbV+AHR683u328LN3e1asy9lQ+Ki19ev375eiWB4T1J18v0AP6RTcPvAU0YQDOqASnhnhEKHXmtfwyO",
+	"o74MPSp8pId/esFNOPQ8s5wQyNLB8Po+b3Ydl/jgTzUfC6p6/f6zSgu9JqTF8heVTZ8BLxNZckjVWkWX",
+	"p49y+bQRHXD7ZGwamP7jJubsTtcCo5479uHgwezYE5PjHnm4HksFYGOSYJTTsR8XNJYh9PsiP3W9kONe",
+	"cSRFqbxRJ0nPm9Ahn+MkY1h2o4aMkNJurD4XX6of7PS+Kup38KmO4Tdx+g1Qr0tv0GU4uNQBR+TgoOFV",
+	"Hi9xPfYPaWNa3k15MJ7ILNY2qtRsAVSiy0XbmbP6tEd0pXk5JEDmkN5t7p8zvG+FoDtPRfTOcU1+HnV7",
+	"R8c/nx0EtwnN45fNOKmOsIGKQsbHXJlJ6sgrpUiJXtOLl1n3SZfuYhws+r+VCwXr9loFLMrLjCQrypcT",
+	"xuVpmUFIpknA+aeVgszO6x5uu7cd0DbA0X1Xp7+igmUkWbTAEBuLSoBs2iaYIuvsN3kHrIKsgxQhRSkI",
+	"Sag2W8N3edeQHpCUB3BydDJ/hQ6ODk9F74yS+SBfGp5g5zvUBkPowsp8GJguRtjZG+YlBKRGtl5E/2f7",
+	"IjLbFuofRKRAorxM7WRrrPMkTKuHNUgtQQ6uN8tsK8xrtGhcQl7Ihb+wOxkvHUWpQ3yfi9Sa0wEyOLIh",
+	"qft3CGeNEZlSxt3+1CdokDQRioCDlxh3Zy6Bu7v2rjxeVx3+DjicSXpHbMyJIJckI3KxAjv6Undq70cv",
+	"qzFsazdfGhM2sff3GdOU6SFNNG1szsrprPHh8+nHNxfUcFa0pVzRjEJ8Qb10dWirtsyrDCnaYdZI69Fi",
+	"6DY2/R9bBitbZqgZ4BR47IJiBcLIhOm6fmpqnc6uMyumVYaE1lSKjXvznRxtqf52qgt6mbFEvRrbQpRd",
+	"snRx4T/eq2SKt+HIZdyL4sh2Dr6A8NAi+o7duqIQcSgynKjrA/XNZqBBjMIDS8kAgTaO2Jm9A1k5eYjn",
+	"PLRDaO9h7Ycbp+oVWAHGrWKkx9NN2HZdugsf6yYcXl9nKWKtRSx1InjDthNnNLyiqz74sZvV70ZLMUzj",
+	"Xb0nEDao5aFov9RXfxaczNVanIFRuWVx7poquu5VfjjgZHZB1c/6YX3dqTGUG77iUgLngKaclYU1YQjX",
+	"CUNEK+vCthFuyqTVmkR8QVU6EJRebrdbbKPz9nT2Sk+A1c46XnFSkU3oPk+vrwvPP9RQ3igggvuqwGOC",
+	"fjR44kA/wyVMH8UmLADsXZ75ECLqgfD+EDo4CJbNm7dFl4vGglRePWJS3BhQUSabGoOfpLIKlP47kbPe",
+	"JDGNWMc+L9C4SxROkui2cwar8dWxU6ehuwYrM7q83kotd+2paCcEaSIO3cVqV7KDhnNF6UQ0sNcc0lO4",
+	"lj/O6FtNncl0+eVKaITOtYkerkoAVAnYetcOss/JqHqDnH76XFKWeoL5zDb0GjVh1GpbZ/0B1EoGUC9L",
+	"jeviccLWcR/hxF3iG/w04BVsStLKQYfSkjsVUkgoNuMs/LTETbjRxfiPNE6Dak7oRY+9gUUFcBv1Msrj",
+	"/eyqXOaqDByOAI5G+SMr3tn1QeY2XqflOVM/OziUIvyqZRzPtb2XMNwQBzJrM+u3oUHhwCLoCy2CUHDR",
+	"eL+VfkC09P5II64xiZYFqrMcx4289PDLoKnEkntsMykzm+pWMUDzNH0wiGqNYKfRlmVj76talhtXB9ZP",
+	"VbJu2JFCzFmBr+nKwNIovZvmsEbIk/VdLNF/7TKJsL4OxLgxvOpYNF8UBRVjoaCy7ilqw2Xg7mutMKUQ",
+	"NZbawbQeGk3XNUMY/HinuijEiLCmyhFVH1d/G/4Ba1NqAz8Nltc8DXHFan2GrN9PdbnyCgxNNw0q4GOT",
+	"W+s1mJAMUYVobCyTdR2MMWIBK0mXf5VQwgkTRAbfx7kvDUA1M5zrEWLfG0Iy8L5fYyKNdozRhIP9GXhI",
+	"Kg0733mVgXwpMBspyxuvRYae4Hjn0TnN9GKN10xtxPzPvc3pT5azKT4w7nBWbwvDgTONc6Ly4H4uMoYD",
+	"J6bgIIJv2Xx+PFHoJeo6ToMB2U6OKPSTxiALLnlAx/vMMy/eWI8tZqzMUuVhK/U6XRTgMGjc2jsbPrX1",
+	"RzYfDr5O2La6XeBqm6GLUvfNsyX7p19H3mqMHeQBlVk/CjOh+zouWgUzSYbgBpJSVt7DStbUb5h6Wac2",
+	"yYJzabthQ7Ns2G3l4aePkL7sPQ1SWgf/G4aW2XYHUGdv/zgOMRc2B4ppAv0+25DZfQoJ42lTANn8upV/",
+	"/BILQCRXMW8pmYKQsd9YQlH70glHMyxmIHS8XtUKeOvBeR2wKi5ZvtqSDxZJxigc/sMkZ01ZUuoM03YP",
+	"BU6u8FR7SoTEWQZpm/JjlBMhlPOkUvOqTonijvQ/pGKQmX6K3110hzdWoO8iSx3GEE1PGE9GZKvyRcP1",
+	"jGUV1CsurgfS55yXFHGYYp5mIKqD0S8x1LXcB7L0VLj9fCDSZne2fXUe4wC1q59dGlas7qQ1/bSZbj/T",
+	"mYRyJA+tsJtU2Y7i+xTaHky7ijus8yHZvZ7rhLNLGIuu07qHyd7FQa4WWx7SgO049f3f0SHCc0y08dqI",
+	"pjr9/MnyBv1o3roqc806FvrOjMJcX3YpX6eCkPqNQ4oTCam5M665jFW9utxD4qlZ/lBpHJufQw1HcQ4p",
+	"Mt302OqkTxiHJkr0ymOFKcaNNj3K1HDAP1PjB5N3PIjY1qsfCRSDo4H53CwrAgCKYPRDB4Oux2FDdwvY",
+	"mvt8ehf61Ul9RVPQYa7i7efAOUmdL92CrGq5f/pBSxJlddqIwM4ehvTOA/N0wg3nbXNNbanmw525PhCp",
+	"ayoJIlVAtRaTLXuy8fj24I+T/+cJcHWpLWeeYaDmViKxWJjzSGTnfieFImOL32FhSo4FxMFhS7nQ7Wrl",
+	"4uzsN2QG8YOR6l2En6I7yJzgULZo9WsX4E1A6CctNzJuEjtxTMHuV6MesFaQKrkUI1+4xBaCmDq+Embg",
+	"k8CzUI5pMouRxFPldVNlipDJPYPOfttXh1KvNW7cTl/qTiPv/ONIxXMSDomGZeCBgP3UBbwZHosuzELT",
+	"hM3P04/dcWON8s+nH4WLW7PbczSw9GCoub4OsJLThrDsimZCdXylatB50ggqZ6spE9Xksxq/jCIltBZ+",
+	"7Qkud7jOcKHK3eBE1TVjtBpYKwHCPAU0US2BK1LN88Opw9Ss5oWlHgJuiD07/wbOXGGSDjZmUharKwq/",
+	"nZ+fqN79T+LP7J1Y51W8iGvGKRnaW8f91PMmq/kK3tR3c2CtcTQhXOiAPl+c+xFDjAqSAodUHxatTzXX",
+	"/HJ39Yo4MlkDzOcHJ50UJCEgm80Z5GOk7JXMQltDoQXy16uC/DZ4YjQNdJakfkUf3p3b6QvcMp9c7Vtl",
+	"/Okf4KYArcdVzrMmzbvvfcl7Tu1xt/1rLmLmiXWWh72bG/fdPp0vZJcj9sckv/71Vx9Eu0EUF5WEsapU",
+	"tDP0KHeTj2mrF7RhGuoaNQcnfQhSQ1kICRdrH3zf8CD7MLrCcfi+yDc0jO0AaduoiBGR/yEaFoRkDUbe",
+	"pTg6H1egyoRMmtHsKkwe77HX45XCY3sT6v0lll6KSMynIIeVGq3O1PGL3iqbTGGHl3THTrxzUe7uvkxI",
+	"qv/1rriAztX56Y8b7NzbN3Ap8bQnJsnqQ27ReZlJsmV+aloXsX55YrVNE/6pS1V1sOouImvFtYPn9T0T",
+	"wj7lDDok7uJK6B14eTiXgRaHCXCgSR0UpJZ05gO3AZF7MQjdLjZjBwYzVTd6DZb/bdKPqwPcfa3Fl97s",
+	"7fOpdh96delMNNX4Wz5NuL9hEbBE1K/+oRXVK1dvpi7Bre4cVENtzCs4cKZbxpwhQY5wyBevptfGY717",
+	"NXpcV/sdQbm142rp9axt2xYaRmA05qycUyvTsye5ApQwXPyqH+WhWlT+gTDvYXrv2h4q0EOt0yxljRT2",
+	"cK2dbxUOVsxj70oQELlQWcZyM5eXnGi/NIraJWAO/L3TX8zm/nLFLDRC9aZ0s3p2bTLdxtF+mhPaGJCo",
+	"5Zv3Ty5m9k30jy3dcOu8WSTDBmKrcfT/lo1hXleF+p+VBVZS5MWYtbjG/ctxLfY05saO1iADN9jtrS1r",
+	"o3gjkZn69m7vrUKol0X3TbS7/WJ7V83NCqC4INGb6OX27vZuZJRqjb8dg54tjR6rgIpQ1gWTghojCtft",
+	"+iSK9nRo+lFqYiqkRxUiMtQEQr5l6cKGJEt7D4+LIrMZqnb+aaMfzJFfmvqyWWWl9cTBhqg5c0VvbG/3",
+	"xcZmD1QT1ysYSM7lql/XgYWZJoxXuy/6ZquWv6Ma3cbR693d5W1VI/+06jC/EDX/+VXF9Uk81RlUm4Sg",
+	"z3uTOHa+eY8Kjw5vDZFkIIPVjNXvyHu9GaQV08ynln1/Ck2oHOcggYveaMW6yU5jgTpqsUUBr5ZkUDP7",
+	"uRuSXu2+GtP21aMgtCBbV7AwD7VCRo5WQtX7bx31bkWE6CDuA0jDX83xbsB4d6VTNlL6V9Iu+Ga8XWWx",
+	"Rh7iIEtOIQ1s6pEPX1AmtFDo0PX1Nh7DmP39hRmzh7R74ck+ph6FJbcXEHg403hR9cQ48mpE4R/pnW9G",
+	"PxjJmYdpxTJmQy37dtzV2bHrOI4TN5DzvXPilU83lknAfjXa/jJ0najOG8bW5tlDx3IZxSF2lxCKDcH+",
+	"SQhFnXiTob5XhP+mPxtHdEhwm+/RGEBbg9fkQa3guxp0NZJ3KEthhNZhmgUW/cl+2IyuMe75i5rTVDVf",
+	"X+MwG3owodI2nlt0pL5aItIL2/lmqrzc9mLmA0i9B2TrqIYR88nVilmN45jJdaH58aUTtM38rxL4ojaZ",
+	"G5VoKnQvew739Y7ktIx2bKLu0fRSlcl4ktxrHGn1qqm6foZ3m4hdQGpXSd0ESd2TCOsUBLm1MmypbmNx",
+	"6yCgX/DpIb4HyTWerTQyVwzz+lY5gjDf99/ltiihJwuqZg3Gyy+Z8nq7CIVqHvSfOhvKRVQK4P+FLxN1",
+	"Ibf3Cy6K/yo4Sy+iv22jdyr7iFIvVPzH3ISuuRgSFVUDNGEppNs9DKlKdu7zo03znxXFWauw2d3kWhd5",
+	"mhh3xxDj7gPKQ88J/OdXJWjWVsKaOVOWGOO2cR1F612LdxmeT+T3ZJdXaH9Yo7wxbZcj+smq+q3xn4So",
+	"Guxzxyu/2M9G/SJp5m36OGZ6XBfKG+KpOv5tS4BqpFCTNessoqNDfU89hcZKdDr9ItNFj+21aYhF2kH+",
+	"IqmI2iQZh7hcIJnLzZH56IcOGWYWRyUl/yrBNtB0fq8KXzCx091YqgkwcYTw8x6Fb1VdikHP1u+qvgr2",
+	"MsOFXFoVms68WherqZjVasa6tVqM7opkWZDPPTmt776EZ6+lib0ka4ikHRz6POyeELhxjrCOFSjq6rM/",
+	"DVn0nvmdiU1oHda53uvcTY1UrZLp25C+TIOuMM2AIlYRlxr9zgS2eY1OLcvT5Tev1K2SbXXMnVybCU70",
+	"Bh5M2VvlZLza/XVM21+/s1PkKgMtUSlNet5GmSURrrM0jjv/Vk37iGx6FWp2C17/ptlnRjXUV6bYl2Pa",
+	"vvwB1IEwXz9VNBcix3C5r0aFLSIQh5zN/azurrmQrBAm7W3VXnt2ZniuTPfzf5wjbh7l/89fsHe5NQdO",
+	"Jpa2tk0Mt+uo/4L/qeWL39a4jfyMA2aV5mciaoKx0ajAOeNVyadq4d6u1Lt4M4VxPY2RYJs7gJsXY50j",
+	"9yjeieb0XblVIUAJLq6fuAN/UOF1P6zgRxd0O9/cfwftxlPNKYKcppNsfJxJWR2537xqfOsevXhp41lF",
+	"v6Pt0RZJG1Z5N4v0JxFX/XTnspj1Wre2rculMEJ5+mha3oV2AkkO9Ev7QGlAgeQMS+8Rgy+gcpJlpK64",
+	"HPToqcHDN7AupeBgUfbOao/NkzcvNfHQKntWlZGcNFdVF5zfVX7D1SrHP4DHQGN9LW1TU9az00CdxmX+",
+	"c/9A5pU7fMSZ7PWd3+FYVlmPzZH03q3xKnGFexQee6XPY93UvFCusynf4/kMDQs0bQw6amtA0/U2ttqS",
+	"H9JwdMUWNmE2PoDT/wc997QugNcTUnmqSxEZha+qeaKrwJTm7DvCbF3IhKMuuwzCleB7suZWs7TaKJur",
+	"L6IlCD6dU7g3KvP7spG+I8rXZY/6/eQn6vOgORP2H+h+D35JpjfTJB8dimNfp5uEL+mzG3h1KnEV3EZw",
+	"Rxwo69ZiiqZw21jWeGIbP1HG6Je+uwtbrJJ9VMC7Bg7PPPHhqZ3DhIOYwcDL1lPTpKF+wY0EqjPSESls",
+	"HSKGMjIfe214Ws37OMTeyhFnJXPgNZD90lK3HRxqI/sKChW1Sebgael+VpqXv+zuLlHGu3lcx8XOttRl",
+	"A9kHuih/AhQsJOMwRL+6QahOoSu1GAc5N6mkrBKspkBnneDEzpsiRscTvVnqJi3joWqXzC2yz971K032",
+	"B5gtD8t9lHjICgHPd+QPedjKfPislfk6SrTp+AQ1H7Ow9OmHB1uN/8HC5n5QCnc8cUQUiEJSWupQRdep",
+	"U99faifANQhpEkCOc6GeVYv4TmJC3II349yrcfDsslM06SXiDLPdM1tD2Tas/eO2mDFqMAsVBgw3BeGA",
+	"bpym6j3EIPW9rmWo2+gAZ5nJP00EykHOWGqy5RWZ6WGyVF9zIm0BrfPzj7EpUawHLEW7tHnt8rYZOYW7",
+	"eisYoVp7yQGLkkNja05VHxvdcW76PQkzozehql2kZznU+PDh1ci4GrBDDFZXzRIbqAepVvl1I+ZIu7y3",
+	"G/1nO9kScD4yq0zwnu3cfnjIB3Fqzru+gzMberj3Fu10a0No9PGF1W8eqna+mWI54y5K/XdGXnq6MBbP",
+	"9cDrXpOaZT3fkf5gd6ReNes76VCyrnwtnorv9Ekw5KUHfCfHN4OHXNOQjbgJHXgXpmoeGjqKHMcGjvHN",
+	"Myd48pwgDjyq5yQxnjbJCcyhQSUmktk8+ex5Ba8O/JDzzRWirCtx/yW6pbj/0sj4i2MJgXqU9xqTdYxv",
+	"fN71zKs2zavMu/hRuqNrGmQ59ccWmwlRpuUt/QdxdMnArw+ts5p93l1vdfB6+rprvdbRaRAHki34lHIf",
+	"HtVgddZRftW9ja+hz7HqShfXRTue6PPyTZBMg83sfHP/HZ8nsYeYTIuKnM794sWrajpV1/HRMo3C6JvI",
+	"lrhJ0bCpsz6YFLH/mKtu94KY+2MXzQzza2dG7NT2f+CYkycqHk7B1RAbKRy+D6L5HmXMDyA3dvTexM43",
+	"W6P+duDqQhulfr2hUUSnESveViXw16fA5c/H7CZComcvzGEMamdYGJv7R8bsToJpAtlAYQT9vcawNcNt",
+	"PacJoUQHKy1AroV7M/zjUcCrIQpQh9vAJ/shYnHDtDXW8N0EtQ2+ITyTHHDeLr2kuqg7RgF8DnxLAJUI",
+	"5mq/sXWL6V6gbt2qN+WmKxEVgQ7a1D20udbrxDUpsxsYRlO48ap0mbvVCiTtR4IGCmBLuX7EQm69U0Da",
+	"OjpEpgALkvgKBCo4JJACTewLfB134i53zSh9Dw3ZVPwxmZgSboHXhis/NezxIGYwh6wxxWDWRzb9qDuM",
+	"cVVIuJE7mni27FZHqwOaJj6y6Tuq678HfBGGDtnEQxJQyQkIe5N+oUB4ERnqRQnm6hvCrfbmHUCHsolU",
+	"RTovIpNf1Y3yA4RMPz5TEpcsX3pzcPb2j+MKMwVnc6BKMrhUGlUJNisPO9XNV+Y+Z2pVjyYXd+9Hl1dQ",
+	"XNXJ3JAGZoBnSXx3oq8K4/aTfRP0fRnSlxJyVaH3exCkFfFVOW88g/oHFYsbOtzrHewnGkr04CdU16/c",
+	"+TbDYjZcrgBTVBYZwynKCL3SV6MYScxN0V6FVkyoR+N4AeabGHl631flRu94ZjUZ6/rWFRXPzLD9F6ZL",
+	"ypuOuqF6cT/0reDyWUO+zyfk4+V6BtzU7DU/apq3WPoB3r3f3/mY762SZX8wIfSXvR85v35H1L03i60X",
+	"erlAjOr6uTnjNjGoGJu/2mYRXTOiXFrW3q5gK+QiUz8omRiQ1gclF4wryIvKs6jTcquA2h5gUbiR5375",
+	"1XHQ6mbf0Ru00SAlp6gAjgpblHrlzDtDYv/FfYapPVdLeIT44PleM9Tjrrf4X/Ye4x7/y97TvWWxMPih",
+	"KigsEYPVTzspS66Aa9Whn7hYXigNULHMw6q9eyvbMuZccfJByqtHuWca9CYafzN8D94JJXLcGejSYmIA",
+	"3HbuxHWVeSXs9w8PDXQRBWvqVPX7nSprVEJIzZcLKpn24YJSDybusXPlw7aXUBf0JyH2e7yK9Ij7KVxG",
+	"3jNXb9DzKJ7+tO5C70pYekB1a2QQWfLM1uEXb3Z2cEG2Ye9yGxdF5I3wrQ6hrCMIv7WKujR/1OGe/t+N",
+	"wtT+B1fn8vbr7f8fAFYZm1qjBAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
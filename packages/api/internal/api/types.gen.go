@@ -51,6 +51,14 @@ const (
 	NodeStatusUnhealthy  NodeStatus = "unhealthy"
 )
 
+// Defines values for SandboxPortVisibility.
+const (
+	AccessToken SandboxPortVisibility = "accessToken"
+	ApiKey      SandboxPortVisibility = "apiKey"
+	Blocked     SandboxPortVisibility = "blocked"
+	Public      SandboxPortVisibility = "public"
+)
+
 // Defines values for SandboxState.
 const (
 	Paused  SandboxState = "paused"
@@ -191,6 +199,18 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// ForkedSandbox defines model for ForkedSandbox.
+type ForkedSandbox struct {
+	// Count Number of copies of the sandbox to create
+	Count *int32 `json:"count,omitempty"`
+
+	// KeepSource Keep the source sandbox running after the fork, otherwise the source sandbox stays paused
+	KeepSource *bool `json:"keepSource,omitempty"`
+
+	// Timeout Time to live for the forked sandboxes in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
 // FromImageRegistry defines model for FromImageRegistry.
 type FromImageRegistry struct {
 	union json.RawMessage
@@ -307,9 +327,14 @@ type NewSandbox struct {
 	AllowInternetAccess *bool `json:"allow_internet_access,omitempty"`
 
 	// AutoPause Automatically pauses the sandbox after the timeout
-	AutoPause *bool            `json:"autoPause,omitempty"`
-	EnvVars   *EnvVars         `json:"envVars,omitempty"`
-	Metadata  *SandboxMetadata `json:"metadata,omitempty"`
+	AutoPause *bool `json:"autoPause,omitempty"`
+
+	// Checkpoint Scheduled checkpoints of the running sandbox, the sandbox is snapshotted periodically without being stopped
+	// and can be restored to any of the kept checkpoints.
+	Checkpoint *SandboxCheckpointConfig `json:"checkpoint,omitempty"`
+	EnvVars    *EnvVars                 `json:"envVars,omitempty"`
+	Metadata   *SandboxMetadata         `json:"metadata,omitempty"`
+	Network    *SandboxNetworkConfig    `json:"network,omitempty"`
 
 	// Secure Secure all system communication with sandbox
 	Secure *bool `json:"secure,omitempty"`
@@ -478,6 +503,16 @@ type Sandbox struct {
 	TemplateID string `json:"templateID"`
 }
 
+// SandboxCheckpointConfig Scheduled checkpoints of the running sandbox, the sandbox is snapshotted periodically without being stopped
+// and can be restored to any of the kept checkpoints.
+type SandboxCheckpointConfig struct {
+	// Interval Time between the checkpoints in seconds
+	Interval int32 `json:"interval"`
+
+	// Retention Number of the latest checkpoints kept for the restore
+	Retention *int32 `json:"retention,omitempty"`
+}
+
 // SandboxDetail defines model for SandboxDetail.
 type SandboxDetail struct {
 	// Alias Alias of the template
@@ -522,6 +557,15 @@ type SandboxDetail struct {
 	TemplateID string `json:"templateID"`
 }
 
+// SandboxHostname defines model for SandboxHostname.
+type SandboxHostname struct {
+	// Hostname Custom hostname routed to the sandbox port, it must point to the sandbox domain in DNS
+	Hostname string `json:"hostname"`
+
+	// Port Sandbox port the requests to the hostname are routed to
+	Port int32 `json:"port"`
+}
+
 // SandboxLog Log entry with timestamp and line
 type SandboxLog struct {
 	// Line Log line content
@@ -577,6 +621,12 @@ type SandboxMetric struct {
 	// MemUsed Memory used in bytes
 	MemUsed int64 `json:"memUsed"`
 
+	// NetworkEgress Total network traffic sent by the sandbox in bytes
+	NetworkEgress int64 `json:"networkEgress"`
+
+	// NetworkIngress Total network traffic received by the sandbox in bytes
+	NetworkIngress int64 `json:"networkIngress"`
+
 	// Timestamp Timestamp of the metric entry
 	// Deprecated:
 	Timestamp time.Time `json:"timestamp"`
@@ -585,9 +635,92 @@ type SandboxMetric struct {
 	TimestampUnix int64 `json:"timestampUnix"`
 }
 
+// SandboxNetworkConfig defines model for SandboxNetworkConfig.
+type SandboxNetworkConfig struct {
+	// Egress Egress policy of the sandbox, when set the sandbox can connect only to the allowed destinations
+	Egress *SandboxNetworkEgressConfig `json:"egress,omitempty"`
+
+	// Ports Access rules of the sandbox ports, the ports without a rule are public
+	Ports *[]SandboxPortRule `json:"ports,omitempty"`
+
+	// TeamNetwork Connects the sandbox to the private network of the team sandboxes, when set the sandbox can reach
+	// the other sandboxes of the team network with the same group by their names in the sandbox.internal domain,
+	// e.g. db.sandbox.internal. The team network can be set only when the sandbox is created.
+	TeamNetwork *SandboxTeamNetworkConfig `json:"teamNetwork,omitempty"`
+}
+
+// SandboxNetworkEgressConfig Egress policy of the sandbox, when set the sandbox can connect only to the allowed destinations
+type SandboxNetworkEgressConfig struct {
+	// AllowedCidrs IPv4 CIDRs the sandbox can connect to
+	AllowedCidrs *[]string `json:"allowedCidrs,omitempty"`
+
+	// AllowedDomains Domains the sandbox can connect to, a domain prefixed with "*." allows all its subdomains
+	AllowedDomains *[]string `json:"allowedDomains,omitempty"`
+
+	// AllowedPorts Destination ports the sandbox can connect to, all ports are allowed when empty
+	AllowedPorts *[]int32 `json:"allowedPorts,omitempty"`
+}
+
+// SandboxNetworkUpdate defines model for SandboxNetworkUpdate.
+type SandboxNetworkUpdate struct {
+	// AllowInternetAccess Allow sandbox to access the internet, ignored when the egress policy is set
+	AllowInternetAccess bool `json:"allowInternetAccess"`
+
+	// Egress Egress policy of the sandbox, when set the sandbox can connect only to the allowed destinations
+	Egress *SandboxNetworkEgressConfig `json:"egress,omitempty"`
+}
+
+// SandboxPortRule defines model for SandboxPortRule.
+type SandboxPortRule struct {
+	Port int32 `json:"port"`
+
+	// Visibility Who can access the sandbox port through the sandbox URL:
+	// public - anyone,
+	// accessToken - requests with the envd access token of the sandbox in the X-Access-Token header, requires a secure sandbox,
+	// apiKey - requests with an API key of the sandbox team in the X-API-Key header,
+	// blocked - nobody
+	Visibility SandboxPortVisibility `json:"visibility"`
+}
+
+// SandboxPortVisibility Who can access the sandbox port through the sandbox URL:
+// public - anyone,
+// accessToken - requests with the envd access token of the sandbox in the X-Access-Token header, requires a secure sandbox,
+// apiKey - requests with an API key of the sandbox team in the X-API-Key header,
+// blocked - nobody
+type SandboxPortVisibility string
+
+// SandboxPortsUpdate defines model for SandboxPortsUpdate.
+type SandboxPortsUpdate struct {
+	// Ports Access rules of the sandbox ports replacing the current ones, the ports without a rule are public
+	Ports []SandboxPortRule `json:"ports"`
+}
+
+// SandboxSnapshot defines model for SandboxSnapshot.
+type SandboxSnapshot struct {
+	// CreatedAt Time when the snapshot was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ParentSnapshotID Identifier of the snapshot the sandbox was running from when the snapshot was created
+	ParentSnapshotID *string `json:"parentSnapshotID,omitempty"`
+
+	// SnapshotID Identifier of the snapshot
+	SnapshotID string `json:"snapshotID"`
+}
+
 // SandboxState State of the sandbox
 type SandboxState string
 
+// SandboxTeamNetworkConfig Connects the sandbox to the private network of the team sandboxes, when set the sandbox can reach
+// the other sandboxes of the team network with the same group by their names in the sandbox.internal domain,
+// e.g. db.sandbox.internal. The team network can be set only when the sandbox is created.
+type SandboxTeamNetworkConfig struct {
+	// Group Only the sandboxes with the same group can reach each other, the sandboxes without a group are in the default group
+	Group *string `json:"group,omitempty"`
+
+	// Name Name the other sandboxes resolve the sandbox by, the sandbox ID is used when not set
+	Name *string `json:"name,omitempty"`
+}
+
 // SandboxesWithMetrics defines model for SandboxesWithMetrics.
 type SandboxesWithMetrics struct {
 	Sandboxes map[string]SandboxMetric `json:"sandboxes"`
@@ -630,6 +763,12 @@ type TeamMetric struct {
 	// ConcurrentSandboxes The number of concurrent sandboxes for the team
 	ConcurrentSandboxes int32 `json:"concurrentSandboxes"`
 
+	// NetworkEgress Network traffic sent by the team sandboxes in bytes during the step
+	NetworkEgress int64 `json:"networkEgress"`
+
+	// NetworkIngress Network traffic received by the team sandboxes in bytes during the step
+	NetworkIngress int64 `json:"networkIngress"`
+
 	// SandboxStartRate Number of sandboxes started per second
 	SandboxStartRate float32 `json:"sandboxStartRate"`
 
@@ -702,8 +841,11 @@ type TemplateBuild struct {
 	LogEntries []BuildLogEntry `json:"logEntries"`
 
 	// Logs Build logs
-	Logs   []string           `json:"logs"`
-	Reason *BuildStatusReason `json:"reason,omitempty"`
+	Logs []string `json:"logs"`
+
+	// QueuePosition Position of the build in the build queue, set only while the build waits for a free builder
+	QueuePosition *int32             `json:"queuePosition,omitempty"`
+	Reason        *BuildStatusReason `json:"reason,omitempty"`
 
 	// Status Status of the template
 	Status TemplateBuildStatus `json:"status"`
@@ -763,11 +905,23 @@ type TemplateBuildRequestV2 struct {
 	TeamID *string `json:"teamID,omitempty"`
 }
 
+// TemplateBuildSBOM defines model for TemplateBuildSBOM.
+type TemplateBuildSBOM struct {
+	// Provenance Record of the build source with the base image digest, the build steps with their hashes and the builder node
+	Provenance map[string]interface{} `json:"provenance"`
+
+	// Sbom CycloneDX JSON document of the packages installed in the template, missing when the packages couldn't be listed
+	Sbom *map[string]interface{} `json:"sbom,omitempty"`
+}
+
 // TemplateBuildStartV2 defines model for TemplateBuildStartV2.
 type TemplateBuildStartV2 struct {
 	// Force Whether the whole build should be forced to run regardless of the cache
 	Force *bool `json:"force,omitempty"`
 
+	// FromGit Git repository cloned in the build sandbox, the COPY steps without the uploaded files copy from it
+	FromGit *TemplateGitSource `json:"fromGit,omitempty"`
+
 	// FromImage Image to use as a base for the template build
 	FromImage         *string            `json:"fromImage,omitempty"`
 	FromImageRegistry *FromImageRegistry `json:"fromImageRegistry,omitempty"`
@@ -778,6 +932,15 @@ type TemplateBuildStartV2 struct {
 	// ReadyCmd Ready check command to execute in the template after the build
 	ReadyCmd *string `json:"readyCmd,omitempty"`
 
+	// ReadyProbe Readiness probe of the template evaluated after the build and on every sandbox start/resume, exactly one of the checks must be set
+	ReadyProbe *TemplateReadyProbe `json:"readyProbe,omitempty"`
+
+	// Secrets Build secrets by their ID available only to the RUN steps mounting them, they are never saved and are redacted from the build logs
+	Secrets *map[string]string `json:"secrets,omitempty"`
+
+	// Stages List of the named stages built before the template steps, in order
+	Stages *[]TemplateStage `json:"stages,omitempty"`
+
 	// StartCmd Start command to execute in the template after the build
 	StartCmd *string `json:"startCmd,omitempty"`
 
@@ -785,6 +948,94 @@ type TemplateBuildStartV2 struct {
 	Steps *[]TemplateStep `json:"steps,omitempty"`
 }
 
+// TemplateDockerfile defines model for TemplateDockerfile.
+type TemplateDockerfile struct {
+	// BuildArgs Values of the build args overriding the defaults of the ARG instructions
+	BuildArgs *map[string]string `json:"buildArgs,omitempty"`
+
+	// Dockerfile Content of the Dockerfile
+	Dockerfile string `json:"dockerfile"`
+}
+
+// TemplateGitSource Git repository cloned in the build sandbox, the COPY steps without the uploaded files copy from it
+type TemplateGitSource struct {
+	// DeployKeySecret ID of the build secret with the SSH deploy key of the repository
+	DeployKeySecret *string `json:"deployKeySecret,omitempty"`
+
+	// DockerfilePath Path of the Dockerfile in the build context, the template is built from it instead of fromImage, fromTemplate, steps and stages
+	DockerfilePath *string `json:"dockerfilePath,omitempty"`
+
+	// Ref Branch, tag or full commit SHA to build, the default branch is used when not set
+	Ref *string `json:"ref,omitempty"`
+
+	// Subdirectory Directory of the repository used as the build context
+	Subdirectory *string `json:"subdirectory,omitempty"`
+
+	// Url URL of the repository, SSH URLs require the deploy key
+	Url string `json:"url"`
+}
+
+// TemplateReadyProbe Readiness probe of the template evaluated after the build and on every sandbox start/resume, exactly one of the checks must be set
+type TemplateReadyProbe struct {
+	// Command Command which must exit with zero code
+	Command *string `json:"command,omitempty"`
+
+	// Http HTTP GET check passing when the response has the expected status
+	Http *TemplateReadyProbeHTTP `json:"http,omitempty"`
+
+	// Interval Seconds between the checks, defaults to 2
+	Interval *int32 `json:"interval,omitempty"`
+
+	// Retries Number of the failed checks after the first one before the sandbox is considered not ready, defaults to 30
+	Retries *int32 `json:"retries,omitempty"`
+
+	// Tcp TCP check passing when the port accepts connections
+	Tcp *TemplateReadyProbeTCP `json:"tcp,omitempty"`
+
+	// Timeout Seconds after which a single check fails, defaults to 5
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
+// TemplateReadyProbeHTTP HTTP GET check passing when the response has the expected status
+type TemplateReadyProbeHTTP struct {
+	// ExpectedStatus Required status of the response, any 2xx status is accepted when not set
+	ExpectedStatus *int32  `json:"expectedStatus,omitempty"`
+	Path           *string `json:"path,omitempty"`
+	Port           int32   `json:"port"`
+}
+
+// TemplateReadyProbeTCP TCP check passing when the port accepts connections
+type TemplateReadyProbeTCP struct {
+	Port int32 `json:"port"`
+}
+
+// TemplateSecretMount Build secret mounted to the RUN step, it's never saved to the template
+type TemplateSecretMount struct {
+	// Env Environment variable set to the secret value
+	Env *string `json:"env,omitempty"`
+
+	// Id ID of the secret in the secrets of the build
+	Id string `json:"id"`
+
+	// Target Path of the file with the secret value, defaults to /run/secrets/<id> when the env is not set
+	Target *string `json:"target,omitempty"`
+}
+
+// TemplateStage Named stage of the multi-stage template build, its files can be copied to the template by the COPY steps
+type TemplateStage struct {
+	// FromImage Image to use as a base for the stage
+	FromImage *string `json:"fromImage,omitempty"`
+
+	// FromTemplate Template to use as a base for the stage
+	FromTemplate *string `json:"fromTemplate,omitempty"`
+
+	// Name Name of the stage referenced by the fromStage of the COPY steps
+	Name string `json:"name"`
+
+	// Steps List of steps to execute in the stage
+	Steps *[]TemplateStep `json:"steps,omitempty"`
+}
+
 // TemplateStep Step in the template build process
 type TemplateStep struct {
 	// Args Arguments for the step
@@ -796,6 +1047,12 @@ type TemplateStep struct {
 	// Force Whether the step should be forced to run regardless of the cache
 	Force *bool `json:"force,omitempty"`
 
+	// FromStage Name of the build stage or alias of the template to copy the files from, only for the COPY steps
+	FromStage *string `json:"fromStage,omitempty"`
+
+	// Secrets Build secrets mounted to the step, only for the RUN steps
+	Secrets *[]TemplateSecretMount `json:"secrets,omitempty"`
+
 	// Type Type of the step
 	Type string `json:"type"`
 }
@@ -821,6 +1078,9 @@ type ApiKeyID = string
 // BuildID defines model for buildID.
 type BuildID = string
 
+// Hostname defines model for hostname.
+type Hostname = string
+
 // NodeID defines model for nodeID.
 type NodeID = string
 
@@ -891,6 +1151,12 @@ type PostSandboxesSandboxIDRefreshesJSONBody struct {
 	Duration *int `json:"duration,omitempty"`
 }
 
+// PostSandboxesSandboxIDRestoreParams defines parameters for PostSandboxesSandboxIDRestore.
+type PostSandboxesSandboxIDRestoreParams struct {
+	// SnapshotID Identifier of the snapshot to restore
+	SnapshotID string `form:"snapshotID" json:"snapshotID"`
+}
+
 // PostSandboxesSandboxIDTimeoutJSONBody defines parameters for PostSandboxesSandboxIDTimeout.
 type PostSandboxesSandboxIDTimeoutJSONBody struct {
 	// Timeout Timeout in seconds from the current time after which the sandbox should expire
@@ -922,6 +1188,13 @@ type GetTemplatesParams struct {
 	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
 }
 
+// GetTemplatesTemplateIDBuildsBuildIDLogsParams defines parameters for GetTemplatesTemplateIDBuildsBuildIDLogs.
+type GetTemplatesTemplateIDBuildsBuildIDLogsParams struct {
+	// LogsOffset Index of the starting build log that should be streamed, the Last-Event-ID header takes precedence when resuming the stream
+	LogsOffset *int32    `form:"logsOffset,omitempty" json:"logsOffset,omitempty"`
+	Level      *LogLevel `form:"level,omitempty" json:"level,omitempty"`
+}
+
 // GetTemplatesTemplateIDBuildsBuildIDStatusParams defines parameters for GetTemplatesTemplateIDBuildsBuildIDStatus.
 type GetTemplatesTemplateIDBuildsBuildIDStatusParams struct {
 	// LogsOffset Index of the starting build log that should be returned with the template
@@ -959,6 +1232,18 @@ type PostNodesNodeIDJSONRequestBody = NodeStatusChange
 // PostSandboxesJSONRequestBody defines body for PostSandboxes for application/json ContentType.
 type PostSandboxesJSONRequestBody = NewSandbox
 
+// PostSandboxesSandboxIDForkJSONRequestBody defines body for PostSandboxesSandboxIDFork for application/json ContentType.
+type PostSandboxesSandboxIDForkJSONRequestBody = ForkedSandbox
+
+// PostSandboxesSandboxIDHostnamesJSONRequestBody defines body for PostSandboxesSandboxIDHostnames for application/json ContentType.
+type PostSandboxesSandboxIDHostnamesJSONRequestBody = SandboxHostname
+
+// PatchSandboxesSandboxIDNetworkJSONRequestBody defines body for PatchSandboxesSandboxIDNetwork for application/json ContentType.
+type PatchSandboxesSandboxIDNetworkJSONRequestBody = SandboxNetworkUpdate
+
+// PatchSandboxesSandboxIDPortsJSONRequestBody defines body for PatchSandboxesSandboxIDPorts for application/json ContentType.
+type PatchSandboxesSandboxIDPortsJSONRequestBody = SandboxPortsUpdate
+
 // PostSandboxesSandboxIDRefreshesJSONRequestBody defines body for PostSandboxesSandboxIDRefreshes for application/json ContentType.
 type PostSandboxesSandboxIDRefreshesJSONRequestBody PostSandboxesSandboxIDRefreshesJSONBody
 
@@ -980,6 +1265,9 @@ type PostTemplatesTemplateIDJSONRequestBody = TemplateBuildRequest
 // PostV2TemplatesJSONRequestBody defines body for PostV2Templates for application/json ContentType.
 type PostV2TemplatesJSONRequestBody = TemplateBuildRequestV2
 
+// PostV2TemplatesDockerfileJSONRequestBody defines body for PostV2TemplatesDockerfile for application/json ContentType.
+type PostV2TemplatesDockerfileJSONRequestBody = TemplateDockerfile
+
 // PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody defines body for PostV2TemplatesTemplateIDBuildsBuildID for application/json ContentType.
 type PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody = TemplateBuildStartV2
 
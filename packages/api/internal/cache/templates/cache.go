@@ -213,6 +213,22 @@ func (c *TemplatesBuildCache) SetStatus(buildID uuid.UUID, status envbuild.Statu
 	)
 }
 
+// SetNode changes the builder node of the build, the queued builds are moved to the builder with a free slot when dispatched.
+func (c *TemplatesBuildCache) SetNode(buildID uuid.UUID, nodeID string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	cacheItem := c.cache.Get(buildID)
+	if cacheItem == nil {
+		return
+	}
+
+	item := cacheItem.Value()
+	item.NodeID = nodeID
+
+	_ = c.cache.Set(buildID, item, templateInfoExpiration)
+}
+
 func (c *TemplatesBuildCache) Get(ctx context.Context, buildID uuid.UUID, templateID string) (TemplateBuildInfo, error) {
 	item := c.cache.Get(buildID)
 	if item == nil {
@@ -257,7 +273,7 @@ func (c *TemplatesBuildCache) GetRunningBuildsForTeam(teamID uuid.UUID) []Templa
 	var builds []TemplateBuildInfo
 	for _, item := range c.cache.Items() {
 		value := item.Value()
-		isRunning := value.BuildStatus == envbuild.StatusBuilding || value.BuildStatus == envbuild.StatusWaiting
+		isRunning := value.BuildStatus == envbuild.StatusBuilding || value.BuildStatus == envbuild.StatusWaiting || value.BuildStatus == envbuild.StatusQueued
 		if value.TeamID == teamID && isRunning {
 			builds = append(builds, value)
 		}
@@ -32,6 +32,9 @@ type Config struct {
 	// tokens signed with the old secret for some time.
 	SupabaseJWTSecrets []string `env:"SUPABASE_JWT_SECRETS"`
 
+	// TemplateBuilderMaxBuilds is the number of the builds running concurrently on a single builder node, the other builds wait in the queue.
+	TemplateBuilderMaxBuilds int64 `env:"TEMPLATE_BUILDER_MAX_BUILDS" envDefault:"4"`
+
 	TemplateManagerHost string `env:"TEMPLATE_MANAGER_HOST"`
 }
 
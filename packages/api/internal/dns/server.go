@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 	e2bcatalog "github.com/e2b-dev/infra/packages/shared/pkg/sandbox-catalog"
 )
 
@@ -29,10 +30,15 @@ const defaultRoutingIP = "127.0.0.1"
 
 const cachedDnsPrefix = "sandbox.dns."
 
+// cachedTeamNetworkPrefix is the prefix of the team network names, the names map to the sandbox IDs.
+const cachedTeamNetworkPrefix = "sandbox.dns.team."
+
 type DNS struct {
 	srv        *resolver.Server
 	redisCache *cache.Cache
 	catalog    e2bcatalog.SandboxesCatalog
+	// teamNetworkCache isn't cached locally when Redis is used, the removed names must not be resolved by the other API instances.
+	teamNetworkCache *cache.Cache
 
 	closer struct {
 		once sync.Once
@@ -47,8 +53,10 @@ func New(ctx context.Context, redisClient redis.UniversalClient) *DNS {
 	if redisClient != nil && !reflect.ValueOf(redisClient).IsNil() {
 		d.redisCache = cache.New(&cache.Options{Redis: redisClient, LocalCache: cache.NewTinyLFU(10_000, time.Hour)})
 		d.catalog = e2bcatalog.NewRedisSandboxesCatalog(redisClient)
+		d.teamNetworkCache = cache.New(&cache.Options{Redis: redisClient})
 	} else {
 		d.catalog = e2bcatalog.NewMemorySandboxesCatalog()
+		d.teamNetworkCache = cache.New(&cache.Options{LocalCache: cache.NewTinyLFU(10_000, redisTTL)})
 	}
 
 	return d
@@ -87,6 +95,66 @@ func (d *DNS) Remove(ctx context.Context, sandboxID, executionID string) {
 	}
 }
 
+// ClaimHostname routes the custom hostname to the sandbox port, unless it's registered by another team.
+// The previous registration of the hostname is returned, nil when there was none.
+func (d *DNS) ClaimHostname(ctx context.Context, hostname string, info e2bcatalog.HostnameInfo, lifetime time.Duration) (*e2bcatalog.HostnameInfo, error) {
+	return d.catalog.ClaimHostname(ctx, hostname, &info, lifetime)
+}
+
+func (d *DNS) RemoveHostname(ctx context.Context, hostname, sandboxID string) {
+	err := d.catalog.DeleteHostname(ctx, hostname, sandboxID)
+	if err != nil {
+		zap.L().Error("error removing hostname from catalog", zap.Error(err), logger.WithSandboxID(sandboxID), zap.String("hostname", hostname))
+	}
+}
+
+// GetTeamNetworkName returns the ID of the sandbox with the name in the team network, empty when the name isn't registered.
+func (d *DNS) GetTeamNetworkName(ctx context.Context, network proxy.TeamNetwork, name string) (string, error) {
+	var sandboxID string
+
+	err := d.teamNetworkCache.Get(ctx, teamNetworkCacheKey(network, name), &sandboxID)
+	if errors.Is(err, cache.ErrCacheMiss) {
+		return "", nil
+	}
+
+	return sandboxID, err
+}
+
+// AddTeamNetworkName registers the sandbox name in the team network, the name of another sandbox is replaced.
+func (d *DNS) AddTeamNetworkName(ctx context.Context, network proxy.TeamNetwork, name, sandboxID string, lifetime time.Duration) {
+	err := d.teamNetworkCache.Set(&cache.Item{
+		Ctx:   ctx,
+		TTL:   lifetime,
+		Key:   teamNetworkCacheKey(network, name),
+		Value: sandboxID,
+	})
+	if err != nil {
+		zap.L().Error("error adding team network name", zap.Error(err), logger.WithSandboxID(sandboxID), zap.String("name", name))
+	}
+}
+
+// RemoveTeamNetworkName removes the sandbox name from the team network, if the name is still registered for the sandbox.
+func (d *DNS) RemoveTeamNetworkName(ctx context.Context, network proxy.TeamNetwork, name, sandboxID string) {
+	registered, err := d.GetTeamNetworkName(ctx, network, name)
+	if err != nil {
+		zap.L().Error("error getting team network name", zap.Error(err), logger.WithSandboxID(sandboxID), zap.String("name", name))
+
+		return
+	}
+
+	if registered != sandboxID {
+		return
+	}
+
+	if err := d.teamNetworkCache.Delete(ctx, teamNetworkCacheKey(network, name)); err != nil {
+		zap.L().Error("error removing team network name", zap.Error(err), logger.WithSandboxID(sandboxID), zap.String("name", name))
+	}
+}
+
+func teamNetworkCacheKey(network proxy.TeamNetwork, name string) string {
+	return cachedTeamNetworkPrefix + network.QueryName(name)
+}
+
 func (d *DNS) Get(ctx context.Context, sandboxID string) net.IP {
 	var res string
 
@@ -136,6 +204,12 @@ func (d *DNS) handleDNSRequest(ctx context.Context, w resolver.ResponseWriter, r
 	m.SetReply(r)
 
 	for _, q := range m.Question {
+		if network, name, ok := proxy.ParseTeamNetworkQueryName(q.Name); ok {
+			d.answerTeamNetwork(ctx, m, q, network, name)
+
+			continue
+		}
+
 		if q.Qtype == resolver.TypeA {
 			sandboxID := strings.Split(q.Name, "-")[0]
 
@@ -156,6 +230,47 @@ func (d *DNS) handleDNSRequest(ctx context.Context, w resolver.ResponseWriter, r
 	}
 }
 
+// answerTeamNetwork answers with the address of the node running the sandbox with the name in the team network,
+// the sandbox ID is in the additional TXT record, so the orchestrator can route the connection to the sandbox.
+func (d *DNS) answerTeamNetwork(ctx context.Context, m *resolver.Msg, q resolver.Question, network proxy.TeamNetwork, name string) {
+	sandboxID, err := d.GetTeamNetworkName(ctx, network, name)
+	if err != nil {
+		zap.L().Error("resolving team network name", zap.Error(err), zap.String("name", q.Name))
+		m.Rcode = resolver.RcodeServerFailure
+
+		return
+	}
+
+	if sandboxID == "" {
+		m.Rcode = resolver.RcodeNameError
+
+		return
+	}
+
+	if q.Qtype != resolver.TypeA {
+		return
+	}
+
+	m.Answer = append(m.Answer, &resolver.A{
+		Hdr: resolver.RR_Header{
+			Name:   q.Name,
+			Rrtype: resolver.TypeA,
+			Class:  resolver.ClassINET,
+			Ttl:    ttl,
+		},
+		A: d.Get(ctx, sandboxID),
+	})
+	m.Extra = append(m.Extra, &resolver.TXT{
+		Hdr: resolver.RR_Header{
+			Name:   q.Name,
+			Rrtype: resolver.TypeTXT,
+			Class:  resolver.ClassINET,
+			Ttl:    ttl,
+		},
+		Txt: []string{sandboxID},
+	})
+}
+
 var errOnStartup = errors.New("failed to start DNS server")
 
 func (d *DNS) Start(ctx context.Context, address string, port uint16) {
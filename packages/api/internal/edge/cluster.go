@@ -133,17 +133,19 @@ func (c *Cluster) GetAvailableTemplateBuilder(ctx context.Context) (*ClusterInst
 	span.SetAttributes(telemetry.WithClusterID(c.ID))
 	defer span.End()
 
-	var instances []*ClusterInstance
-	for _, instance := range c.instances.Items() {
-		instances = append(instances, instance)
+	instances := c.GetAvailableTemplateBuilders()
+	if len(instances) == 0 {
+		return nil, ErrAvailableTemplateBuilderNotFound
 	}
 
-	// Make sure we will always iterate in different order and when there is bigger amount of builders, we will not always pick the same one
-	rand.Shuffle(len(instances), func(i, j int) {
-		instances[i], instances[j] = instances[j], instances[i]
-	})
+	// Make sure when there is bigger amount of builders, we will not always pick the same one
+	return instances[rand.IntN(len(instances))], nil
+}
 
-	for _, instance := range instances {
+// GetAvailableTemplateBuilders returns all healthy template builders of the cluster.
+func (c *Cluster) GetAvailableTemplateBuilders() []*ClusterInstance {
+	var instances []*ClusterInstance
+	for _, instance := range c.instances.Items() {
 		if instance.GetStatus() != infogrpc.ServiceInfoStatus_Healthy {
 			continue
 		}
@@ -152,10 +154,10 @@ func (c *Cluster) GetAvailableTemplateBuilder(ctx context.Context) (*ClusterInst
 			continue
 		}
 
-		return instance, nil
+		instances = append(instances, instance)
 	}
 
-	return nil, ErrAvailableTemplateBuilderNotFound
+	return instances
 }
 
 func (c *Cluster) GetGRPC(serviceInstanceID string) *ClusterGRPC {
@@ -125,6 +125,12 @@ func (a *APIStore) DeleteApiKeysApiKeyID(c *gin.Context, apiKeyID string) {
 		return
 	}
 
+	// The deleted API key must stop granting access to the sandbox ports.
+	err = a.orchestrator.RefreshTeamAPIKeyHashes(ctx, teamID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when refreshing API keys of the sandbox ports", err)
+	}
+
 	c.Status(http.StatusNoContent)
 }
 
@@ -152,6 +158,11 @@ func (a *APIStore) PostApiKeys(c *gin.Context) {
 		return
 	}
 
+	err = a.orchestrator.RefreshTeamAPIKeyHashes(ctx, teamID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when refreshing API keys of the sandbox ports", err)
+	}
+
 	user, err := a.db.Client.User.Get(ctx, userID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when getting user: %s", err))
@@ -149,6 +149,23 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	allowInternetAccess := body.AllowInternetAccess
 
+	network, err := parseSandboxNetwork(body.Network)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid network configuration: %s", err))
+		return
+	}
+
+	if envdAccessToken == nil && network != nil && requiresAccessToken(network.Ports) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Ports requiring the access token can be set only for secure sandboxes")
+		return
+	}
+
+	checkpoint, err := parseSandboxCheckpoint(body.Checkpoint)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid checkpoint configuration: %s", err))
+		return
+	}
+
 	sbx, createErr := a.startSandbox(
 		ctx,
 		sandboxID,
@@ -165,6 +182,8 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		autoPause,
 		envdAccessToken,
 		allowInternetAccess,
+		network,
+		checkpoint,
 	)
 	if createErr != nil {
 		zap.L().Error("Failed to create sandbox", zap.Error(createErr.Err))
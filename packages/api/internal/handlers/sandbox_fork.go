@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	forkCountDefault = 1
+	forkCountMax     = 10
+)
+
+func (a *APIStore) PostSandboxesSandboxIDFork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	// Get team from context, use TeamContextKey
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDForkJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	count := forkCountDefault
+	if body.Count != nil {
+		count = int(*body.Count)
+	}
+
+	if count < 1 || count > forkCountMax {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Count must be between 1 and %d", forkCountMax))
+
+		return
+	}
+
+	keepSource := true
+	if body.KeepSource != nil {
+		keepSource = *body.KeepSource
+	}
+
+	timeout := sandbox.SandboxTimeoutDefault
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, false)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return
+	}
+
+	if sbx.TeamID != teamInfo.Team.ID {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to sandbox \"%s\"", sandboxID))
+
+		return
+	}
+
+	if sbx.State != sandbox.StateRunning {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return
+	}
+
+	targets := make([]orchestrator.ForkTarget, 0, count)
+	for range count {
+		target := orchestrator.ForkTarget{
+			SandboxID: InstanceIDPrefix + id.Generate(),
+		}
+
+		if sbx.EnvdAccessToken != nil {
+			accessToken, tokenErr := a.envdAccessTokenGenerator.GenerateAccessToken(target.SandboxID)
+			if tokenErr != nil {
+				zap.L().Error("Secure envd access token error", zap.Error(tokenErr), logger.WithSandboxID(target.SandboxID))
+				a.sendAPIStoreError(c, http.StatusInternalServerError, "error during sandbox access token generation")
+
+				return
+			}
+
+			target.EnvdAccessToken = &accessToken
+		}
+
+		targets = append(targets, target)
+	}
+
+	sbxlogger.E(sbx).Debug("Started forking sandbox", zap.Int("count", count), zap.Bool("keep_source", keepSource))
+
+	sandboxes, forkErr := a.orchestrator.ForkSandbox(ctx, sbx, teamInfo, targets, keepSource, timeout)
+	if forkErr != nil {
+		zap.L().Error("Failed to fork sandbox", zap.Error(forkErr.Err), logger.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, forkErr.Code, forkErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, sandboxes)
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+var hostnameLabelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+func (a *APIStore) GetSandboxesSandboxIDHostnames(c *gin.Context, sandboxID api.SandboxID) {
+	sbx, ok := a.getTeamSandbox(c, sandboxID)
+	if !ok {
+		return
+	}
+
+	hostnames := make([]api.SandboxHostname, 0, len(sbx.Hostnames))
+	for hostname, port := range sbx.Hostnames {
+		hostnames = append(hostnames, api.SandboxHostname{
+			Hostname: hostname,
+			Port:     int32(port),
+		})
+	}
+
+	slices.SortFunc(hostnames, func(a, b api.SandboxHostname) int {
+		return strings.Compare(a.Hostname, b.Hostname)
+	})
+
+	c.JSON(http.StatusOK, hostnames)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDHostnames(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDHostnamesJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	hostname, err := parseHostname(body.Hostname)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid hostname: %s", err))
+
+		return
+	}
+
+	if body.Port < 1 || body.Port > 65535 {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid port %d", body.Port))
+
+		return
+	}
+
+	sbx, ok := a.getTeamSandbox(c, sandboxID)
+	if !ok {
+		return
+	}
+
+	apiErr := a.orchestrator.AddSandboxHostname(ctx, sbx, hostname, uint64(body.Port))
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when adding sandbox hostname", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.SandboxHostname{
+		Hostname: hostname,
+		Port:     body.Port,
+	})
+}
+
+func (a *APIStore) DeleteSandboxesSandboxIDHostnamesHostname(c *gin.Context, sandboxID api.SandboxID, hostname api.Hostname) {
+	ctx := c.Request.Context()
+
+	sbx, ok := a.getTeamSandbox(c, sandboxID)
+	if !ok {
+		return
+	}
+
+	apiErr := a.orchestrator.RemoveSandboxHostname(ctx, sbx, proxy.NormalizeHostname(hostname))
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when removing sandbox hostname", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// getTeamSandbox returns the running sandbox of the team, the error response is sent when it's not found.
+func (a *APIStore) getTeamSandbox(c *gin.Context, sandboxID api.SandboxID) (sandbox.Sandbox, bool) {
+	sandboxID = utils.ShortID(sandboxID)
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, false)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return sandbox.Sandbox{}, false
+	}
+
+	if sbx.TeamID != teamID {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to sandbox \"%s\"", sandboxID))
+
+		return sandbox.Sandbox{}, false
+	}
+
+	return sbx, true
+}
+
+// parseHostname validates the custom hostname, the hostnames in the sandbox host format are reserved for the sandbox routing.
+func parseHostname(hostname string) (string, error) {
+	hostname = proxy.NormalizeHostname(hostname)
+	if len(hostname) > 253 {
+		return "", fmt.Errorf("hostname is too long")
+	}
+
+	labels := strings.Split(hostname, ".")
+	if len(labels) < 2 {
+		return "", fmt.Errorf("hostname '%s' must have a domain", hostname)
+	}
+
+	for _, label := range labels {
+		if !hostnameLabelRegex.MatchString(label) {
+			return "", fmt.Errorf("hostname '%s' is not a valid DNS name", hostname)
+		}
+	}
+
+	if _, _, err := proxy.ParseHost(hostname); err == nil {
+		return "", fmt.Errorf("hostname '%s' is reserved for the sandbox routing", hostname)
+	}
+
+	return hostname, nil
+}
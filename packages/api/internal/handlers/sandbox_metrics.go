@@ -70,17 +70,38 @@ func (a *APIStore) GetSandboxesSandboxIDMetrics(c *gin.Context, sandboxID string
 		return
 	}
 
+	networkUsage, err := a.clickhouseStore.QuerySandboxNetworkUsage(ctx, sandboxID, team.ID.String(), start, end, step)
+	if err != nil {
+		zap.L().Error("Error fetching sandbox network usage from ClickHouse",
+			logger.WithSandboxID(sandboxID),
+			logger.WithTeamID(team.ID.String()),
+			zap.Error(err),
+		)
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("error querying sandbox network usage: %s", err))
+		return
+	}
+
+	// The network usage is only recorded for the steps with traffic, the total from the last one is used for the following steps.
+	var usage clickhouse.SandboxNetworkUsageTotal
 	apiMetrics := make([]api.SandboxMetric, len(metrics))
 	for i, m := range metrics {
+		for len(networkUsage) > 0 && !networkUsage[0].Timestamp.After(m.Timestamp) {
+			usage = networkUsage[0]
+			networkUsage = networkUsage[1:]
+		}
+
 		apiMetrics[i] = api.SandboxMetric{
-			Timestamp:     m.Timestamp,
-			TimestampUnix: m.Timestamp.Unix(),
-			CpuUsedPct:    float32(m.CPUUsedPercent),
-			CpuCount:      int32(m.CPUCount),
-			MemTotal:      int64(m.MemTotal),
-			MemUsed:       int64(m.MemUsed),
-			DiskTotal:     int64(m.DiskTotal),
-			DiskUsed:      int64(m.DiskUsed),
+			Timestamp:      m.Timestamp,
+			TimestampUnix:  m.Timestamp.Unix(),
+			CpuUsedPct:     float32(m.CPUUsedPercent),
+			CpuCount:       int32(m.CPUCount),
+			MemTotal:       int64(m.MemTotal),
+			MemUsed:        int64(m.MemUsed),
+			DiskTotal:      int64(m.DiskTotal),
+			DiskUsed:       int64(m.DiskUsed),
+			NetworkIngress: int64(usage.IngressBytes),
+			NetworkEgress:  int64(usage.EgressBytes),
 		}
 	}
 
@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+func (a *APIStore) PatchSandboxesSandboxIDNetwork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	body, err := utils.ParseBody[api.PatchSandboxesSandboxIDNetworkJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	egress, err := parseSandboxEgress(body.Egress)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid network configuration: %s", err))
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, false)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return
+	}
+
+	if sbx.TeamID != teamID {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to sandbox \"%s\"", sandboxID))
+
+		return
+	}
+
+	network := &types.SandboxNetworkConfig{Egress: egress}
+	if sbx.Network != nil {
+		// The access rules of the ports are updated separately and the team network can be set only when the sandbox is created.
+		network.Ports = sbx.Network.Ports
+		network.TeamNetwork = sbx.Network.TeamNetwork
+	}
+
+	apiErr := a.orchestrator.UpdateSandboxNetwork(ctx, sbx, body.AllowInternetAccess, network)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when updating sandbox network", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) PatchSandboxesSandboxIDPorts(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	body, err := utils.ParseBody[api.PatchSandboxesSandboxIDPortsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	ports, err := parsePortRules(body.Ports)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid port rules: %s", err))
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, false)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" is not running", sandboxID))
+
+		return
+	}
+
+	if sbx.TeamID != teamID {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to sandbox \"%s\"", sandboxID))
+
+		return
+	}
+
+	if sbx.EnvdAccessToken == nil && requiresAccessToken(ports) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Ports requiring the access token can be set only for secure sandboxes")
+
+		return
+	}
+
+	apiErr := a.orchestrator.UpdateSandboxPorts(ctx, sbx, ports)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when updating sandbox ports", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseSandboxNetwork validates the network configuration from the request.
+// The allowed destinations are validated again by the orchestrator, which also rejects the internal ranges.
+func parseSandboxNetwork(network *api.SandboxNetworkConfig) (*types.SandboxNetworkConfig, error) {
+	if network == nil {
+		return nil, nil
+	}
+
+	egress, err := parseSandboxEgress(network.Egress)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := parsePortRules(sharedUtils.FromPtr(network.Ports))
+	if err != nil {
+		return nil, err
+	}
+
+	teamNetwork, err := parseTeamNetwork(network.TeamNetwork)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SandboxNetworkConfig{Egress: egress, Ports: ports, TeamNetwork: teamNetwork}, nil
+}
+
+// parseTeamNetwork validates the team network configuration from the request, the name and the group must be DNS labels.
+func parseTeamNetwork(config *api.SandboxTeamNetworkConfig) (*types.SandboxTeamNetworkConfig, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	teamNetwork := &types.SandboxTeamNetworkConfig{
+		Name:  strings.ToLower(sharedUtils.FromPtr(config.Name)),
+		Group: strings.ToLower(sharedUtils.FromPtr(config.Group)),
+	}
+
+	if teamNetwork.Name != "" && !hostnameLabelRegex.MatchString(teamNetwork.Name) {
+		return nil, fmt.Errorf("team network name '%s' is not a valid DNS label", teamNetwork.Name)
+	}
+
+	if teamNetwork.Group != "" && !hostnameLabelRegex.MatchString(teamNetwork.Group) {
+		return nil, fmt.Errorf("team network group '%s' is not a valid DNS label", teamNetwork.Group)
+	}
+
+	return teamNetwork, nil
+}
+
+// parsePortRules validates the access rules of the sandbox ports from the request.
+// The envd port is always protected by the envd access token, so it can't have a rule.
+func parsePortRules(rules []api.SandboxPortRule) ([]types.SandboxPortRule, error) {
+	seen := make(map[int32]bool, len(rules))
+	ports := make([]types.SandboxPortRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Port < 1 || rule.Port > 65535 {
+			return nil, fmt.Errorf("invalid port %d", rule.Port)
+		}
+
+		if int64(rule.Port) == consts.DefaultEnvdServerPort {
+			return nil, fmt.Errorf("port %d is reserved", rule.Port)
+		}
+
+		if seen[rule.Port] {
+			return nil, fmt.Errorf("duplicate rule for port %d", rule.Port)
+		}
+		seen[rule.Port] = true
+
+		switch rule.Visibility {
+		case api.Public, api.AccessToken, api.ApiKey, api.Blocked:
+		default:
+			return nil, fmt.Errorf("invalid visibility '%s' for port %d", rule.Visibility, rule.Port)
+		}
+
+		ports = append(ports, types.SandboxPortRule{
+			Port:       uint32(rule.Port),
+			Visibility: string(rule.Visibility),
+		})
+	}
+
+	return ports, nil
+}
+
+// requiresAccessToken checks if any of the ports requires the envd access token of the sandbox.
+func requiresAccessToken(ports []types.SandboxPortRule) bool {
+	for _, rule := range ports {
+		if rule.Visibility == string(api.AccessToken) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseSandboxEgress validates the egress policy from the request, it returns nil when there is no policy.
+func parseSandboxEgress(egress *api.SandboxNetworkEgressConfig) (*types.SandboxNetworkEgressConfig, error) {
+	if egress == nil {
+		return nil, nil
+	}
+
+	config := &types.SandboxNetworkEgressConfig{}
+	for _, cidr := range sharedUtils.FromPtr(egress.AllowedCidrs) {
+		var addr netip.Addr
+		if strings.Contains(cidr, "/") {
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed CIDR '%s'", cidr)
+			}
+
+			addr = prefix.Addr()
+		} else {
+			parsed, err := netip.ParseAddr(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed CIDR '%s'", cidr)
+			}
+
+			addr = parsed
+		}
+
+		if !addr.Is4() {
+			return nil, fmt.Errorf("allowed CIDR '%s' is not an IPv4 CIDR", cidr)
+		}
+
+		config.AllowedCIDRs = append(config.AllowedCIDRs, cidr)
+	}
+
+	for _, domain := range sharedUtils.FromPtr(egress.AllowedDomains) {
+		if strings.TrimPrefix(strings.TrimSpace(domain), "*.") == "" {
+			return nil, fmt.Errorf("invalid allowed domain '%s'", domain)
+		}
+
+		config.AllowedDomains = append(config.AllowedDomains, domain)
+	}
+
+	for _, port := range sharedUtils.FromPtr(egress.AllowedPorts) {
+		if port < 1 || port > 65535 {
+			return nil, fmt.Errorf("invalid allowed port %d", port)
+		}
+
+		config.AllowedPorts = append(config.AllowedPorts, uint32(port))
+	}
+
+	return config, nil
+}
@@ -147,6 +147,8 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		autoPause,
 		envdAccessToken,
 		snap.AllowInternetAccess,
+		snap.Network,
+		snap.Checkpoint,
 	)
 
 	if createErr != nil {
@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	checkpointIntervalMin      = 60 * time.Second
+	checkpointRetentionMax     = 100
+	checkpointRetentionDefault = 5
+)
+
+func (a *APIStore) GetSandboxesSandboxIDSnapshots(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	checkpoints, err := a.sqlcDB.GetSnapshotCheckpoints(ctx, queries.GetSnapshotCheckpointsParams{SandboxID: sandboxID, TeamID: teamID})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting sandbox snapshots", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting sandbox snapshots")
+
+		return
+	}
+
+	if len(checkpoints) == 0 {
+		// The running sandbox without any checkpoint yet has no snapshots to list.
+		sbx, err := a.orchestrator.GetSandbox(sandboxID, false)
+		if err != nil || sbx.TeamID != teamID {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox \"%s\" doesn't exist or you don't have access to it", sandboxID))
+
+			return
+		}
+	}
+
+	snapshots := make([]api.SandboxSnapshot, 0, len(checkpoints))
+	for _, row := range checkpoints {
+		snapshot := api.SandboxSnapshot{
+			SnapshotID: row.SnapshotCheckpoint.BuildID.String(),
+			CreatedAt:  row.SnapshotCheckpoint.CreatedAt,
+		}
+
+		if row.SnapshotCheckpoint.ParentBuildID != nil {
+			parentID := row.SnapshotCheckpoint.ParentBuildID.String()
+			snapshot.ParentSnapshotID = &parentID
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	c.JSON(http.StatusOK, snapshots)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDRestore(c *gin.Context, sandboxID api.SandboxID, params api.PostSandboxesSandboxIDRestoreParams) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamInfo := a.GetTeamInfo(c)
+
+	buildID, err := uuid.Parse(params.SnapshotID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid snapshot ID: %s", err))
+
+		return
+	}
+
+	checkpoint, err := a.sqlcDB.GetSnapshotCheckpoint(ctx, queries.GetSnapshotCheckpointParams{
+		BuildID:   buildID,
+		SandboxID: sandboxID,
+		TeamID:    teamInfo.Team.ID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Snapshot \"%s\" of sandbox \"%s\" not found", params.SnapshotID, sandboxID))
+
+			return
+		}
+
+		zap.L().Error("Error getting sandbox snapshot", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting snapshot")
+
+		return
+	}
+
+	snap := checkpoint.Snapshot
+	build := checkpoint.EnvBuild
+
+	alias := ""
+	if len(checkpoint.Aliases) > 0 {
+		alias = checkpoint.Aliases[0]
+	}
+
+	sbxlogger.E(&sbxlogger.SandboxMetadata{
+		SandboxID:  sandboxID,
+		TemplateID: build.EnvID,
+		TeamID:     teamInfo.Team.ID.String(),
+	}).Debug("Started restoring sandbox", logger.WithBuildID(build.ID.String()))
+
+	var envdAccessToken *string = nil
+	if snap.EnvSecure {
+		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, sandboxID)
+		if tokenErr != nil {
+			zap.L().Error("Secure envd access token error", zap.Error(tokenErr.Err), logger.WithTemplateID(build.EnvID), logger.WithBuildID(build.ID.String()))
+			a.sendAPIStoreError(c, tokenErr.Code, tokenErr.ClientMsg)
+
+			return
+		}
+
+		envdAccessToken = &accessToken
+	}
+
+	// The snapshots of the restored sandbox are based on the checkpoint build, so it has to be kept after the checkpoint expires.
+	err = a.sqlcDB.MarkSnapshotCheckpointRestored(ctx, buildID)
+	if err != nil {
+		zap.L().Error("Error marking the snapshot as restored", logger.WithSandboxID(sandboxID), logger.WithBuildID(buildID.String()), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when restoring snapshot")
+
+		return
+	}
+
+	timeout := sandbox.SandboxTimeoutDefault
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, true)
+	if err == nil {
+		switch sbx.State {
+		case sandbox.StatePausing:
+			zap.L().Debug("Waiting for sandbox to pause", logger.WithSandboxID(sandboxID))
+			err = a.orchestrator.WaitForStateChange(ctx, sandboxID)
+			if err != nil {
+				a.sendAPIStoreError(c, http.StatusInternalServerError, "Error waiting for sandbox to pause")
+
+				return
+			}
+		case sandbox.StateKilling:
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox %s is being killed", sandboxID))
+
+			return
+		case sandbox.StateRunning:
+			// The restored sandbox replaces the running one and keeps its remaining timeout.
+			// The running sandbox is paused instead of killed, so when the restore fails, it can still be resumed.
+			if remaining := time.Until(sbx.EndTime); remaining > 0 {
+				timeout = remaining
+			}
+
+			err = a.orchestrator.RemoveSandbox(ctx, sbx, sandbox.StateActionPause)
+			if err != nil {
+				telemetry.ReportError(ctx, "error pausing sandbox before restore", err)
+				a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error stopping the running sandbox: %s", err))
+
+				return
+			}
+		default:
+			zap.L().Error("Sandbox is in an unknown state", logger.WithSandboxID(sandboxID), zap.String("state", string(sbx.State)))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Sandbox is in an unknown state")
+
+			return
+		}
+	}
+
+	// The checkpoint is cached on the node where it was created.
+	nodeID := &build.ClusterNodeID
+
+	restored, createErr := a.startSandbox(
+		ctx,
+		snap.SandboxID,
+		timeout,
+		nil,
+		snap.Metadata,
+		alias,
+		teamInfo,
+		build,
+		&c.Request.Header,
+		true,
+		nodeID,
+		snap.BaseEnvID,
+		snap.AutoPause,
+		envdAccessToken,
+		snap.AllowInternetAccess,
+		snap.Network,
+		snap.Checkpoint,
+	)
+	if createErr != nil {
+		zap.L().Error("Failed to restore sandbox", zap.Error(createErr.Err))
+		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, &restored)
+}
+
+// parseSandboxCheckpoint validates the scheduled checkpoints configuration, the retention defaults to the last 5 checkpoints.
+func parseSandboxCheckpoint(checkpoint *api.SandboxCheckpointConfig) (*types.SandboxCheckpointConfig, error) {
+	if checkpoint == nil {
+		return nil, nil
+	}
+
+	if time.Duration(checkpoint.Interval)*time.Second < checkpointIntervalMin {
+		return nil, fmt.Errorf("interval must be at least %d seconds", int(checkpointIntervalMin.Seconds()))
+	}
+
+	retention := int32(checkpointRetentionDefault)
+	if checkpoint.Retention != nil {
+		retention = *checkpoint.Retention
+	}
+
+	if retention < 1 || retention > checkpointRetentionMax {
+		return nil, fmt.Errorf("retention must be between 1 and %d", checkpointRetentionMax)
+	}
+
+	return &types.SandboxCheckpointConfig{
+		IntervalSeconds: uint32(checkpoint.Interval),
+		Retention:       uint32(retention),
+	}, nil
+}
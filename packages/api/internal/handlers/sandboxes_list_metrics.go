@@ -14,6 +14,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
 	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -61,17 +62,35 @@ func (a *APIStore) getSandboxesMetrics(
 		return nil, fmt.Errorf("error querying metrics: %w", err)
 	}
 
+	networkUsage, err := a.clickhouseStore.QuerySandboxesNetworkUsage(ctx, sandboxIDs, teamID.String())
+	if err != nil {
+		zap.L().Error("Error fetching sandboxes network usage from ClickHouse",
+			logger.WithTeamID(teamID.String()),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("error querying network usage: %w", err)
+	}
+
+	usageBySandbox := make(map[string]clickhouse.SandboxNetworkUsageTotal, len(networkUsage))
+	for _, u := range networkUsage {
+		usageBySandbox[u.SandboxID] = u
+	}
+
 	apiMetrics := make(map[string]api.SandboxMetric)
 	for _, m := range metrics {
+		usage := usageBySandbox[m.SandboxID]
 		apiMetrics[m.SandboxID] = api.SandboxMetric{
-			Timestamp:     m.Timestamp,
-			TimestampUnix: m.Timestamp.Unix(),
-			CpuUsedPct:    float32(m.CPUUsedPercent),
-			CpuCount:      int32(m.CPUCount),
-			MemTotal:      int64(m.MemTotal),
-			MemUsed:       int64(m.MemUsed),
-			DiskTotal:     int64(m.DiskTotal),
-			DiskUsed:      int64(m.DiskUsed),
+			Timestamp:      m.Timestamp,
+			TimestampUnix:  m.Timestamp.Unix(),
+			CpuUsedPct:     float32(m.CPUUsedPercent),
+			CpuCount:       int32(m.CPUCount),
+			MemTotal:       int64(m.MemTotal),
+			MemUsed:        int64(m.MemUsed),
+			DiskTotal:      int64(m.DiskTotal),
+			DiskUsed:       int64(m.DiskUsed),
+			NetworkIngress: int64(usage.IngressBytes),
+			NetworkEgress:  int64(usage.EgressBytes),
 		}
 	}
 
@@ -138,11 +138,6 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, config cfg.Config)
 		zap.L().Fatal("failed to create feature flags client", zap.Error(err))
 	}
 
-	orch, err := orchestrator.New(ctx, config, tel, nomadClient, posthogClient, redisClient, dbClient, sqlcDB, clustersPool, featureFlags)
-	if err != nil {
-		zap.L().Fatal("Initializing Orchestrator client", zap.Error(err))
-	}
-
 	authCache := authcache.NewTeamAuthCache()
 	templateCache := templatecache.NewTemplateCache(sqlcDB)
 	templateSpawnCounter := utils.NewTemplateSpawnCounter(ctx, time.Minute, sqlcDB)
@@ -158,9 +153,17 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, config cfg.Config)
 		zap.L().Fatal("Initializing Template manager client", zap.Error(err))
 	}
 
+	orch, err := orchestrator.New(ctx, config, tel, nomadClient, posthogClient, redisClient, dbClient, sqlcDB, clustersPool, templateManager, featureFlags)
+	if err != nil {
+		zap.L().Fatal("Initializing Orchestrator client", zap.Error(err))
+	}
+
 	// Start the periodic sync of template builds statuses
 	go templateManager.BuildsStatusPeriodicalSync(ctx)
 
+	// Start the dispatch of queued template builds to the builders with free slots
+	go templateManager.BuildsQueueDispatch(ctx)
+
 	a := &APIStore{
 		config:                   config,
 		Healthy:                  false,
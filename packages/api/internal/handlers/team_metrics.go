@@ -79,6 +79,8 @@ func (a *APIStore) GetTeamsTeamIDMetrics(c *gin.Context, teamID string, params a
 			TimestampUnix:       m.Timestamp.Unix(),
 			ConcurrentSandboxes: int32(m.ConcurrentSandboxes),
 			SandboxStartRate:    float32(m.SandboxStartedRate),
+			NetworkIngress:      int64(m.NetworkIngress),
+			NetworkEgress:       int64(m.NetworkEgress),
 		}
 	}
 
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/posthog/posthog-go"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatemanager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// PostTemplatesTemplateIDBuildsBuildIDCancel aborts a template build which is not finished yet
+func (a *APIStore) PostTemplatesTemplateIDBuildsBuildIDCancel(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when parsing build id", err)
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+		return
+	}
+
+	buildInfo, err := a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+	if err != nil {
+		if errors.Is(err, db.TemplateBuildNotFoundError{}) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+			return
+		}
+
+		telemetry.ReportError(ctx, "error when getting template", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+		return
+	}
+
+	infoTeamID := buildInfo.TeamID.String()
+	team, _, apiErr := a.GetTeamAndTier(c, &infoTeamID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team and tier", apiErr.Err)
+		return
+	}
+
+	if team.ID != buildInfo.TeamID {
+		telemetry.ReportError(ctx, "user doesn't have access to env", fmt.Errorf("user doesn't have access to env '%s'", templateID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+		telemetry.WithTemplateID(templateID),
+		telemetry.WithBuildID(buildID),
+	)
+
+	switch buildInfo.BuildStatus {
+	case envbuild.StatusWaiting, envbuild.StatusQueued, envbuild.StatusBuilding:
+	default:
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Build '%s' is not in progress", buildUUID))
+		return
+	}
+
+	err = a.templateManager.CancelBuild(ctx, buildUUID, templateID, buildInfo.BuildStatus, utils.WithClusterFallback(team.ClusterID), buildInfo.NodeID)
+	if err != nil {
+		if errors.Is(err, templatemanager.ErrBuildNotInProgress) {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Build '%s' is not in progress", buildUUID))
+			return
+		}
+
+		telemetry.ReportCriticalError(ctx, "error when cancelling template build", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when cancelling template build")
+		return
+	}
+
+	a.posthog.CreateAnalyticsTeamEvent(team.ID.String(), "cancelled environment build", posthog.NewProperties().
+		Set("environment", templateID).
+		Set("build_id", buildID),
+	)
+
+	c.Status(http.StatusNoContent)
+}
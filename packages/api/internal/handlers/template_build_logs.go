@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	templateBuildLogsPollInterval = time.Second
+	// Time to wait for the build status to be updated after the builder finished the logs stream
+	templateBuildLogsFinishTimeout = 10 * time.Second
+)
+
+// GetTemplatesTemplateIDBuildsBuildIDLogs streams template build logs as server-sent events until the build is finished
+func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDLogs(c *gin.Context, templateID api.TemplateID, buildID api.BuildID, params api.GetTemplatesTemplateIDBuildsBuildIDLogsParams) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when parsing build id", err)
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+		return
+	}
+
+	offset := int32(0)
+	if params.LogsOffset != nil {
+		offset = *params.LogsOffset
+	}
+
+	// Reconnecting clients send the ID of the last received event, which is the offset of the next log entry
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		id, err := strconv.ParseInt(lastEventID, 10, 32)
+		if err != nil || id < 0 {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid Last-Event-ID header")
+			return
+		}
+
+		offset = int32(id)
+	}
+
+	buildInfo, err := a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+	if err != nil {
+		if errors.Is(err, db.TemplateBuildNotFoundError{}) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+			return
+		}
+
+		telemetry.ReportError(ctx, "error when getting template", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+		return
+	}
+
+	infoTeamID := buildInfo.TeamID.String()
+	team, _, apiErr := a.GetTeamAndTier(c, &infoTeamID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team and tier", apiErr.Err)
+		return
+	}
+
+	if team.ID != buildInfo.TeamID {
+		telemetry.ReportError(ctx, "user doesn't have access to env", fmt.Errorf("user doesn't have access to env '%s'", templateID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+		telemetry.WithTemplateID(templateID),
+		telemetry.WithBuildID(buildID),
+	)
+
+	// The stream lasts as long as the build, so it can't be limited by the server write timeout
+	err = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	if err != nil {
+		telemetry.ReportError(ctx, "error when disabling write deadline for template build logs stream", err)
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	// The build has no builder assigned until it leaves the queue
+	buildInfo, err = a.waitForTemplateBuild(ctx, c.Writer, buildUUID, templateID, func(s envbuild.Status) bool {
+		return s != envbuild.StatusWaiting && s != envbuild.StatusQueued
+	})
+	if err != nil {
+		return
+	}
+
+	cli, err := a.templateManager.GetClusterBuildClient(utils.WithClusterFallback(team.ClusterID), buildInfo.NodeID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when getting build client", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		writeTemplateBuildStatusEvent(c.Writer, templateID, buildID, buildInfo)
+		return
+	}
+
+	level := apiToLogLevel(params.Level)
+	index := int64(offset)
+
+	stream, err := cli.StreamLogs(ctx, templateID, buildID, offset, level)
+	if err == nil {
+		for {
+			var entry *templatemanagergrpc.TemplateBuildLogEntry
+			entry, err = stream.Recv()
+			if err != nil {
+				break
+			}
+
+			index++
+			writeServerSentEvent(c.Writer, strconv.FormatInt(index, 10), "log", getAPILogEntry(logs.LogEntry{
+				Timestamp: entry.GetTimestamp().AsTime(),
+				Message:   entry.GetMessage(),
+				Level:     logs.LogLevel(entry.GetLevel()),
+				Fields:    entry.GetFields(),
+			}))
+		}
+	}
+
+	switch {
+	case errors.Is(err, io.EOF):
+	case status.Code(err) == codes.NotFound && index == int64(offset):
+		// The build is no longer kept by the builder, serve the stored logs instead
+		for _, entry := range cli.GetLogs(ctx, templateID, buildID, offset, level) {
+			index++
+			writeServerSentEvent(c.Writer, strconv.FormatInt(index, 10), "log", getAPILogEntry(entry))
+		}
+	case ctx.Err() != nil:
+		return
+	default:
+		telemetry.ReportError(ctx, "error when streaming template build logs", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+	}
+
+	finishCtx, finishCancel := context.WithTimeout(ctx, templateBuildLogsFinishTimeout)
+	defer finishCancel()
+
+	buildInfo, err = a.waitForTemplateBuild(finishCtx, c.Writer, buildUUID, templateID, func(s envbuild.Status) bool {
+		return s == envbuild.StatusUploaded || s == envbuild.StatusFailed || s == envbuild.StatusCancelled
+	})
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	writeTemplateBuildStatusEvent(c.Writer, templateID, buildID, buildInfo)
+}
+
+// waitForTemplateBuild polls the build info until the build status matches the condition,
+// the last known build info is returned together with the error when the context is done
+func (a *APIStore) waitForTemplateBuild(ctx context.Context, w gin.ResponseWriter, buildID uuid.UUID, templateID string, done func(envbuild.Status) bool) (templatecache.TemplateBuildInfo, error) {
+	ticker := time.NewTicker(templateBuildLogsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		buildInfo, err := a.templateBuildsCache.Get(ctx, buildID, templateID)
+		if err != nil {
+			return buildInfo, err
+		}
+
+		if done(buildInfo.BuildStatus) {
+			return buildInfo, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return buildInfo, ctx.Err()
+		case <-ticker.C:
+			// Keep the connection alive while waiting
+			_, _ = io.WriteString(w, ": waiting\n\n")
+			w.Flush()
+		}
+	}
+}
+
+func writeTemplateBuildStatusEvent(w gin.ResponseWriter, templateID, buildID string, buildInfo templatecache.TemplateBuildInfo) {
+	writeServerSentEvent(w, "", "status", api.TemplateBuild{
+		LogEntries: make([]api.BuildLogEntry, 0),
+		Logs:       make([]string, 0),
+		TemplateID: templateID,
+		BuildID:    buildID,
+		Status:     getCorrespondingTemplateBuildStatus(buildInfo.BuildStatus),
+		Reason:     getAPIReason(buildInfo.Reason),
+	})
+}
+
+func writeServerSentEvent(w gin.ResponseWriter, id, event string, data any) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	if id != "" {
+		_, _ = fmt.Fprintf(w, "id: %s\n", id)
+	}
+	_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	w.Flush()
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatemanager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// GetTemplatesTemplateIDBuildsBuildIDSbom returns the SBOM and the provenance record of the finished template build
+func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDSbom(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when parsing build id", err)
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+		return
+	}
+
+	buildInfo, err := a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+	if err != nil {
+		if errors.Is(err, db.TemplateBuildNotFoundError{}) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+			return
+		}
+
+		telemetry.ReportError(ctx, "error when getting template", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+		return
+	}
+
+	infoTeamID := buildInfo.TeamID.String()
+	team, _, apiErr := a.GetTeamAndTier(c, &infoTeamID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team and tier", apiErr.Err)
+		return
+	}
+
+	if team.ID != buildInfo.TeamID {
+		telemetry.ReportError(ctx, "user doesn't have access to env", fmt.Errorf("user doesn't have access to env '%s'", templateID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithTeamID(team.ID.String()),
+		telemetry.WithTemplateID(templateID),
+		telemetry.WithBuildID(buildID),
+	)
+
+	switch buildInfo.BuildStatus {
+	case envbuild.StatusSuccess, envbuild.StatusUploaded:
+	default:
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Build '%s' is not finished", buildUUID))
+		return
+	}
+
+	resp, err := a.templateManager.GetBuildSBOM(ctx, utils.WithClusterFallback(team.ClusterID), buildUUID, templateID)
+	if err != nil {
+		if errors.Is(err, templatemanager.ErrBuildSBOMNotFound) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("SBOM of build '%s' not found", buildUUID))
+			return
+		}
+
+		telemetry.ReportCriticalError(ctx, "error when getting template build SBOM", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build SBOM")
+		return
+	}
+
+	result := api.TemplateBuildSBOM{}
+
+	err = json.Unmarshal(resp.GetProvenance(), &result.Provenance)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when parsing template build provenance", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build SBOM")
+		return
+	}
+
+	if len(resp.GetSbom()) > 0 {
+		err = json.Unmarshal(resp.GetSbom(), &result.Sbom)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "error when parsing template build SBOM", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build SBOM")
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
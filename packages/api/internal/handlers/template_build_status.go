@@ -70,6 +70,27 @@ func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, tem
 		return
 	}
 
+	// early return if waiting in the queue for a free builder
+	if buildInfo.BuildStatus == envbuild.StatusQueued {
+		result := api.TemplateBuild{
+			LogEntries: make([]api.BuildLogEntry, 0),
+			Logs:       make([]string, 0),
+			TemplateID: templateID,
+			BuildID:    buildID,
+			Status:     api.TemplateBuildStatusWaiting,
+		}
+
+		position, err := a.templateManager.GetQueuePosition(ctx, buildUUID)
+		if err != nil {
+			telemetry.ReportError(ctx, "error when getting build queue position", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		} else if position > 0 {
+			result.QueuePosition = sharedUtils.ToPtr(int32(position))
+		}
+
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
 	// Needs to be before logs request so the status is not set to done too early
 	result := api.TemplateBuild{
 		LogEntries: nil,
@@ -111,9 +132,9 @@ func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, tem
 
 func getCorrespondingTemplateBuildStatus(s envbuild.Status) api.TemplateBuildStatus {
 	switch s {
-	case envbuild.StatusWaiting:
+	case envbuild.StatusWaiting, envbuild.StatusQueued:
 		return api.TemplateBuildStatusWaiting
-	case envbuild.StatusFailed:
+	case envbuild.StatusFailed, envbuild.StatusCancelled:
 		return api.TemplateBuildStatusError
 	case envbuild.StatusUploaded:
 		return api.TemplateBuildStatusReady
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	apiutils "github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/dockerfile"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// PostV2TemplatesDockerfile compiles the Dockerfile to the steps and stages of the template build
+func (a *APIStore) PostV2TemplatesDockerfile(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := apiutils.ParseBody[api.TemplateDockerfile](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+		telemetry.ReportCriticalError(ctx, "invalid request body", err)
+
+		return
+	}
+
+	var buildArgs map[string]string
+	if body.BuildArgs != nil {
+		buildArgs = *body.BuildArgs
+	}
+
+	template, err := dockerfile.Parse(body.Dockerfile, buildArgs)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid Dockerfile:\n%s", err))
+		telemetry.ReportError(ctx, "invalid Dockerfile", err)
+
+		return
+	}
+
+	steps := toAPITemplateSteps(template.Steps)
+	stages := make([]api.TemplateStage, 0, len(template.Stages))
+	for _, stage := range template.Stages {
+		fromImage := stage.GetFromImage()
+		stageSteps := toAPITemplateSteps(stage.GetSteps())
+		stages = append(stages, api.TemplateStage{
+			Name:      stage.GetName(),
+			FromImage: &fromImage,
+			Steps:     &stageSteps,
+		})
+	}
+
+	c.JSON(http.StatusOK, api.TemplateBuildStartV2{
+		FromImage:  &template.FromImage,
+		Steps:      &steps,
+		Stages:     &stages,
+		StartCmd:   template.StartCmd,
+		ReadyProbe: toAPIReadyProbe(template.ReadyProbe),
+	})
+}
+
+func toAPITemplateSteps(steps []*templatemanagergrpc.TemplateStep) []api.TemplateStep {
+	result := make([]api.TemplateStep, 0, len(steps))
+	for _, step := range steps {
+		args := step.GetArgs()
+		apiStep := api.TemplateStep{
+			Type:      step.GetType(),
+			Args:      &args,
+			FromStage: step.FromStage, //nolint:protogetter // the nil value is kept
+		}
+
+		if len(step.GetSecrets()) > 0 {
+			secrets := make([]api.TemplateSecretMount, 0, len(step.GetSecrets()))
+			for _, mount := range step.GetSecrets() {
+				secrets = append(secrets, api.TemplateSecretMount{
+					Id:     mount.GetId(),
+					Env:    mount.Env,    //nolint:protogetter // the nil value is kept
+					Target: mount.Target, //nolint:protogetter // the nil value is kept
+				})
+			}
+
+			apiStep.Secrets = &secrets
+		}
+
+		result = append(result, apiStep)
+	}
+
+	return result
+}
+
+// toAPIReadyProbe converts the command probe compiled from the HEALTHCHECK instruction
+func toAPIReadyProbe(probe *templatemanagergrpc.ReadyProbe) *api.TemplateReadyProbe {
+	if probe == nil {
+		return nil
+	}
+
+	command := probe.GetCommand()
+	result := &api.TemplateReadyProbe{
+		Command: &command,
+	}
+
+	if probe.IntervalSeconds != nil { //nolint:protogetter // the nil value is kept
+		interval := int32(probe.GetIntervalSeconds())
+		result.Interval = &interval
+	}
+
+	if probe.TimeoutSeconds != nil { //nolint:protogetter // the nil value is kept
+		timeout := int32(probe.GetTimeoutSeconds())
+		result.Timeout = &timeout
+	}
+
+	if probe.Retries != nil { //nolint:protogetter // the nil value is kept
+		retries := int32(probe.GetRetries())
+		result.Retries = &retries
+	}
+
+	return result
+}
@@ -29,7 +29,7 @@ func (a *APIStore) CheckAndCancelConcurrentBuilds(ctx context.Context, templateI
 		Query().
 		Where(
 			envbuild.EnvID(templateID),
-			envbuild.StatusIn(envbuild.StatusWaiting, envbuild.StatusBuilding),
+			envbuild.StatusIn(envbuild.StatusWaiting, envbuild.StatusQueued, envbuild.StatusBuilding),
 			envbuild.IDNotIn(buildID),
 		).
 		All(ctx)
@@ -153,11 +153,15 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		build.FreeDiskSizeMb,
 		build.RamMb,
 		build.ReadyCmd,
+		nil, // readyProbe not supported in v1 handler
 		&fromImage,
 		nil, // fromTemplate not supported in v1 handler
 		nil, // fromImageRegistry not supported in v1 handler
+		nil, // fromGit not supported in v1 handler
 		&forceRebuild,
 		nil,
+		nil, // stages not supported in v1 handler
+		nil, // secrets not supported in v1 handler
 		apiutils.WithClusterFallback(team.ClusterID),
 		build.ClusterNodeID,
 	)
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -17,10 +19,16 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
+// gitURLRegex matches the https, ssh and git URLs and the scp-like ssh syntax, the local repositories are not allowed
+var gitURLRegex = regexp.MustCompile(`^((https?|ssh|git)://[^\s/]+/\S+|[\w.-]+@[\w.-]+:[^\s/]\S*)$`)
+
 type dockerfileStore struct {
-	FromImage    *string             `json:"from_image"`
-	FromTemplate *string             `json:"from_template"`
-	Steps        *[]api.TemplateStep `json:"steps"`
+	FromImage    *string                 `json:"from_image"`
+	FromTemplate *string                 `json:"from_template"`
+	Steps        *[]api.TemplateStep     `json:"steps"`
+	Stages       *[]api.TemplateStage    `json:"stages"`
+	ReadyProbe   *api.TemplateReadyProbe `json:"ready_probe,omitempty"`
+	FromGit      *api.TemplateGitSource  `json:"from_git,omitempty"`
 }
 
 // PostV2TemplatesTemplateIDBuildsBuildID triggers a new build
@@ -84,6 +92,27 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		return
 	}
 
+	err = validateSecretMounts(body)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid build secrets: %s", err))
+
+		return
+	}
+
+	err = validateReadyProbe(body.ReadyProbe)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid ready probe: %s", err))
+
+		return
+	}
+
+	err = validateGitSource(body)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid git source: %s", err))
+
+		return
+	}
+
 	startTime := time.Now()
 	build := templateBuildDB.EnvBuild
 
@@ -94,10 +123,14 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		return
 	}
 
+	// The build secrets are never persisted, only the steps with their mounts are
 	stepsMarshalled, err := json.Marshal(dockerfileStore{
 		FromImage:    body.FromImage,
 		FromTemplate: body.FromTemplate,
 		Steps:        body.Steps,
+		Stages:       body.Stages,
+		ReadyProbe:   body.ReadyProbe,
+		FromGit:      body.FromGit,
 	})
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when processing steps: %s", err))
@@ -130,11 +163,15 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		build.FreeDiskSizeMb,
 		build.RamMb,
 		body.ReadyCmd,
+		body.ReadyProbe,
 		body.FromImage,
 		body.FromTemplate,
 		body.FromImageRegistry,
+		body.FromGit,
 		body.Force,
 		body.Steps,
+		body.Stages,
+		body.Secrets,
 		apiutils.WithClusterFallback(team.ClusterID),
 		build.ClusterNodeID,
 	)
@@ -153,3 +190,133 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 
 	c.Status(http.StatusAccepted)
 }
+
+// validateSecretMounts checks all secrets mounted by the steps are provided and mounted only by the RUN steps
+func validateSecretMounts(body api.TemplateBuildStartV2) error {
+	var secrets map[string]string
+	if body.Secrets != nil {
+		secrets = *body.Secrets
+	}
+
+	steps := make([]api.TemplateStep, 0)
+	if body.Steps != nil {
+		steps = append(steps, *body.Steps...)
+	}
+
+	if body.Stages != nil {
+		for _, stage := range *body.Stages {
+			if stage.Steps != nil {
+				steps = append(steps, *stage.Steps...)
+			}
+		}
+	}
+
+	for _, step := range steps {
+		if step.Secrets == nil {
+			continue
+		}
+
+		if !strings.EqualFold(step.Type, "RUN") && len(*step.Secrets) > 0 {
+			return fmt.Errorf("secrets can be mounted only to the RUN steps, not to %s", step.Type)
+		}
+
+		for _, mount := range *step.Secrets {
+			if _, ok := secrets[mount.Id]; !ok {
+				return fmt.Errorf("secret '%s' is not provided", mount.Id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateReadyProbe checks the ready probe has exactly one check with the valid port and status
+func validateReadyProbe(probe *api.TemplateReadyProbe) error {
+	if probe == nil {
+		return nil
+	}
+
+	checks := 0
+	if probe.Http != nil {
+		checks++
+
+		if probe.Http.Port < 1 || probe.Http.Port > 65535 {
+			return fmt.Errorf("invalid HTTP port %d", probe.Http.Port)
+		}
+
+		if probe.Http.ExpectedStatus != nil && (*probe.Http.ExpectedStatus < 100 || *probe.Http.ExpectedStatus > 599) {
+			return fmt.Errorf("invalid expected status %d", *probe.Http.ExpectedStatus)
+		}
+	}
+
+	if probe.Tcp != nil {
+		checks++
+
+		if probe.Tcp.Port < 1 || probe.Tcp.Port > 65535 {
+			return fmt.Errorf("invalid TCP port %d", probe.Tcp.Port)
+		}
+	}
+
+	if probe.Command != nil {
+		checks++
+
+		if strings.TrimSpace(*probe.Command) == "" {
+			return fmt.Errorf("the command is empty")
+		}
+	}
+
+	if checks != 1 {
+		return fmt.Errorf("exactly one of the http, tcp and command checks must be set")
+	}
+
+	if (probe.Interval != nil && *probe.Interval < 1) || (probe.Timeout != nil && *probe.Timeout < 1) {
+		return fmt.Errorf("the interval and timeout must be at least 1 second")
+	}
+
+	if probe.Retries != nil && *probe.Retries < 0 {
+		return fmt.Errorf("the retries must not be negative")
+	}
+
+	return nil
+}
+
+// validateGitSource checks the repository URL and ref, the template built from the repository Dockerfile must not have any other source and steps
+func validateGitSource(body api.TemplateBuildStartV2) error {
+	source := body.FromGit
+	if source == nil {
+		return nil
+	}
+
+	if !gitURLRegex.MatchString(source.Url) {
+		return fmt.Errorf("the repository URL must be an https, ssh or git URL")
+	}
+
+	if source.Ref != nil && (strings.HasPrefix(*source.Ref, "-") || strings.ContainsAny(*source.Ref, " \t\n~^:?*[\\")) {
+		return fmt.Errorf("invalid ref '%s'", *source.Ref)
+	}
+
+	if source.DeployKeySecret != nil {
+		var secrets map[string]string
+		if body.Secrets != nil {
+			secrets = *body.Secrets
+		}
+
+		if _, ok := secrets[*source.DeployKeySecret]; !ok {
+			return fmt.Errorf("deploy key secret '%s' is not provided", *source.DeployKeySecret)
+		}
+	}
+
+	if source.DockerfilePath == nil || *source.DockerfilePath == "" {
+		return nil
+	}
+
+	if body.FromImage != nil || body.FromTemplate != nil {
+		return fmt.Errorf("fromImage and fromTemplate can't be set when building from the Dockerfile")
+	}
+
+	if (body.Steps != nil && len(*body.Steps) > 0) || (body.Stages != nil && len(*body.Stages) > 0) {
+		return fmt.Errorf("steps and stages can't be set when building from the Dockerfile")
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// checkpointCheckInterval is how often the running sandboxes are checked for the due checkpoints.
+const checkpointCheckInterval = 10 * time.Second
+
+var errCheckpointNotDue = errors.New("checkpoint is not due")
+
+// checkpointSandboxes periodically creates the scheduled checkpoints of the running sandboxes.
+func (o *Orchestrator) checkpointSandboxes(ctx context.Context) {
+	ticker := time.NewTicker(checkpointCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			zap.L().Info("Stopping sandbox checkpoints due to context cancellation")
+
+			return
+		case <-ticker.C:
+			now := time.Now()
+			for _, item := range o.sandboxStore.Items(nil, []sandbox.State{sandbox.StateRunning}) {
+				if !item.CheckpointDue(now) {
+					continue
+				}
+
+				// The checkpoint time is set before the checkpoint is created, so the failed checkpoints are retried only after the interval.
+				sbx, err := o.sandboxStore.Update(item.SandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+					if sbx.State != sandbox.StateRunning || !sbx.CheckpointDue(now) {
+						return sbx, errCheckpointNotDue
+					}
+
+					sbx.LastCheckpointTime = now
+
+					return sbx, nil
+				})
+				if err != nil {
+					continue
+				}
+
+				go func() {
+					if err := o.CheckpointSandbox(context.WithoutCancel(ctx), sbx); err != nil {
+						zap.L().Warn("Failed to checkpoint sandbox", zap.Error(err), logger.WithSandboxID(sbx.SandboxID))
+					}
+				}()
+			}
+		}
+	}
+}
+
+// CheckpointSandbox snapshots the running sandbox in place and records the snapshot as a checkpoint,
+// only the configured number of the latest checkpoints is kept.
+func (o *Orchestrator) CheckpointSandbox(ctx context.Context, sbx sandbox.Sandbox) error {
+	ctx, span := tracer.Start(ctx, "checkpoint-sandbox")
+	defer span.End()
+
+	telemetry.SetAttributes(ctx, telemetry.WithSandboxID(sbx.SandboxID))
+
+	envBuild, err := o.snapshotSandbox(ctx, sbx)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot sandbox: %w", err)
+	}
+
+	params := queries.CreateSnapshotCheckpointParams{
+		BuildID:   envBuild.ID,
+		SandboxID: sbx.SandboxID,
+		TeamID:    sbx.TeamID,
+	}
+	// The checkpoint diff is based on the build the sandbox was running from, when it's a snapshot of the same sandbox.
+	if sbx.TemplateID == envBuild.EnvID {
+		params.ParentBuildID = &sbx.BuildID
+	}
+
+	err = o.sqlcDB.CreateSnapshotCheckpoint(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to create checkpoint: %w", err)
+	}
+
+	expired, err := o.sqlcDB.DeleteExpiredSnapshotCheckpoints(ctx, queries.DeleteExpiredSnapshotCheckpointsParams{
+		SandboxID: sbx.SandboxID,
+		TeamID:    sbx.TeamID,
+		Retention: int32(sbx.Checkpoint.Retention),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete expired checkpoints: %w", err)
+	}
+
+	if len(expired) > 0 {
+		builds := make([]template_manager.DeleteBuild, 0, len(expired))
+		for _, build := range expired {
+			builds = append(builds, template_manager.DeleteBuild{
+				BuildID:    build.ID,
+				TemplateID: build.EnvID,
+				ClusterID:  sbx.ClusterID,
+				NodeID:     build.ClusterNodeID,
+			})
+		}
+
+		err = o.templateManager.DeleteBuilds(ctx, builds)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired checkpoint builds: %w", err)
+		}
+	}
+
+	sbxlogger.I(sbx).Debug("Created sandbox checkpoint", logger.WithBuildID(envBuild.ID.String()))
+
+	return nil
+}
+
+// snapshotSandbox snapshots the sandbox on its node without stopping it, the sandbox is only briefly paused
+// while the snapshot is created and keeps running with the same execution also when the snapshot fails.
+func (o *Orchestrator) snapshotSandbox(ctx context.Context, sbx sandbox.Sandbox) (*models.EnvBuild, error) {
+	node := o.GetNode(sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		return nil, fmt.Errorf("node '%s' not found", sbx.NodeID)
+	}
+
+	envBuild, err := o.dbClient.NewSnapshotBuild(
+		ctx,
+		newSnapshotInfo(sbx),
+		sbx.TeamID,
+		sbx.NodeID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot build: %w", err)
+	}
+
+	// The fork without any new sandboxes that keeps the source running is a snapshot of the sandbox in place.
+	client, clientCtx := node.GetClient(ctx)
+	_, err = client.Sandbox.Fork(
+		clientCtx,
+		&orchestrator.SandboxForkRequest{
+			SandboxId:  sbx.SandboxID,
+			TemplateId: envBuild.EnvID,
+			BuildId:    envBuild.ID.String(),
+			KeepSource: true,
+		},
+	)
+	err = utils.UnwrapGRPCError(err)
+	o.finishSnapshotBuild(ctx, envBuild, err)
+	if err != nil {
+		return nil, err
+	}
+
+	// The snapshot is cached on the node now
+	node.InsertBuild(envBuild.ID.String())
+
+	return envBuild, nil
+}
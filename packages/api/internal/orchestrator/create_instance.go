@@ -19,6 +19,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -44,47 +45,26 @@ func (o *Orchestrator) CreateSandbox(
 	autoPause bool,
 	envdAuthToken *string,
 	allowInternetAccess *bool,
+	network *types.SandboxNetworkConfig,
+	checkpoint *types.SandboxCheckpointConfig,
 ) (*api.Sandbox, *api.APIError) {
 	ctx, childSpan := tracer.Start(ctx, "create-sandbox")
 	defer childSpan.End()
 
 	// Check if team has reached max instances
-	releaseTeamSandboxReservation, err := o.sandboxStore.Reserve(sandboxID, team.Team.ID, team.Tier.ConcurrentInstances)
-	if err != nil {
-		var limitErr *sandbox.LimitExceededError
-		var alreadyErr *sandbox.AlreadyBeingStartedError
-
-		telemetry.ReportCriticalError(ctx, "failed to reserve sandbox for team", err)
-
-		switch {
-		case errors.As(err, &limitErr):
-			return nil, &api.APIError{
-				Code: http.StatusTooManyRequests,
-				ClientMsg: fmt.Sprintf(
-					"you have reached the maximum number of concurrent E2B sandboxes (%d). If you need more, "+
-						"please contact us at 'https://e2b.dev/docs/getting-help'", team.Tier.ConcurrentInstances),
-				Err: fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", team.Team.ID, team.Tier.ConcurrentInstances),
-			}
-		case errors.As(err, &alreadyErr):
-			zap.L().Warn("sandbox already being started", logger.WithSandboxID(sandboxID), zap.Error(err))
-			return nil, &api.APIError{
-				Code:      http.StatusConflict,
-				ClientMsg: fmt.Sprintf("Sandbox %s is already being started", sandboxID),
-				Err:       err,
-			}
-		default:
-			zap.L().Error("failed to reserve sandbox for team", logger.WithSandboxID(sandboxID), zap.Error(err))
-			return nil, &api.APIError{
-				Code:      http.StatusInternalServerError,
-				ClientMsg: fmt.Sprintf("Failed to create sandbox: %s", err),
-				Err:       err,
-			}
-		}
+	releaseTeamSandboxReservation, apiErr := o.reserveSandbox(ctx, sandboxID, team)
+	if apiErr != nil {
+		return nil, apiErr
 	}
 
 	telemetry.ReportEvent(ctx, "Reserved sandbox for team")
 	defer releaseTeamSandboxReservation()
 
+	apiErr = o.checkTeamNetworkName(ctx, sandboxID, team.Team.ID.String(), network)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
 	features, err := sandbox.NewVersionInfo(build.FirecrackerVersion)
 	if err != nil {
 		errMsg := fmt.Errorf("failed to get features for firecracker version '%s': %w", build.FirecrackerVersion, err)
@@ -112,6 +92,15 @@ func (o *Orchestrator) CreateSandbox(
 		sbxDomain = cluster.SandboxDomain
 	}
 
+	apiKeyHashes, err := o.getAPIKeyHashes(ctx, team.Team.ID)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when getting the team API keys",
+			Err:       err,
+		}
+	}
+
 	sbxRequest := &orchestrator.SandboxCreateRequest{
 		Sandbox: &orchestrator.SandboxConfig{
 			BaseTemplateId:      baseTemplateID,
@@ -134,6 +123,9 @@ func (o *Orchestrator) CreateSandbox(
 			Snapshot:            isResume,
 			AutoPause:           autoPause,
 			AllowInternetAccess: allowInternetAccess,
+			Network:             sandbox.NetworkConfigToGRPC(network, apiKeyHashes),
+			NetworkLimits:       sandbox.NetworkLimitsFromTier(team.Tier),
+			Checkpoint:          sandbox.CheckpointConfigToGRPC(checkpoint),
 			TotalDiskSizeMb:     ut.FromPtr(build.TotalDiskSizeMb),
 		},
 		StartTime: timestamppb.New(startTime),
@@ -219,9 +211,50 @@ func (o *Orchestrator) CreateSandbox(
 		autoPause,
 		envdAuthToken,
 		allowInternetAccess,
+		network,
+		checkpoint,
 		baseTemplateID,
 	)
+	instanceInfo.APIKeyHashes = apiKeyHashes
 
 	o.sandboxStore.Add(ctx, instanceInfo, true)
 	return &sbx, nil
 }
+
+// reserveSandbox reserves a sandbox slot for the team, so the team's concurrent sandboxes limit is not exceeded.
+func (o *Orchestrator) reserveSandbox(ctx context.Context, sandboxID string, team authcache.AuthTeamInfo) (func(), *api.APIError) {
+	release, err := o.sandboxStore.Reserve(sandboxID, team.Team.ID, team.Tier.ConcurrentInstances)
+	if err != nil {
+		var limitErr *sandbox.LimitExceededError
+		var alreadyErr *sandbox.AlreadyBeingStartedError
+
+		telemetry.ReportCriticalError(ctx, "failed to reserve sandbox for team", err)
+
+		switch {
+		case errors.As(err, &limitErr):
+			return nil, &api.APIError{
+				Code: http.StatusTooManyRequests,
+				ClientMsg: fmt.Sprintf(
+					"you have reached the maximum number of concurrent E2B sandboxes (%d). If you need more, "+
+						"please contact us at 'https://e2b.dev/docs/getting-help'", team.Tier.ConcurrentInstances),
+				Err: fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", team.Team.ID, team.Tier.ConcurrentInstances),
+			}
+		case errors.As(err, &alreadyErr):
+			zap.L().Warn("sandbox already being started", logger.WithSandboxID(sandboxID), zap.Error(err))
+			return nil, &api.APIError{
+				Code:      http.StatusConflict,
+				ClientMsg: fmt.Sprintf("Sandbox %s is already being started", sandboxID),
+				Err:       err,
+			}
+		default:
+			zap.L().Error("failed to reserve sandbox for team", logger.WithSandboxID(sandboxID), zap.Error(err))
+			return nil, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: fmt.Sprintf("Failed to create sandbox: %s", err),
+				Err:       err,
+			}
+		}
+	}
+
+	return release, nil
+}
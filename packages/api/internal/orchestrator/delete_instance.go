@@ -79,6 +79,8 @@ func (o *Orchestrator) removeSandboxFromNode(ctx context.Context, sbx sandbox.Sa
 	defer node.RemoveSandbox(sbx)
 
 	o.dns.Remove(ctx, sbx.SandboxID, sbx.ExecutionID)
+	o.removeHostnames(ctx, sbx)
+	o.removeTeamNetworkName(ctx, sbx)
 
 	sbxlogger.I(sbx).Debug("Removing sandbox",
 		zap.Bool("auto_pause", sbx.AutoPause),
@@ -0,0 +1,291 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/nodemanager"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ForkTarget is a new sandbox that should be created from the forked sandbox.
+type ForkTarget struct {
+	SandboxID       string
+	EnvdAccessToken *string
+}
+
+// ForkSandbox snapshots the running sandbox and resumes the snapshot as new sandboxes on the same node.
+// The snapshot is stored as a regular pause snapshot of the source sandbox,
+// so when the source is not kept running, it can be resumed later the same way as a paused sandbox.
+func (o *Orchestrator) ForkSandbox(
+	ctx context.Context,
+	sbx sandbox.Sandbox,
+	team authcache.AuthTeamInfo,
+	targets []ForkTarget,
+	keepSource bool,
+	timeout time.Duration,
+) ([]*api.Sandbox, *api.APIError) {
+	ctx, span := tracer.Start(ctx, "fork-sandbox")
+	defer span.End()
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sbx.SandboxID),
+		attribute.Int("fork.count", len(targets)),
+		attribute.Bool("fork.keep_source", keepSource),
+	)
+
+	for _, target := range targets {
+		release, apiErr := o.reserveSandbox(ctx, target.SandboxID, team)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+
+		defer release()
+	}
+
+	telemetry.ReportEvent(ctx, "Reserved forked sandboxes for team")
+
+	features, err := sandbox.NewVersionInfo(sbx.FirecrackerVersion)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get build information for the sandbox",
+			Err:       fmt.Errorf("failed to get features for firecracker version '%s': %w", sbx.FirecrackerVersion, err),
+		}
+	}
+
+	var sbxDomain *string
+	if team.Team.ClusterID != nil {
+		cluster, ok := o.clusters.GetClusterById(*team.Team.ClusterID)
+		if !ok {
+			return nil, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Error while looking for sandbox cluster information",
+				Err:       fmt.Errorf("cannot access cluster %s associated with team id %s that forked sandbox %s", *team.Team.ClusterID, team.Team.ID, sbx.SandboxID),
+			}
+		}
+
+		sbxDomain = cluster.SandboxDomain
+	}
+
+	node := o.GetNode(sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error forking sandbox",
+			Err:       fmt.Errorf("node '%s' not found", sbx.NodeID),
+		}
+	}
+
+	envBuild, err := o.dbClient.NewSnapshotBuild(
+		ctx,
+		newSnapshotInfo(sbx),
+		sbx.TeamID,
+		sbx.NodeID,
+	)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error forking sandbox",
+			Err:       fmt.Errorf("failed to create snapshot build: %w", err),
+		}
+	}
+
+	startTime := time.Now()
+	endTime := startTime.Add(timeout)
+
+	// The team network name stays with the source sandbox, the forks use their sandbox IDs.
+	forkNetwork := sbx.Network
+	if forkNetwork != nil && forkNetwork.TeamNetwork != nil {
+		forkNetwork = &types.SandboxNetworkConfig{
+			Egress:      forkNetwork.Egress,
+			Ports:       forkNetwork.Ports,
+			TeamNetwork: &types.SandboxTeamNetworkConfig{Group: forkNetwork.TeamNetwork.Group},
+		}
+	}
+
+	configs := make([]*orchestrator.SandboxConfig, 0, len(targets))
+	for _, target := range targets {
+		configs = append(configs, &orchestrator.SandboxConfig{
+			BaseTemplateId:      sbx.BaseTemplateID,
+			TemplateId:          envBuild.EnvID,
+			Alias:               sbx.Alias,
+			TeamId:              sbx.TeamID.String(),
+			BuildId:             envBuild.ID.String(),
+			SandboxId:           target.SandboxID,
+			ExecutionId:         uuid.New().String(),
+			KernelVersion:       sbx.KernelVersion,
+			FirecrackerVersion:  sbx.FirecrackerVersion,
+			EnvdVersion:         sbx.EnvdVersion,
+			Metadata:            sbx.Metadata,
+			EnvdAccessToken:     target.EnvdAccessToken,
+			MaxSandboxLength:    team.Tier.MaxLengthHours,
+			HugePages:           features.HasHugePages(),
+			RamMb:               sbx.RamMB,
+			Vcpu:                sbx.VCpu,
+			Snapshot:            true,
+			AutoPause:           sbx.AutoPause,
+			AllowInternetAccess: sbx.AllowInternetAccess,
+			Network:             sandbox.NetworkConfigToGRPC(forkNetwork, sbx.APIKeyHashes),
+			NetworkLimits:       sandbox.NetworkLimitsFromTier(team.Tier),
+			TotalDiskSizeMb:     sbx.TotalDiskSizeMB,
+		})
+	}
+
+	// The source sandbox is only briefly paused while the snapshot is created,
+	// when the snapshot fails, the source keeps running on the node.
+	client, clientCtx := node.GetClient(ctx)
+	if !keepSource {
+		clientCtx = node.GetSandboxDeleteCtx(clientCtx, sbx.SandboxID, sbx.ExecutionID)
+	}
+
+	res, err := client.Sandbox.Fork(
+		clientCtx,
+		&orchestrator.SandboxForkRequest{
+			SandboxId:  sbx.SandboxID,
+			TemplateId: envBuild.EnvID,
+			BuildId:    envBuild.ID.String(),
+			Sandboxes:  configs,
+			StartTime:  timestamppb.New(startTime),
+			EndTime:    timestamppb.New(endTime),
+			KeepSource: keepSource,
+		},
+	)
+	err = utils.UnwrapGRPCError(err)
+	o.finishSnapshotBuild(ctx, envBuild, err)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error forking sandbox",
+			Err:       fmt.Errorf("failed to fork sandbox '%s': %w", sbx.SandboxID, err),
+		}
+	}
+
+	// The snapshot is cached on the node now
+	node.InsertBuild(envBuild.ID.String())
+
+	if !keepSource {
+		o.removeForkSource(ctx, node, sbx)
+	}
+
+	started := make(map[string]bool, len(res.GetSandboxIds()))
+	for _, sandboxID := range res.GetSandboxIds() {
+		started[sandboxID] = true
+	}
+
+	sandboxes := make([]*api.Sandbox, 0, len(targets))
+	for i, target := range targets {
+		if !started[target.SandboxID] {
+			zap.L().Error("Forked sandbox failed to start", logger.WithSandboxID(target.SandboxID), zap.String("source_sandbox_id", sbx.SandboxID))
+
+			continue
+		}
+
+		instanceInfo := sandbox.NewSandbox(
+			target.SandboxID,
+			envBuild.EnvID,
+			consts.ClientID,
+			sbx.Alias,
+			configs[i].GetExecutionId(),
+			sbx.TeamID,
+			envBuild.ID,
+			sbx.Metadata,
+			time.Duration(team.Tier.MaxLengthHours)*time.Hour,
+			startTime,
+			endTime,
+			sbx.VCpu,
+			sbx.TotalDiskSizeMB,
+			sbx.RamMB,
+			sbx.KernelVersion,
+			sbx.FirecrackerVersion,
+			sbx.EnvdVersion,
+			node.ID,
+			node.ClusterID,
+			sbx.AutoPause,
+			target.EnvdAccessToken,
+			sbx.AllowInternetAccess,
+			forkNetwork,
+			nil,
+			sbx.BaseTemplateID,
+		)
+		instanceInfo.APIKeyHashes = sbx.APIKeyHashes
+
+		o.sandboxStore.Add(ctx, instanceInfo, true)
+
+		sandboxes = append(sandboxes, &api.Sandbox{
+			ClientID:        consts.ClientID,
+			SandboxID:       target.SandboxID,
+			TemplateID:      envBuild.EnvID,
+			Alias:           sbx.Alias,
+			EnvdVersion:     sbx.EnvdVersion,
+			EnvdAccessToken: target.EnvdAccessToken,
+			Domain:          sbxDomain,
+		})
+	}
+
+	telemetry.ReportEvent(ctx, "Forked sandbox")
+
+	return sandboxes, nil
+}
+
+// finishSnapshotBuild marks the snapshot build as finished based on the result of the snapshot.
+func (o *Orchestrator) finishSnapshotBuild(ctx context.Context, envBuild *models.EnvBuild, snapshotErr error) {
+	params := queries.UpdateEnvBuildStatusParams{
+		Status:     string(envbuild.StatusSuccess),
+		Reason:     types.BuildReason{},
+		BuildID:    envBuild.ID,
+		TemplateID: envBuild.EnvID,
+	}
+	if snapshotErr != nil {
+		params.Status = string(envbuild.StatusFailed)
+		params.Reason = types.BuildReason{Message: "Snapshot of the sandbox failed"}
+	}
+
+	now := time.Now()
+	params.FinishedAt = &now
+
+	err := o.sqlcDB.UpdateEnvBuildStatus(ctx, params)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error updating snapshot build status", err)
+	}
+}
+
+// removeForkSource removes the source sandbox stopped by the node after the fork snapshot,
+// the snapshot is stored as its pause snapshot, so it can be resumed later.
+func (o *Orchestrator) removeForkSource(ctx context.Context, node *nodemanager.Node, sbx sandbox.Sandbox) {
+	alreadyDone, finish, err := o.sandboxStore.StartRemoving(ctx, sbx.SandboxID, sandbox.StateActionPause)
+	if err != nil || alreadyDone {
+		// The sandbox is already being paused or killed, the other operation removes it.
+		sbxlogger.I(sbx).Debug("Fork source is already being removed", zap.Error(err))
+
+		return
+	}
+	defer finish(nil)
+
+	o.dns.Remove(ctx, sbx.SandboxID, sbx.ExecutionID)
+	o.removeTeamNetworkName(ctx, sbx)
+	o.removeHostnames(ctx, sbx)
+	node.RemoveSandbox(sbx)
+	o.sandboxStore.Remove(sbx.SandboxID)
+	go o.countersRemove(context.WithoutCancel(ctx), sbx, sandbox.StateActionPause)
+	go o.analyticsRemove(context.WithoutCancel(ctx), sbx, sandbox.StateActionPause)
+}
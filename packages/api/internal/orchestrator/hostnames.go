@@ -0,0 +1,155 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	e2bcatalog "github.com/e2b-dev/infra/packages/shared/pkg/sandbox-catalog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// hostnameVerificationPrefix is the subdomain of the custom hostname with the TXT record proving the team controls the hostname.
+	hostnameVerificationPrefix = "_e2b-verification."
+	hostnameVerificationValue  = "e2b-verification="
+
+	hostnameVerificationTimeout = 5 * time.Second
+)
+
+var lookupTXT = net.DefaultResolver.LookupTXT
+
+// hostnameVerification returns the TXT record name and value the team has to add to the DNS to verify the hostname.
+// The value is bound to the team, the record of one team can't be used to verify the hostname for another team.
+func hostnameVerification(teamID uuid.UUID, hostname string) (string, string) {
+	hash := sha256.Sum256([]byte(teamID.String() + ":" + hostname))
+
+	return hostnameVerificationPrefix + hostname, hostnameVerificationValue + hex.EncodeToString(hash[:])
+}
+
+// verifyHostname checks the TXT record of the hostname contains the verification value of the team.
+func verifyHostname(ctx context.Context, teamID uuid.UUID, hostname string) *api.APIError {
+	record, value := hostnameVerification(teamID, hostname)
+
+	ctx, cancel := context.WithTimeout(ctx, hostnameVerificationTimeout)
+	defer cancel()
+
+	records, err := lookupTXT(ctx, record)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when verifying the hostname", Err: fmt.Errorf("failed to lookup TXT record '%s': %w", record, err)}
+		}
+	}
+
+	if slices.Contains(records, value) {
+		return nil
+	}
+
+	return &api.APIError{
+		Code:      http.StatusBadRequest,
+		ClientMsg: fmt.Sprintf("Hostname \"%s\" is not verified, add a TXT record \"%s\" with the value \"%s\"", hostname, record, value),
+		Err:       fmt.Errorf("TXT record '%s' doesn't contain the verification value", record),
+	}
+}
+
+// AddSandboxHostname routes the custom hostname to the sandbox port.
+// The team has to prove it controls the hostname with a TXT record.
+// The hostname registered by the team can be moved between the team sandboxes, other teams can't use it until it's removed.
+func (o *Orchestrator) AddSandboxHostname(ctx context.Context, sbx sandbox.Sandbox, hostname string, port uint64) *api.APIError {
+	ctx, childSpan := tracer.Start(ctx, "add-sandbox-hostname",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.SandboxID),
+		),
+	)
+	defer childSpan.End()
+
+	if apiErr := verifyHostname(ctx, sbx.TeamID, hostname); apiErr != nil {
+		return apiErr
+	}
+
+	telemetry.ReportEvent(ctx, "Verified sandbox hostname")
+
+	lifetime := time.Until(sbx.StartTime.Add(sbx.MaxInstanceLength))
+	previous, err := o.dns.ClaimHostname(ctx, hostname, e2bcatalog.HostnameInfo{
+		SandboxID: sbx.SandboxID,
+		TeamID:    sbx.TeamID.String(),
+		Port:      port,
+	}, lifetime)
+	if errors.Is(err, e2bcatalog.ErrHostnameTaken) {
+		return &api.APIError{Code: http.StatusConflict, ClientMsg: fmt.Sprintf("Hostname \"%s\" is already used", hostname), Err: fmt.Errorf("hostname '%s' is registered by team '%s'", hostname, previous.TeamID)}
+	}
+
+	if err != nil {
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when registering the hostname", Err: fmt.Errorf("failed to store hostname '%s': %w", hostname, err)}
+	}
+
+	if previous != nil && previous.SandboxID != sbx.SandboxID {
+		o.updateSandboxHostnames(previous.SandboxID, func(hostnames map[string]uint64) {
+			delete(hostnames, hostname)
+		})
+	}
+
+	o.updateSandboxHostnames(sbx.SandboxID, func(hostnames map[string]uint64) {
+		hostnames[hostname] = port
+	})
+
+	telemetry.ReportEvent(ctx, "Added sandbox hostname")
+
+	return nil
+}
+
+// RemoveSandboxHostname removes the custom hostname of the sandbox.
+func (o *Orchestrator) RemoveSandboxHostname(ctx context.Context, sbx sandbox.Sandbox, hostname string) *api.APIError {
+	if _, ok := sbx.Hostnames[hostname]; !ok {
+		return &api.APIError{Code: http.StatusNotFound, ClientMsg: fmt.Sprintf("Hostname \"%s\" not found", hostname), Err: fmt.Errorf("hostname '%s' is not registered for sandbox '%s'", hostname, sbx.SandboxID)}
+	}
+
+	o.dns.RemoveHostname(ctx, hostname, sbx.SandboxID)
+	o.updateSandboxHostnames(sbx.SandboxID, func(hostnames map[string]uint64) {
+		delete(hostnames, hostname)
+	})
+
+	return nil
+}
+
+// removeHostnames removes the custom hostnames of the sandbox from the catalog, when the sandbox stops running.
+func (o *Orchestrator) removeHostnames(ctx context.Context, sbx sandbox.Sandbox) {
+	for hostname := range sbx.Hostnames {
+		o.dns.RemoveHostname(ctx, hostname, sbx.SandboxID)
+	}
+}
+
+func (o *Orchestrator) updateSandboxHostnames(sandboxID string, update func(hostnames map[string]uint64)) {
+	_, err := o.sandboxStore.Update(sandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+		// The map is copied, the previous sandbox values can be still used by the readers.
+		hostnames := maps.Clone(sbx.Hostnames)
+		if hostnames == nil {
+			hostnames = make(map[string]uint64)
+		}
+
+		update(hostnames)
+		sbx.Hostnames = hostnames
+
+		return sbx, nil
+	})
+	if err != nil {
+		// The sandbox was removed in the meantime, the hostnames expire with the sandbox.
+		zap.L().Debug("failed to update sandbox hostnames in the store", logger.WithSandboxID(sandboxID), zap.Error(err))
+	}
+}
@@ -0,0 +1,81 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func stubLookupTXT(t *testing.T, lookup func(ctx context.Context, name string) ([]string, error)) {
+	t.Helper()
+
+	original := lookupTXT
+	lookupTXT = lookup
+	t.Cleanup(func() {
+		lookupTXT = original
+	})
+}
+
+func TestVerifyHostname(t *testing.T) {
+	teamID := uuid.New()
+	record, value := hostnameVerification(teamID, "app.example.com")
+
+	stubLookupTXT(t, func(_ context.Context, name string) ([]string, error) {
+		if name != record {
+			t.Fatalf("unexpected TXT lookup of %s", name)
+		}
+
+		return []string{"v=spf1 -all", value}, nil
+	})
+
+	if apiErr := verifyHostname(context.Background(), teamID, "app.example.com"); apiErr != nil {
+		t.Fatalf("expected hostname to be verified, got %v", apiErr.Err)
+	}
+}
+
+func TestVerifyHostnameOtherTeam(t *testing.T) {
+	_, value := hostnameVerification(uuid.New(), "app.example.com")
+
+	stubLookupTXT(t, func(context.Context, string) ([]string, error) {
+		return []string{value}, nil
+	})
+
+	apiErr := verifyHostname(context.Background(), uuid.New(), "app.example.com")
+	if apiErr == nil || apiErr.Code != http.StatusBadRequest {
+		t.Fatalf("expected bad request, got %v", apiErr)
+	}
+}
+
+func TestVerifyHostnameMissingRecord(t *testing.T) {
+	teamID := uuid.New()
+	record, value := hostnameVerification(teamID, "app.example.com")
+
+	stubLookupTXT(t, func(_ context.Context, name string) ([]string, error) {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	})
+
+	apiErr := verifyHostname(context.Background(), teamID, "app.example.com")
+	if apiErr == nil || apiErr.Code != http.StatusBadRequest {
+		t.Fatalf("expected bad request, got %v", apiErr)
+	}
+
+	if !strings.Contains(apiErr.ClientMsg, record) || !strings.Contains(apiErr.ClientMsg, value) {
+		t.Fatalf("expected the message to contain the verification record, got %q", apiErr.ClientMsg)
+	}
+}
+
+func TestVerifyHostnameLookupError(t *testing.T) {
+	stubLookupTXT(t, func(context.Context, string) ([]string, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	apiErr := verifyHostname(context.Background(), uuid.New(), "app.example.com")
+	if apiErr == nil || apiErr.Code != http.StatusInternalServerError {
+		t.Fatalf("expected internal server error, got %v", apiErr)
+	}
+}
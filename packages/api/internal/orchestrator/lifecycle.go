@@ -6,6 +6,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/nodemanager"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	e2bcatalog "github.com/e2b-dev/infra/packages/shared/pkg/sandbox-catalog"
@@ -22,15 +23,32 @@ func (o *Orchestrator) addToNode(ctx context.Context, sandbox sandbox.Sandbox, _
 	} else {
 		node.AddSandbox(sandbox)
 
-		info := e2bcatalog.SandboxInfo{
-			OrchestratorID: node.Metadata().ServiceInstanceID,
-			OrchestratorIP: node.IPAddress,
-			ExecutionID:    sandbox.ExecutionID,
+		o.dns.Add(ctx, sandbox.SandboxID, catalogInfo(node, sandbox))
+		o.addTeamNetworkName(ctx, sandbox)
+	}
+}
+
+// updateCatalog replaces the sandbox catalog entry, so the proxies use the current sandbox configuration.
+func (o *Orchestrator) updateCatalog(ctx context.Context, sbx sandbox.Sandbox) {
+	node := o.GetNode(sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		zap.L().Error("failed to get node", logger.WithNodeID(sbx.NodeID))
+
+		return
+	}
+
+	o.dns.Add(ctx, sbx.SandboxID, catalogInfo(node, sbx))
+}
+
+func catalogInfo(node *nodemanager.Node, sbx sandbox.Sandbox) e2bcatalog.SandboxInfo {
+	return e2bcatalog.SandboxInfo{
+		OrchestratorID: node.Metadata().ServiceInstanceID,
+		OrchestratorIP: node.IPAddress,
+		ExecutionID:    sbx.ExecutionID,
 
-			SandboxStartedAt:        sandbox.StartTime,
-			SandboxMaxLengthInHours: int64(sandbox.MaxInstanceLength / time.Hour),
-		}
+		SandboxStartedAt:        sbx.StartTime,
+		SandboxMaxLengthInHours: int64(sbx.MaxInstanceLength / time.Hour),
 
-		o.dns.Add(ctx, sandbox.SandboxID, info)
+		PortPolicy: sandbox.PortPolicy(sbx),
 	}
 }
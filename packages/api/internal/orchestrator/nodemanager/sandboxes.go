@@ -46,34 +46,36 @@ func (n *Node) GetSandboxes(ctx context.Context) ([]sandbox.Sandbox, error) {
 			return nil, fmt.Errorf("failed to parse build ID '%s' for job: %w", config.GetBuildId(), parseErr)
 		}
 
-		sandboxesInfo = append(
-			sandboxesInfo,
-			sandbox.NewSandbox(
-				config.GetSandboxId(),
-				config.GetTemplateId(),
-				consts.ClientID,
-				config.Alias, //nolint:protogetter // we need the nil check too
-				config.GetExecutionId(),
-				teamID,
-				buildID,
-				config.GetMetadata(),
-				time.Duration(config.GetMaxSandboxLength())*time.Hour,
-				sbx.GetStartTime().AsTime(),
-				sbx.GetEndTime().AsTime(),
-				config.GetVcpu(),
-				config.GetTotalDiskSizeMb(),
-				config.GetRamMb(),
-				config.GetKernelVersion(),
-				config.GetFirecrackerVersion(),
-				config.GetEnvdVersion(),
-				n.ID,
-				n.ClusterID,
-				config.GetAutoPause(),
-				config.EnvdAccessToken,     //nolint:protogetter // we need the nil check too
-				config.AllowInternetAccess, //nolint:protogetter // we need the nil check too
-				config.GetBaseTemplateId(),
-			),
+		sbxInfo := sandbox.NewSandbox(
+			config.GetSandboxId(),
+			config.GetTemplateId(),
+			consts.ClientID,
+			config.Alias, //nolint:protogetter // we need the nil check too
+			config.GetExecutionId(),
+			teamID,
+			buildID,
+			config.GetMetadata(),
+			time.Duration(config.GetMaxSandboxLength())*time.Hour,
+			sbx.GetStartTime().AsTime(),
+			sbx.GetEndTime().AsTime(),
+			config.GetVcpu(),
+			config.GetTotalDiskSizeMb(),
+			config.GetRamMb(),
+			config.GetKernelVersion(),
+			config.GetFirecrackerVersion(),
+			config.GetEnvdVersion(),
+			n.ID,
+			n.ClusterID,
+			config.GetAutoPause(),
+			config.EnvdAccessToken,     //nolint:protogetter // we need the nil check too
+			config.AllowInternetAccess, //nolint:protogetter // we need the nil check too
+			sandbox.NetworkConfigFromGRPC(config.GetNetwork()),
+			sandbox.CheckpointConfigFromGRPC(config.GetCheckpoint()),
+			config.GetBaseTemplateId(),
 		)
+		sbxInfo.APIKeyHashes = config.GetNetwork().GetIngress().GetApiKeyHashes()
+
+		sandboxesInfo = append(sandboxesInfo, sbxInfo)
 	}
 
 	return sandboxesInfo, nil
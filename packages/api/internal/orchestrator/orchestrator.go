@@ -22,6 +22,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/placement"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox/store/memory"
+	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
 	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
@@ -49,6 +50,7 @@ type Orchestrator struct {
 	sqlcDB                  *sqlcdb.Client
 	tel                     *telemetry.Client
 	clusters                *edge.Pool
+	templateManager         *template_manager.TemplateManager
 	metricsRegistration     metric.Registration
 	createdSandboxesCounter metric.Int64Counter
 	teamMetricsObserver     *metrics.TeamObserver
@@ -66,6 +68,7 @@ func New(
 	dbClient *db.DB,
 	sqlcDB *sqlcdb.Client,
 	clusters *edge.Pool,
+	templateManager *template_manager.TemplateManager,
 	featureFlags *featureflags.Client,
 ) (*Orchestrator, error) {
 	analyticsInstance, err := analyticscollector.NewAnalytics(
@@ -123,6 +126,7 @@ func New(
 		sqlcDB:             sqlcDB,
 		tel:                tel,
 		clusters:           clusters,
+		templateManager:    templateManager,
 
 		sandboxCounter: sandboxCounter,
 		createdCounter: createdCounter,
@@ -164,6 +168,7 @@ func New(
 	}
 
 	go o.reportLongRunningSandboxes(ctx)
+	go o.checkpointSandboxes(ctx)
 	go o.startStatusLogging(ctx)
 	go o.updateBestOfKConfig(ctx)
 
@@ -29,25 +29,9 @@ func (o *Orchestrator) pauseSandbox(ctx context.Context, node *nodemanager.Node,
 	ctx, span := tracer.Start(ctx, "pause-sandbox")
 	defer span.End()
 
-	snapshotConfig := &db.SnapshotInfo{
-		BaseTemplateID:      sbx.BaseTemplateID,
-		SandboxID:           sbx.SandboxID,
-		SandboxStartedAt:    sbx.StartTime,
-		VCPU:                sbx.VCpu,
-		RAMMB:               sbx.RamMB,
-		TotalDiskSizeMB:     sbx.TotalDiskSizeMB,
-		Metadata:            sbx.Metadata,
-		KernelVersion:       sbx.KernelVersion,
-		FirecrackerVersion:  sbx.FirecrackerVersion,
-		EnvdVersion:         sbx.EnvdVersion,
-		EnvdSecured:         sbx.EnvdAccessToken != nil,
-		AllowInternetAccess: sbx.AllowInternetAccess,
-		AutoPause:           sbx.AutoPause,
-	}
-
 	envBuild, err := o.dbClient.NewSnapshotBuild(
 		ctx,
-		snapshotConfig,
+		newSnapshotInfo(sbx),
 		sbx.TeamID,
 		sbx.NodeID,
 	)
@@ -87,6 +71,26 @@ func (o *Orchestrator) pauseSandbox(ctx context.Context, node *nodemanager.Node,
 	return nil
 }
 
+func newSnapshotInfo(sbx sandbox.Sandbox) *db.SnapshotInfo {
+	return &db.SnapshotInfo{
+		BaseTemplateID:      sbx.BaseTemplateID,
+		SandboxID:           sbx.SandboxID,
+		SandboxStartedAt:    sbx.StartTime,
+		VCPU:                sbx.VCpu,
+		RAMMB:               sbx.RamMB,
+		TotalDiskSizeMB:     sbx.TotalDiskSizeMB,
+		Metadata:            sbx.Metadata,
+		KernelVersion:       sbx.KernelVersion,
+		FirecrackerVersion:  sbx.FirecrackerVersion,
+		EnvdVersion:         sbx.EnvdVersion,
+		EnvdSecured:         sbx.EnvdAccessToken != nil,
+		AllowInternetAccess: sbx.AllowInternetAccess,
+		Network:             sandbox.NetworkConfigToSchema(sbx.Network),
+		Checkpoint:          sandbox.CheckpointConfigToSchema(sbx.Checkpoint),
+		AutoPause:           sbx.AutoPause,
+	}
+}
+
 func snapshotInstance(ctx context.Context, orch *Orchestrator, node *nodemanager.Node, sbx sandbox.Sandbox, templateID, buildID string) error {
 	childCtx, childSpan := tracer.Start(ctx, "snapshot-instance")
 	defer childSpan.End()
@@ -0,0 +1,60 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+)
+
+// checkTeamNetworkName checks the name of the new sandbox isn't used by another running sandbox of the team network.
+func (o *Orchestrator) checkTeamNetworkName(ctx context.Context, sandboxID string, teamID string, network *types.SandboxNetworkConfig) *api.APIError {
+	if network == nil || network.TeamNetwork == nil || network.TeamNetwork.Name == "" {
+		return nil
+	}
+
+	teamNetwork := proxy.TeamNetwork{TeamID: teamID, Group: network.TeamNetwork.Group}
+	name := network.TeamNetwork.Name
+
+	registered, err := o.dns.GetTeamNetworkName(ctx, teamNetwork, name)
+	if err != nil {
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when checking the team network name", Err: fmt.Errorf("failed to get team network name '%s': %w", name, err)}
+	}
+
+	if registered == "" || registered == sandboxID {
+		return nil
+	}
+
+	// The name is released when the sandbox stops, but the sandbox could have been removed without it.
+	if _, err := o.sandboxStore.Get(registered, false); err != nil {
+		return nil
+	}
+
+	return &api.APIError{Code: http.StatusConflict, ClientMsg: fmt.Sprintf("Name \"%s\" is already used in the team network", name), Err: fmt.Errorf("team network name '%s' is used by sandbox '%s'", name, registered)}
+}
+
+// addTeamNetworkName registers the sandbox name, so the other sandboxes of the team network can resolve it.
+func (o *Orchestrator) addTeamNetworkName(ctx context.Context, sbx sandbox.Sandbox) {
+	network, name := sandbox.TeamNetwork(sbx)
+	if network == nil {
+		return
+	}
+
+	lifetime := time.Until(sbx.StartTime.Add(sbx.MaxInstanceLength))
+	o.dns.AddTeamNetworkName(ctx, *network, name, sbx.SandboxID, lifetime)
+}
+
+// removeTeamNetworkName removes the sandbox name from the team network, when the sandbox stops running.
+func (o *Orchestrator) removeTeamNetworkName(ctx context.Context, sbx sandbox.Sandbox) {
+	network, name := sandbox.TeamNetwork(sbx)
+	if network == nil {
+		return
+	}
+
+	o.dns.RemoveTeamNetworkName(ctx, *network, name, sbx.SandboxID)
+}
@@ -3,16 +3,25 @@ package orchestrator
 import (
 	"context"
 	_ "embed"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/gogo/status"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -51,3 +60,208 @@ func (o *Orchestrator) UpdateSandbox(
 
 	return nil
 }
+
+// UpdateSandboxNetwork replaces the network configuration of the running sandbox.
+func (o *Orchestrator) UpdateSandboxNetwork(
+	ctx context.Context,
+	sbx sandbox.Sandbox,
+	allowInternetAccess bool,
+	network *types.SandboxNetworkConfig,
+) *api.APIError {
+	childCtx, childSpan := tracer.Start(ctx, "update-sandbox-network",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.SandboxID),
+		),
+	)
+	defer childSpan.End()
+
+	client, childCtx, err := o.GetClient(childCtx, sbx.ClusterID, sbx.NodeID)
+	if err != nil {
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when updating sandbox network", Err: fmt.Errorf("failed to get client '%s': %w", sbx.NodeID, err)}
+	}
+
+	config := sandbox.NetworkConfigToGRPC(network, sbx.APIKeyHashes)
+	if config == nil {
+		// An empty configuration removes the egress policy.
+		config = &orchestrator.SandboxNetworkConfig{}
+	}
+
+	_, err = client.Sandbox.Update(
+		childCtx, &orchestrator.SandboxUpdateRequest{
+			SandboxId:           sbx.SandboxID,
+			Network:             config,
+			AllowInternetAccess: &allowInternetAccess,
+		},
+	)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return &api.APIError{Code: http.StatusNotFound, ClientMsg: "Sandbox not found", Err: err}
+			case codes.InvalidArgument:
+				return &api.APIError{Code: http.StatusBadRequest, ClientMsg: fmt.Sprintf("Invalid network configuration: %s", st.Message()), Err: err}
+			}
+		}
+
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when updating sandbox network", Err: fmt.Errorf("failed to update sandbox '%s' network: %w", sbx.SandboxID, utils.UnwrapGRPCError(err))}
+	}
+
+	_, err = o.sandboxStore.Update(sbx.SandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+		sbx.AllowInternetAccess = &allowInternetAccess
+		sbx.Network = network
+
+		return sbx, nil
+	})
+	if err != nil {
+		// The sandbox was removed in the meantime, the configuration doesn't have to be kept.
+		zap.L().Debug("failed to update sandbox network in the store", logger.WithSandboxID(sbx.SandboxID), zap.Error(err))
+	}
+
+	telemetry.ReportEvent(childCtx, "Updated sandbox network")
+
+	return nil
+}
+
+// UpdateSandboxPorts replaces the access rules of the running sandbox ports, the egress configuration is kept.
+func (o *Orchestrator) UpdateSandboxPorts(
+	ctx context.Context,
+	sbx sandbox.Sandbox,
+	ports []types.SandboxPortRule,
+) *api.APIError {
+	childCtx, childSpan := tracer.Start(ctx, "update-sandbox-ports",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.SandboxID),
+		),
+	)
+	defer childSpan.End()
+
+	network := &types.SandboxNetworkConfig{Ports: ports}
+	if sbx.Network != nil {
+		network.Egress = sbx.Network.Egress
+		network.TeamNetwork = sbx.Network.TeamNetwork
+	}
+
+	apiKeyHashes, err := o.getAPIKeyHashes(childCtx, sbx.TeamID)
+	if err != nil {
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when getting the team API keys", Err: err}
+	}
+
+	apiErr := o.updateSandboxIngress(childCtx, sbx, ports, apiKeyHashes)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	updated, err := o.sandboxStore.Update(sbx.SandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+		sbx.Network = network
+		sbx.APIKeyHashes = apiKeyHashes
+
+		return sbx, nil
+	})
+	if err != nil {
+		// The sandbox was removed in the meantime, the configuration doesn't have to be kept.
+		zap.L().Debug("failed to update sandbox ports in the store", logger.WithSandboxID(sbx.SandboxID), zap.Error(err))
+
+		return nil
+	}
+
+	o.updateCatalog(childCtx, updated)
+
+	telemetry.ReportEvent(childCtx, "Updated sandbox ports")
+
+	return nil
+}
+
+// RefreshTeamAPIKeyHashes propagates the current team API keys to the running sandboxes,
+// they are accepted by the ports requiring a team API key and by the tunnels.
+func (o *Orchestrator) RefreshTeamAPIKeyHashes(ctx context.Context, teamID uuid.UUID) error {
+	ctx, childSpan := tracer.Start(ctx, "refresh-team-api-key-hashes")
+	defer childSpan.End()
+
+	sandboxes := o.GetSandboxes(ctx, teamID, []sandbox.State{sandbox.StateRunning})
+	if len(sandboxes) == 0 {
+		return nil
+	}
+
+	apiKeyHashes, err := o.sqlcDB.GetTeamAPIKeyHashes(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to get team API key hashes: %w", err)
+	}
+
+	var errs []error
+	for _, sbx := range sandboxes {
+		var ports []types.SandboxPortRule
+		if sbx.Network != nil {
+			ports = sbx.Network.Ports
+		}
+
+		apiErr := o.updateSandboxIngress(ctx, sbx, ports, apiKeyHashes)
+		if apiErr != nil {
+			errs = append(errs, fmt.Errorf("sandbox '%s': %w", sbx.SandboxID, apiErr.Err))
+
+			continue
+		}
+
+		updated, err := o.sandboxStore.Update(sbx.SandboxID, func(sbx sandbox.Sandbox) (sandbox.Sandbox, error) {
+			sbx.APIKeyHashes = apiKeyHashes
+
+			return sbx, nil
+		})
+		if err != nil {
+			continue
+		}
+
+		o.updateCatalog(ctx, updated)
+	}
+
+	return errors.Join(errs...)
+}
+
+// updateSandboxIngress sends the access rules of the sandbox ports to the node.
+func (o *Orchestrator) updateSandboxIngress(
+	ctx context.Context,
+	sbx sandbox.Sandbox,
+	ports []types.SandboxPortRule,
+	apiKeyHashes []string,
+) *api.APIError {
+	client, ctx, err := o.GetClient(ctx, sbx.ClusterID, sbx.NodeID)
+	if err != nil {
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when updating sandbox ports", Err: fmt.Errorf("failed to get client '%s': %w", sbx.NodeID, err)}
+	}
+
+	config := sandbox.PortRulesToGRPC(ports, apiKeyHashes)
+	if config == nil {
+		// An empty configuration makes all ports public.
+		config = &orchestrator.SandboxNetworkIngressConfig{}
+	}
+
+	_, err = client.Sandbox.Update(
+		ctx, &orchestrator.SandboxUpdateRequest{
+			SandboxId: sbx.SandboxID,
+			Ingress:   config,
+		},
+	)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return &api.APIError{Code: http.StatusNotFound, ClientMsg: "Sandbox not found", Err: err}
+			case codes.InvalidArgument:
+				return &api.APIError{Code: http.StatusBadRequest, ClientMsg: fmt.Sprintf("Invalid port rules: %s", st.Message()), Err: err}
+			}
+		}
+
+		return &api.APIError{Code: http.StatusInternalServerError, ClientMsg: "Error when updating sandbox ports", Err: fmt.Errorf("failed to update sandbox '%s' ports: %w", sbx.SandboxID, utils.UnwrapGRPCError(err))}
+	}
+
+	return nil
+}
+
+// getAPIKeyHashes returns the hashes of the team API keys, they are accepted by the ports requiring a team API key and by the tunnels.
+func (o *Orchestrator) getAPIKeyHashes(ctx context.Context, teamID uuid.UUID) ([]string, error) {
+	apiKeyHashes, err := o.sqlcDB.GetTeamAPIKeyHashes(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team API key hashes: %w", err)
+	}
+
+	return apiKeyHashes, nil
+}
@@ -0,0 +1,61 @@
+package sandbox
+
+import (
+	"time"
+
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+// CheckpointConfigToGRPC converts the scheduled checkpoints configuration to the orchestrator request format,
+// the orchestrator only keeps it, so it can be reported back after the API restart.
+func CheckpointConfigToGRPC(checkpoint *types.SandboxCheckpointConfig) *orchestrator.SandboxCheckpointConfig {
+	if checkpoint == nil {
+		return nil
+	}
+
+	return &orchestrator.SandboxCheckpointConfig{
+		IntervalSeconds: checkpoint.IntervalSeconds,
+		Retention:       checkpoint.Retention,
+	}
+}
+
+// CheckpointConfigFromGRPC converts the scheduled checkpoints configuration reported by the orchestrator.
+func CheckpointConfigFromGRPC(checkpoint *orchestrator.SandboxCheckpointConfig) *types.SandboxCheckpointConfig {
+	if checkpoint == nil {
+		return nil
+	}
+
+	return &types.SandboxCheckpointConfig{
+		IntervalSeconds: checkpoint.GetIntervalSeconds(),
+		Retention:       checkpoint.GetRetention(),
+	}
+}
+
+// CheckpointConfigToSchema converts the scheduled checkpoints configuration to the format stored with the snapshot.
+func CheckpointConfigToSchema(checkpoint *types.SandboxCheckpointConfig) *schema.SandboxCheckpointConfig {
+	if checkpoint == nil {
+		return nil
+	}
+
+	return &schema.SandboxCheckpointConfig{
+		IntervalSeconds: checkpoint.IntervalSeconds,
+		Retention:       checkpoint.Retention,
+	}
+}
+
+// CheckpointDue checks if the running sandbox should be checkpointed,
+// the interval is counted from the last checkpoint or from the sandbox start.
+func (s Sandbox) CheckpointDue(now time.Time) bool {
+	if s.Checkpoint == nil || s.Checkpoint.IntervalSeconds == 0 {
+		return false
+	}
+
+	last := s.StartTime
+	if s.LastCheckpointTime.After(last) {
+		last = s.LastCheckpointTime
+	}
+
+	return now.Sub(last) >= time.Duration(s.Checkpoint.IntervalSeconds)*time.Second
+}
@@ -0,0 +1,40 @@
+package sandbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/db/types"
+)
+
+func TestSandboxCheckpointDue(t *testing.T) {
+	now := time.Now()
+	checkpoint := &types.SandboxCheckpointConfig{IntervalSeconds: 60, Retention: 5}
+
+	tests := []struct {
+		name string
+		sbx  Sandbox
+		want bool
+	}{
+		{name: "no checkpoints", sbx: Sandbox{StartTime: now.Add(-time.Hour)}},
+		{name: "interval from start", sbx: Sandbox{StartTime: now.Add(-time.Minute), Checkpoint: checkpoint}, want: true},
+		{name: "started recently", sbx: Sandbox{StartTime: now.Add(-time.Second), Checkpoint: checkpoint}},
+		{
+			name: "checkpointed recently",
+			sbx:  Sandbox{StartTime: now.Add(-time.Hour), LastCheckpointTime: now.Add(-time.Second), Checkpoint: checkpoint},
+		},
+		{
+			name: "interval from last checkpoint",
+			sbx:  Sandbox{StartTime: now.Add(-time.Hour), LastCheckpointTime: now.Add(-2 * time.Minute), Checkpoint: checkpoint},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.sbx.CheckpointDue(now))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package sandbox
+
+import (
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+var portVisibilityToGRPC = map[proxy.PortVisibility]orchestrator.SandboxPortVisibility{
+	proxy.PortVisibilityPublic:      orchestrator.SandboxPortVisibility_PortPublic,
+	proxy.PortVisibilityAccessToken: orchestrator.SandboxPortVisibility_PortAccessToken,
+	proxy.PortVisibilityAPIKey:      orchestrator.SandboxPortVisibility_PortApiKey,
+	proxy.PortVisibilityBlocked:     orchestrator.SandboxPortVisibility_PortBlocked,
+}
+
+var portVisibilityFromGRPC = map[orchestrator.SandboxPortVisibility]proxy.PortVisibility{
+	orchestrator.SandboxPortVisibility_PortPublic:      proxy.PortVisibilityPublic,
+	orchestrator.SandboxPortVisibility_PortAccessToken: proxy.PortVisibilityAccessToken,
+	orchestrator.SandboxPortVisibility_PortApiKey:      proxy.PortVisibilityAPIKey,
+	orchestrator.SandboxPortVisibility_PortBlocked:     proxy.PortVisibilityBlocked,
+}
+
+// NetworkConfigToGRPC converts the sandbox network configuration to the orchestrator request format.
+// The API key hashes are accepted by the ports requiring a team API key and by the tunnels.
+func NetworkConfigToGRPC(network *types.SandboxNetworkConfig, apiKeyHashes []string) *orchestrator.SandboxNetworkConfig {
+	if network == nil {
+		if len(apiKeyHashes) == 0 {
+			return nil
+		}
+
+		network = &types.SandboxNetworkConfig{}
+	}
+
+	config := &orchestrator.SandboxNetworkConfig{
+		Ingress: PortRulesToGRPC(network.Ports, apiKeyHashes),
+	}
+	if network.Egress != nil {
+		config.Egress = &orchestrator.SandboxNetworkEgressConfig{
+			AllowedCidrs:   network.Egress.AllowedCIDRs,
+			AllowedDomains: network.Egress.AllowedDomains,
+			AllowedPorts:   network.Egress.AllowedPorts,
+		}
+	}
+
+	if network.TeamNetwork != nil {
+		config.TeamNetwork = &orchestrator.SandboxTeamNetworkConfig{
+			Name:  network.TeamNetwork.Name,
+			Group: network.TeamNetwork.Group,
+		}
+	}
+
+	return config
+}
+
+// PortRulesToGRPC converts the access rules of the sandbox ports, it returns nil when there are no rules and no API keys.
+func PortRulesToGRPC(rules []types.SandboxPortRule, apiKeyHashes []string) *orchestrator.SandboxNetworkIngressConfig {
+	if len(rules) == 0 && len(apiKeyHashes) == 0 {
+		return nil
+	}
+
+	config := &orchestrator.SandboxNetworkIngressConfig{
+		ApiKeyHashes: apiKeyHashes,
+	}
+	for _, rule := range rules {
+		visibility, ok := portVisibilityToGRPC[proxy.PortVisibility(rule.Visibility)]
+		if !ok {
+			visibility = orchestrator.SandboxPortVisibility_PortBlocked
+		}
+
+		config.Ports = append(config.Ports, &orchestrator.SandboxPortRule{
+			Port:       rule.Port,
+			Visibility: visibility,
+		})
+	}
+
+	return config
+}
+
+// PortPolicy returns the access rules of the sandbox ports checked by the proxies,
+// nil when all ports are public and there are no credentials for the tunnels.
+func PortPolicy(sbx Sandbox) *proxy.PortPolicy {
+	var rules []types.SandboxPortRule
+	if sbx.Network != nil {
+		rules = sbx.Network.Ports
+	}
+
+	// The credentials are kept even without rules, the tunnels require them.
+	if len(rules) == 0 && sbx.EnvdAccessToken == nil && len(sbx.APIKeyHashes) == 0 {
+		return nil
+	}
+
+	policy := &proxy.PortPolicy{
+		Ports:        make(map[uint64]proxy.PortVisibility, len(rules)),
+		APIKeyHashes: sbx.APIKeyHashes,
+	}
+
+	if sbx.EnvdAccessToken != nil {
+		policy.AccessTokenHash = proxy.HashAccessToken(*sbx.EnvdAccessToken)
+	}
+
+	for _, rule := range rules {
+		visibility := proxy.PortVisibility(rule.Visibility)
+		if _, ok := portVisibilityToGRPC[visibility]; !ok {
+			visibility = proxy.PortVisibilityBlocked
+		}
+
+		policy.Ports[uint64(rule.Port)] = visibility
+	}
+
+	return policy
+}
+
+// NetworkConfigFromGRPC converts the sandbox network configuration reported by the orchestrator.
+func NetworkConfigFromGRPC(network *orchestrator.SandboxNetworkConfig) *types.SandboxNetworkConfig {
+	if network == nil {
+		return nil
+	}
+
+	config := &types.SandboxNetworkConfig{}
+	if egress := network.GetEgress(); egress != nil {
+		config.Egress = &types.SandboxNetworkEgressConfig{
+			AllowedCIDRs:   egress.GetAllowedCidrs(),
+			AllowedDomains: egress.GetAllowedDomains(),
+			AllowedPorts:   egress.GetAllowedPorts(),
+		}
+	}
+
+	if team := network.GetTeamNetwork(); team != nil {
+		config.TeamNetwork = &types.SandboxTeamNetworkConfig{
+			Name:  team.GetName(),
+			Group: team.GetGroup(),
+		}
+	}
+
+	for _, rule := range network.GetIngress().GetPorts() {
+		visibility, ok := portVisibilityFromGRPC[rule.GetVisibility()]
+		if !ok {
+			visibility = proxy.PortVisibilityBlocked
+		}
+
+		config.Ports = append(config.Ports, types.SandboxPortRule{
+			Port:       rule.GetPort(),
+			Visibility: string(visibility),
+		})
+	}
+
+	// The configuration with only the API key hashes is sent for the tunnels, the sandbox has no network configuration.
+	if config.Egress == nil && config.TeamNetwork == nil && len(config.Ports) == 0 {
+		return nil
+	}
+
+	return config
+}
+
+// NetworkConfigToSchema converts the sandbox network configuration to the format stored with the snapshot.
+func NetworkConfigToSchema(network *types.SandboxNetworkConfig) *schema.SandboxNetworkConfig {
+	if network == nil {
+		return nil
+	}
+
+	config := &schema.SandboxNetworkConfig{}
+	if network.Egress != nil {
+		config.Egress = &schema.SandboxNetworkEgressConfig{
+			AllowedCIDRs:   network.Egress.AllowedCIDRs,
+			AllowedDomains: network.Egress.AllowedDomains,
+			AllowedPorts:   network.Egress.AllowedPorts,
+		}
+	}
+
+	if network.TeamNetwork != nil {
+		config.TeamNetwork = &schema.SandboxTeamNetworkConfig{
+			Name:  network.TeamNetwork.Name,
+			Group: network.TeamNetwork.Group,
+		}
+	}
+
+	for _, rule := range network.Ports {
+		config.Ports = append(config.Ports, schema.SandboxPortRule{
+			Port:       rule.Port,
+			Visibility: rule.Visibility,
+		})
+	}
+
+	return config
+}
+
+// TeamNetwork returns the team network of the sandbox and its name in the network, nil when the sandbox isn't in a team network.
+// The sandbox ID is used as the name when the name isn't set.
+func TeamNetwork(sbx Sandbox) (*proxy.TeamNetwork, string) {
+	if sbx.Network == nil || sbx.Network.TeamNetwork == nil {
+		return nil, ""
+	}
+
+	name := sbx.Network.TeamNetwork.Name
+	if name == "" {
+		name = sbx.SandboxID
+	}
+
+	return &proxy.TeamNetwork{TeamID: sbx.TeamID.String(), Group: sbx.Network.TeamNetwork.Group}, name
+}
+
+// NetworkLimitsFromTier returns the rate limits of the sandbox network traffic for the team tier.
+func NetworkLimitsFromTier(tier *queries.Tier) *orchestrator.SandboxNetworkLimits {
+	return &orchestrator.SandboxNetworkLimits{
+		IngressBytesPerSecond:   uint64(max(tier.NetworkIngressBytesPerSecond, 0)),
+		EgressBytesPerSecond:    uint64(max(tier.NetworkEgressBytesPerSecond, 0)),
+		IngressPacketsPerSecond: uint64(max(tier.NetworkIngressPacketsPerSecond, 0)),
+		EgressPacketsPerSecond:  uint64(max(tier.NetworkEgressPacketsPerSecond, 0)),
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/e2b-dev/infra/packages/db/types"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 )
 
@@ -31,6 +32,8 @@ func NewSandbox(
 	autoPause bool,
 	envdAccessToken *string,
 	allowInternetAccess *bool,
+	network *types.SandboxNetworkConfig,
+	checkpoint *types.SandboxCheckpointConfig,
 	baseTemplateID string,
 ) Sandbox {
 	return Sandbox{
@@ -54,6 +57,8 @@ func NewSandbox(
 		EnvdVersion:         envdVersion,
 		EnvdAccessToken:     envdAccessToken,
 		AllowInternetAccess: allowInternetAccess,
+		Network:             network,
+		Checkpoint:          checkpoint,
 		NodeID:              nodeID,
 		ClusterID:           clusterID,
 		AutoPause:           autoPause,
@@ -84,9 +89,17 @@ type Sandbox struct {
 	EnvdVersion         string
 	EnvdAccessToken     *string
 	AllowInternetAccess *bool
-	NodeID              string
-	ClusterID           uuid.UUID
-	AutoPause           bool
+	Network             *types.SandboxNetworkConfig
+	Checkpoint          *types.SandboxCheckpointConfig
+	// LastCheckpointTime is the time of the last scheduled checkpoint, it's not persisted.
+	LastCheckpointTime time.Time
+	// APIKeyHashes are the hashes of the team API keys accepted by the ports requiring an API key, they are not persisted.
+	APIKeyHashes []string
+	// Hostnames are the custom hostnames routed to the sandbox ports, they are not persisted.
+	Hostnames map[string]uint64
+	NodeID    string
+	ClusterID uuid.UUID
+	AutoPause bool
 
 	State State
 }
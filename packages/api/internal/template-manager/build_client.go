@@ -5,9 +5,11 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/e2b-dev/infra/packages/api/internal/edge"
 	buildlogs "github.com/e2b-dev/infra/packages/api/internal/template-manager/logs"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -33,3 +35,22 @@ func (bc *BuildClient) GetLogs(ctx context.Context, templateID, buildID string,
 
 	return logsTotal
 }
+
+// StreamLogs opens a stream of the build logs from the template manager, the stream ends when the build is done
+func (bc *BuildClient) StreamLogs(ctx context.Context, templateID, buildID string, offset int32, level *logs.LogLevel) (templatemanagergrpc.TemplateService_TemplateBuildLogsStreamClient, error) {
+	reqCtx := metadata.NewOutgoingContext(ctx, bc.GRPC.Metadata)
+
+	var lvlReq *templatemanagergrpc.LogLevel
+	if level != nil {
+		lvlReq = templatemanagergrpc.LogLevel(*level).Enum()
+	}
+
+	return bc.GRPC.Client.Template.TemplateBuildLogsStream(
+		reqCtx, &templatemanagergrpc.TemplateStatusRequest{
+			TemplateID: templateID,
+			BuildID:    buildID,
+			Offset:     &offset,
+			Level:      lvlReq,
+		},
+	)
+}
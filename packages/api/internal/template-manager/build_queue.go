@@ -0,0 +1,397 @@
+package template_manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	ut "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	queueDispatchInterval = time.Second * 5
+	queueTimeout          = time.Hour * 2
+	// queueHeartbeatTimeout is how long the queued build is kept without the heartbeat of the API instance holding it
+	queueHeartbeatTimeout = queueDispatchInterval * 6
+)
+
+// queuedBuild is a build waiting for a free builder slot. The build config is kept only in memory
+// because the build secrets and registry credentials are never persisted, the queue state itself is in the database.
+// The instance holding the build refreshes its heartbeat, so the builds of the restarted or lost instances are failed by the other ones.
+type queuedBuild struct {
+	teamID    uuid.UUID
+	clusterID uuid.UUID
+	// nodeID is the builder assigned when the build was requested, it's preferred if it has a free slot
+	nodeID   string
+	template *templatemanagergrpc.TemplateConfig
+}
+
+// enqueueBuild moves the build to the queue, it's started as soon as a builder in the cluster has a free slot.
+func (tm *TemplateManager) enqueueBuild(ctx context.Context, buildID uuid.UUID, templateID string, build queuedBuild) error {
+	updated, err := tm.sqlcDB.EnqueueTemplateBuild(ctx, queries.EnqueueTemplateBuildParams{
+		BuildID:    buildID,
+		TemplateID: templateID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enqueue build: %w", err)
+	}
+
+	if updated == 0 {
+		return fmt.Errorf("build '%s' is not in waiting state", buildID)
+	}
+
+	tm.queueLock.Lock()
+	tm.queue[buildID] = build
+	tm.queueLock.Unlock()
+
+	tm.buildCache.SetStatus(buildID, envbuild.StatusQueued, types.BuildReason{})
+	telemetry.ReportEvent(ctx, "Template build queued")
+
+	tm.triggerDispatch()
+
+	return nil
+}
+
+// removeFromQueue removes the build from the local queue, it returns false if the build isn't queued by this instance.
+func (tm *TemplateManager) removeFromQueue(buildID uuid.UUID) bool {
+	tm.queueLock.Lock()
+	defer tm.queueLock.Unlock()
+
+	_, ok := tm.queue[buildID]
+	delete(tm.queue, buildID)
+
+	return ok
+}
+
+func (tm *TemplateManager) getQueuedBuild(buildID uuid.UUID) (queuedBuild, bool) {
+	tm.queueLock.Lock()
+	defer tm.queueLock.Unlock()
+
+	build, ok := tm.queue[buildID]
+
+	return build, ok
+}
+
+// triggerDispatch wakes up the queue dispatcher without waiting for the next dispatch interval.
+func (tm *TemplateManager) triggerDispatch() {
+	select {
+	case tm.dispatchSignal <- struct{}{}:
+	default:
+	}
+}
+
+// BuildsQueueDispatch starts the queued builds when a builder frees up.
+func (tm *TemplateManager) BuildsQueueDispatch(ctx context.Context) {
+	ticker := time.NewTicker(queueDispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-tm.dispatchSignal:
+		}
+
+		err := tm.dispatchQueuedBuilds(ctx)
+		if err != nil {
+			zap.L().Error("Error dispatching queued builds", zap.Error(err))
+		}
+	}
+}
+
+func (tm *TemplateManager) dispatchQueuedBuilds(ctx context.Context) error {
+	dbCtx, dbCtxCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer dbCtxCancel()
+
+	err := tm.failOrphanedQueuedBuilds(dbCtx)
+	if err != nil {
+		return err
+	}
+
+	queued, err := tm.sqlcDB.GetQueuedTemplateBuilds(dbCtx)
+	if err != nil {
+		return fmt.Errorf("failed to get queued builds: %w", err)
+	}
+
+	if len(queued) == 0 {
+		return nil
+	}
+
+	running, err := tm.sqlcDB.GetRunningTemplateBuildsCount(dbCtx)
+	if err != nil {
+		return fmt.Errorf("failed to get running builds: %w", err)
+	}
+
+	teamBuilds := make(map[uuid.UUID]int64)
+	nodeBuilds := make(map[string]int64)
+	for _, r := range running {
+		teamBuilds[r.TeamID] += r.Builds
+		nodeBuilds[r.ClusterNodeID] += r.Builds
+	}
+
+	fullClusters := make(map[uuid.UUID]bool)
+	for _, b := range orderQueuedBuilds(queued, teamBuilds) {
+		build, ok := tm.getQueuedBuild(b.BuildID)
+		if !ok {
+			// The build is queued by another API instance
+			continue
+		}
+
+		if fullClusters[build.clusterID] {
+			continue
+		}
+
+		nodeID, ok := tm.getFreeBuilder(build.clusterID, build.nodeID, nodeBuilds)
+		if !ok {
+			fullClusters[build.clusterID] = true
+			continue
+		}
+
+		dequeued, err := tm.sqlcDB.DequeueTemplateBuild(ctx, queries.DequeueTemplateBuildParams{
+			NodeID:  nodeID,
+			BuildID: b.BuildID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to dequeue build '%s': %w", b.BuildID, err)
+		}
+
+		tm.removeFromQueue(b.BuildID)
+		if dequeued == 0 {
+			// The build was canceled or failed while waiting in the queue
+			continue
+		}
+
+		nodeBuilds[nodeID]++
+		tm.buildCache.SetNode(b.BuildID, nodeID)
+		tm.buildCache.SetStatus(b.BuildID, envbuild.StatusWaiting, types.BuildReason{})
+
+		err = tm.startBuild(context.WithoutCancel(ctx), b.BuildID, build, nodeID)
+		if errors.Is(err, ErrBuildNotInProgress) {
+			zap.L().Info("Queued build was cancelled while it was being started", logger.WithBuildID(b.BuildID.String()), logger.WithTemplateID(b.TemplateID))
+		} else if err != nil {
+			zap.L().Error("Error starting queued build", zap.Error(err), logger.WithBuildID(b.BuildID.String()), logger.WithTemplateID(b.TemplateID))
+
+			statusErr := tm.SetStatus(ctx, b.TemplateID, b.BuildID, envbuild.StatusFailed, &templatemanagergrpc.TemplateBuildStatusReason{
+				Message: fmt.Sprintf("error when building env: %s", err),
+			})
+			if statusErr != nil {
+				zap.L().Error("Error setting build status", zap.Error(statusErr), logger.WithBuildID(b.BuildID.String()))
+			}
+		}
+	}
+
+	return nil
+}
+
+// failOrphanedQueuedBuilds refreshes the heartbeat of the builds queued by this instance
+// and fails the queued builds whose instance is gone, as their build config is lost.
+func (tm *TemplateManager) failOrphanedQueuedBuilds(ctx context.Context) error {
+	tm.queueLock.Lock()
+	buildIDs := slices.Collect(maps.Keys(tm.queue))
+	tm.queueLock.Unlock()
+
+	if len(buildIDs) > 0 {
+		err := tm.sqlcDB.RefreshQueuedTemplateBuilds(ctx, buildIDs)
+		if err != nil {
+			return fmt.Errorf("failed to refresh queued builds: %w", err)
+		}
+	}
+
+	reason := types.BuildReason{Message: "build was lost from the queue, the API instance holding it is gone"}
+	orphaned, err := tm.sqlcDB.FailOrphanedQueuedTemplateBuilds(ctx, queries.FailOrphanedQueuedTemplateBuildsParams{
+		Reason:      reason,
+		StaleBefore: time.Now().Add(-queueHeartbeatTimeout),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fail orphaned queued builds: %w", err)
+	}
+
+	for _, b := range orphaned {
+		zap.L().Warn("Failed orphaned queued build", logger.WithBuildID(b.ID.String()), logger.WithTemplateID(b.EnvID))
+
+		tm.removeFromQueue(b.ID)
+		tm.buildCache.SetStatus(b.ID, envbuild.StatusFailed, reason)
+	}
+
+	return nil
+}
+
+// getFreeBuilder returns the least loaded healthy builder with a free slot, the preferred builder is used if it has one.
+func (tm *TemplateManager) getFreeBuilder(clusterID uuid.UUID, preferredNodeID string, nodeBuilds map[string]int64) (string, bool) {
+	cluster, ok := tm.edgePool.GetClusterById(clusterID)
+	if !ok {
+		return "", false
+	}
+
+	nodeID := ""
+	for _, builder := range cluster.GetAvailableTemplateBuilders() {
+		if nodeBuilds[builder.NodeID] >= tm.maxBuildsPerBuilder {
+			continue
+		}
+
+		if builder.NodeID == preferredNodeID {
+			return builder.NodeID, true
+		}
+
+		if nodeID == "" || nodeBuilds[builder.NodeID] < nodeBuilds[nodeID] {
+			nodeID = builder.NodeID
+		}
+	}
+
+	return nodeID, nodeID != ""
+}
+
+// startBuild triggers the build on the builder node and starts syncing its status.
+func (tm *TemplateManager) startBuild(ctx context.Context, buildID uuid.UUID, build queuedBuild, nodeID string) error {
+	templateID := build.template.GetTemplateID()
+
+	cli, err := tm.GetClusterBuildClient(build.clusterID, nodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get builder: %w", err)
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, cli.GRPC.Metadata)
+	_, err = cli.GRPC.Client.Template.TemplateCreate(
+		reqCtx, &templatemanagergrpc.TemplateCreateRequest{
+			Template:   build.template,
+			CacheScope: ut.ToPtr(build.teamID.String()),
+		},
+	)
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to create template '%s': %w", templateID, err)
+	}
+	telemetry.ReportEvent(ctx, "Template build started")
+
+	// status building must be set after build is triggered because then
+	// it's possible build status job will be triggered before build cache on template manager is created and build will fail
+	started, err := tm.sqlcDB.StartTemplateBuild(ctx, queries.StartTemplateBuildParams{
+		BuildID:    buildID,
+		TemplateID: templateID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set build status to building: %w", err)
+	}
+
+	if started == 0 {
+		// The build was cancelled while it was being started, the cancellation couldn't reach the builder before
+		err = tm.cancelOnBuilder(ctx, buildID, templateID, build.clusterID, nodeID)
+		if err != nil {
+			zap.L().Error("Error cancelling build on the builder", zap.Error(err), logger.WithBuildID(buildID.String()))
+		}
+
+		return ErrBuildNotInProgress
+	}
+
+	tm.buildCache.SetStatus(buildID, envbuild.StatusBuilding, types.BuildReason{})
+	telemetry.ReportEvent(ctx, "created new environment", telemetry.WithTemplateID(templateID))
+
+	// Do not wait for global build sync trigger it immediately
+	go func(ctx context.Context) {
+		buildContext, buildSpan := tracer.Start(ctx, "template-background-build-env")
+		defer buildSpan.End()
+
+		err := tm.BuildStatusSync(buildContext, buildID, templateID, build.clusterID, nodeID)
+		if err != nil {
+			zap.L().Error("error syncing build status", zap.Error(err))
+		}
+
+		// Invalidate the cache
+		tm.templateCache.Invalidate(templateID)
+	}(ctx)
+
+	return nil
+}
+
+// GetQueuePosition returns the 1-based position of the build in the queue, it returns 0 if the build isn't queued.
+func (tm *TemplateManager) GetQueuePosition(ctx context.Context, buildID uuid.UUID) (int, error) {
+	queued, err := tm.sqlcDB.GetQueuedTemplateBuilds(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get queued builds: %w", err)
+	}
+
+	running, err := tm.sqlcDB.GetRunningTemplateBuildsCount(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get running builds: %w", err)
+	}
+
+	teamBuilds := make(map[uuid.UUID]int64)
+	for _, r := range running {
+		teamBuilds[r.TeamID] += r.Builds
+	}
+
+	for i, b := range orderQueuedBuilds(queued, teamBuilds) {
+		if b.BuildID == buildID {
+			return i + 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// orderQueuedBuilds returns the order in which the queued builds are dispatched. The builds with the higher tier priority go first,
+// the builds of the same priority are interleaved across the teams so the team with the fewest running builds goes first,
+// and the ties are resolved by the time the build was queued.
+func orderQueuedBuilds(queued []queries.GetQueuedTemplateBuildsRow, teamBuilds map[uuid.UUID]int64) []queries.GetQueuedTemplateBuildsRow {
+	pending := slices.Clone(queued)
+	slices.SortStableFunc(pending, func(a, b queries.GetQueuedTemplateBuildsRow) int {
+		return compareQueuedAt(a.QueuedAt, b.QueuedAt)
+	})
+
+	builds := make(map[uuid.UUID]int64, len(teamBuilds))
+	for teamID, count := range teamBuilds {
+		builds[teamID] = count
+	}
+
+	ordered := make([]queries.GetQueuedTemplateBuildsRow, 0, len(pending))
+	for len(pending) > 0 {
+		next := 0
+		for i := 1; i < len(pending); i++ {
+			candidate, best := pending[i], pending[next]
+
+			switch {
+			case candidate.BuildPriority != best.BuildPriority:
+				if candidate.BuildPriority > best.BuildPriority {
+					next = i
+				}
+			case builds[candidate.TeamID] < builds[best.TeamID]:
+				next = i
+			}
+		}
+
+		ordered = append(ordered, pending[next])
+		builds[pending[next].TeamID]++
+		pending = slices.Delete(pending, next, next+1)
+	}
+
+	return ordered
+}
+
+func compareQueuedAt(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return a.Compare(*b)
+	}
+}
@@ -0,0 +1,64 @@
+package template_manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+func queuedRow(teamID uuid.UUID, priority int32, queuedAt time.Time) queries.GetQueuedTemplateBuildsRow {
+	return queries.GetQueuedTemplateBuildsRow{
+		BuildID:       uuid.New(),
+		TeamID:        teamID,
+		BuildPriority: priority,
+		QueuedAt:      &queuedAt,
+	}
+}
+
+func buildIDs(rows []queries.GetQueuedTemplateBuildsRow) []uuid.UUID {
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.BuildID
+	}
+
+	return ids
+}
+
+func TestOrderQueuedBuilds_Priority(t *testing.T) {
+	now := time.Now()
+	teamA, teamB := uuid.New(), uuid.New()
+
+	low := queuedRow(teamA, 0, now)
+	high := queuedRow(teamB, 10, now.Add(time.Minute))
+
+	ordered := orderQueuedBuilds([]queries.GetQueuedTemplateBuildsRow{low, high}, nil)
+	assert.Equal(t, []uuid.UUID{high.BuildID, low.BuildID}, buildIDs(ordered))
+}
+
+func TestOrderQueuedBuilds_FairAcrossTeams(t *testing.T) {
+	now := time.Now()
+	teamA, teamB := uuid.New(), uuid.New()
+
+	a1 := queuedRow(teamA, 0, now)
+	a2 := queuedRow(teamA, 0, now.Add(time.Second))
+	a3 := queuedRow(teamA, 0, now.Add(2*time.Second))
+	b1 := queuedRow(teamB, 0, now.Add(3*time.Second))
+
+	ordered := orderQueuedBuilds([]queries.GetQueuedTemplateBuildsRow{a3, b1, a1, a2}, nil)
+	assert.Equal(t, []uuid.UUID{a1.BuildID, b1.BuildID, a2.BuildID, a3.BuildID}, buildIDs(ordered))
+}
+
+func TestOrderQueuedBuilds_RunningBuilds(t *testing.T) {
+	now := time.Now()
+	teamA, teamB := uuid.New(), uuid.New()
+
+	a1 := queuedRow(teamA, 0, now)
+	b1 := queuedRow(teamB, 0, now.Add(time.Second))
+
+	ordered := orderQueuedBuilds([]queries.GetQueuedTemplateBuildsRow{a1, b1}, map[uuid.UUID]int64{teamA: 2})
+	assert.Equal(t, []uuid.UUID{b1.BuildID, a1.BuildID}, buildIDs(ordered))
+}
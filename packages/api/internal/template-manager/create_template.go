@@ -8,12 +8,9 @@ import (
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
-	"go.uber.org/zap"
-	"google.golang.org/grpc/metadata"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
-	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -45,11 +42,15 @@ func (tm *TemplateManager) CreateTemplate(
 	diskSizeMB,
 	memoryMB int64,
 	readyCommand *string,
+	readyProbe *api.TemplateReadyProbe,
 	fromImage *string,
 	fromTemplate *string,
 	fromImageRegistry *api.FromImageRegistry,
+	fromGit *api.TemplateGitSource,
 	force *bool,
 	steps *[]api.TemplateStep,
+	stages *[]api.TemplateStage,
+	secrets *map[string]string,
 	clusterID uuid.UUID,
 	nodeID string,
 ) (e error) {
@@ -86,11 +87,6 @@ func (tm *TemplateManager) CreateTemplate(
 		return fmt.Errorf("failed to get features for firecracker version '%s': %w", firecrackerVersion, err)
 	}
 
-	cli, err := tm.GetClusterBuildClient(clusterID, nodeID)
-	if err != nil {
-		return fmt.Errorf("failed to get builder: %w", err)
-	}
-
 	var startCmd string
 	if startCommand != nil {
 		startCmd = *startCommand
@@ -117,12 +113,21 @@ func (tm *TemplateManager) CreateTemplate(
 		HugePages:          features.HasHugePages(),
 		StartCommand:       startCmd,
 		ReadyCommand:       readyCmd,
+		ReadyProbe:         convertReadyProbe(readyProbe),
 		Force:              force,
 		Steps:              convertTemplateSteps(steps),
 		FromImageRegistry:  imageRegistry,
+		FromGit:            convertGitSource(fromGit),
 	}
 
-	err = setTemplateSource(ctx, tm, teamID, template, fromImage, fromTemplate)
+	if secrets != nil {
+		template.Secrets = *secrets
+	}
+
+	err = setTemplateSource(ctx, tm, teamID, template, fromImage, fromTemplate, fromGit)
+	if err == nil {
+		template.Stages, err = convertTemplateStages(ctx, tm, teamID, stages, steps)
+	}
 	if err != nil {
 		// If the error is related to fromTemplate, set the build status to failed with the appropriate message
 		// This is to unify the error handling with fromImage errors
@@ -147,47 +152,16 @@ func (tm *TemplateManager) CreateTemplate(
 		return nil
 	}
 
-	reqCtx := metadata.NewOutgoingContext(ctx, cli.GRPC.Metadata)
-	_, err = cli.GRPC.Client.Template.TemplateCreate(
-		reqCtx, &templatemanagergrpc.TemplateCreateRequest{
-			Template:   template,
-			CacheScope: ut.ToPtr(teamID.String()),
-		},
-	)
-
-	err = utils.UnwrapGRPCError(err)
-	if err != nil {
-		return fmt.Errorf("failed to create template '%s': %w", templateID, err)
-	}
-	telemetry.ReportEvent(ctx, "Template build started")
-
-	// status building must be set after build is triggered because then
-	// it's possible build status job will be triggered before build cache on template manager is created and build will fail
-	err = tm.SetStatus(
-		ctx,
-		templateID,
-		buildID,
-		envbuild.StatusBuilding,
-		nil,
-	)
+	// The build waits in the queue until a builder has a free slot
+	err = tm.enqueueBuild(ctx, buildID, templateID, queuedBuild{
+		teamID:    teamID,
+		clusterID: clusterID,
+		nodeID:    nodeID,
+		template:  template,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to set build status to building: %w", err)
+		return fmt.Errorf("failed to enqueue template build: %w", err)
 	}
-	telemetry.ReportEvent(ctx, "created new environment", telemetry.WithTemplateID(templateID))
-
-	// Do not wait for global build sync trigger it immediately
-	go func(ctx context.Context) {
-		buildContext, buildSpan := tracer.Start(ctx, "template-background-build-env")
-		defer buildSpan.End()
-
-		err := tm.BuildStatusSync(buildContext, buildID, templateID, clusterID, nodeID)
-		if err != nil {
-			zap.L().Error("error syncing build status", zap.Error(err))
-		}
-
-		// Invalidate the cache
-		tm.templateCache.Invalidate(templateID)
-	}(context.WithoutCancel(ctx))
 
 	return nil
 }
@@ -209,8 +183,80 @@ func convertTemplateSteps(steps *[]api.TemplateStep) []*templatemanagergrpc.Temp
 			Args:      args,
 			FilesHash: step.FilesHash,
 			Force:     step.Force,
+			FromStage: step.FromStage,
+			Secrets:   convertSecretMounts(step.Secrets),
+		}
+	}
+	return result
+}
+
+func convertSecretMounts(mounts *[]api.TemplateSecretMount) []*templatemanagergrpc.TemplateSecretMount {
+	if mounts == nil {
+		return nil
+	}
+
+	result := make([]*templatemanagergrpc.TemplateSecretMount, len(*mounts))
+	for i, mount := range *mounts {
+		result[i] = &templatemanagergrpc.TemplateSecretMount{
+			Id:     mount.Id,
+			Env:    mount.Env,
+			Target: mount.Target,
+		}
+	}
+	return result
+}
+
+func convertGitSource(source *api.TemplateGitSource) *templatemanagergrpc.FromGitConfig {
+	if source == nil {
+		return nil
+	}
+
+	return &templatemanagergrpc.FromGitConfig{
+		Url:             source.Url,
+		Ref:             source.Ref,
+		Subdirectory:    source.Subdirectory,
+		DockerfilePath:  source.DockerfilePath,
+		DeployKeySecret: source.DeployKeySecret,
+	}
+}
+
+func convertReadyProbe(probe *api.TemplateReadyProbe) *templatemanagergrpc.ReadyProbe {
+	if probe == nil {
+		return nil
+	}
+
+	result := &templatemanagergrpc.ReadyProbe{}
+
+	switch {
+	case probe.Http != nil:
+		httpProbe := &templatemanagergrpc.ReadyProbeHTTP{
+			Port: uint32(probe.Http.Port),
+			Path: "/",
+		}
+		if probe.Http.Path != nil {
+			httpProbe.Path = *probe.Http.Path
 		}
+		if probe.Http.ExpectedStatus != nil {
+			httpProbe.ExpectedStatus = ut.ToPtr(uint32(*probe.Http.ExpectedStatus))
+		}
+
+		result.Check = &templatemanagergrpc.ReadyProbe_Http{Http: httpProbe}
+	case probe.Tcp != nil:
+		result.Check = &templatemanagergrpc.ReadyProbe_Tcp{Tcp: &templatemanagergrpc.ReadyProbeTCP{Port: uint32(probe.Tcp.Port)}}
+	case probe.Command != nil:
+		result.Check = &templatemanagergrpc.ReadyProbe_Command{Command: *probe.Command}
+	}
+
+	if probe.Interval != nil {
+		result.IntervalSeconds = ut.ToPtr(uint32(*probe.Interval))
+	}
+	if probe.Timeout != nil {
+		result.TimeoutSeconds = ut.ToPtr(uint32(*probe.Timeout))
+	}
+	if probe.Retries != nil {
+		result.Retries = ut.ToPtr(uint32(*probe.Retries))
 	}
+
 	return result
 }
 
@@ -271,39 +317,31 @@ func convertImageRegistry(registry *api.FromImageRegistry) (*templatemanagergrpc
 }
 
 // setTemplateSource sets the source (either fromImage or fromTemplate)
-func setTemplateSource(ctx context.Context, tm *TemplateManager, teamID uuid.UUID, template *templatemanagergrpc.TemplateConfig, fromImage *string, fromTemplate *string) error {
+func setTemplateSource(ctx context.Context, tm *TemplateManager, teamID uuid.UUID, template *templatemanagergrpc.TemplateConfig, fromImage *string, fromTemplate *string, fromGit *api.TemplateGitSource) error {
 	// hasImage can be empty for v1 template builds
 	hasImage := fromImage != nil
 	hasTemplate := fromTemplate != nil && *fromTemplate != ""
+	// The source of the template built from the repository Dockerfile is known only after the repository is cloned
+	hasDockerfile := fromGit != nil && fromGit.DockerfilePath != nil && *fromGit.DockerfilePath != ""
 
 	// Validate input: exactly one source must be provided
 	switch {
+	case hasDockerfile && (hasImage || hasTemplate):
+		return fmt.Errorf("cannot specify fromImage or fromTemplate together with the Dockerfile from the git repository")
+	case hasDockerfile:
+		return nil
 	case hasImage && hasTemplate:
 		return fmt.Errorf("cannot specify both fromImage and fromTemplate")
 	case !hasImage && !hasTemplate:
 		return fmt.Errorf("must specify either fromImage or fromTemplate")
 	case hasTemplate:
-		// Look up the base template by alias to get its metadata
-		baseTemplate, err := tm.sqlcDB.GetTemplateWithBuild(ctx, *fromTemplate)
+		baseTemplate, err := getFromTemplate(ctx, tm, teamID, *fromTemplate)
 		if err != nil {
-			return &FromTemplateError{
-				err:     err,
-				message: fmt.Sprintf("base template '%s' not found", *fromTemplate),
-			}
-		}
-
-		if !baseTemplate.Env.Public && baseTemplate.Env.TeamID != teamID {
-			return &FromTemplateError{
-				err:     nil,
-				message: fmt.Sprintf("you have no access to use '%s' as a base template", *fromTemplate),
-			}
+			return err
 		}
 
 		template.Source = &templatemanagergrpc.TemplateConfig_FromTemplate{
-			FromTemplate: &templatemanagergrpc.FromTemplateConfig{
-				Alias:   *fromTemplate,
-				BuildID: baseTemplate.EnvBuild.ID.String(),
-			},
+			FromTemplate: baseTemplate,
 		}
 	default: // hasImage
 		template.Source = &templatemanagergrpc.TemplateConfig_FromImage{
@@ -312,3 +350,116 @@ func setTemplateSource(ctx context.Context, tm *TemplateManager, teamID uuid.UUI
 	}
 	return nil
 }
+
+// getFromTemplate looks up the base template by alias to get its metadata
+func getFromTemplate(ctx context.Context, tm *TemplateManager, teamID uuid.UUID, alias string) (*templatemanagergrpc.FromTemplateConfig, error) {
+	baseTemplate, err := tm.sqlcDB.GetTemplateWithBuild(ctx, alias)
+	if err != nil {
+		return nil, &FromTemplateError{
+			err:     err,
+			message: fmt.Sprintf("base template '%s' not found", alias),
+		}
+	}
+
+	if !baseTemplate.Env.Public && baseTemplate.Env.TeamID != teamID {
+		return nil, &FromTemplateError{
+			err:     nil,
+			message: fmt.Sprintf("you have no access to use '%s' as a base template", alias),
+		}
+	}
+
+	return &templatemanagergrpc.FromTemplateConfig{
+		Alias:   alias,
+		BuildID: baseTemplate.EnvBuild.ID.String(),
+	}, nil
+}
+
+// convertTemplateStages converts the stages of the multi-stage build, the COPY steps may copy from a template alias instead of a stage,
+// such templates are added as the stages without any steps before the other stages.
+func convertTemplateStages(ctx context.Context, tm *TemplateManager, teamID uuid.UUID, stages *[]api.TemplateStage, steps *[]api.TemplateStep) ([]*templatemanagergrpc.TemplateStage, error) {
+	var apiStages []api.TemplateStage
+	if stages != nil {
+		apiStages = *stages
+	}
+
+	stageNames := make(map[string]bool, len(apiStages))
+	for _, stage := range apiStages {
+		if stage.Name == "" {
+			return nil, fmt.Errorf("stage name must not be empty")
+		}
+
+		if stageNames[stage.Name] {
+			return nil, fmt.Errorf("stage '%s' is defined more than once", stage.Name)
+		}
+
+		stageNames[stage.Name] = true
+	}
+
+	// The steps copying from the names which are not stages copy from the templates
+	var templateStages []*templatemanagergrpc.TemplateStage
+	addTemplateStages := func(steps *[]api.TemplateStep) error {
+		if steps == nil {
+			return nil
+		}
+
+		for _, step := range *steps {
+			if step.FromStage == nil || stageNames[*step.FromStage] {
+				continue
+			}
+
+			fromTemplate, err := getFromTemplate(ctx, tm, teamID, *step.FromStage)
+			if err != nil {
+				return err
+			}
+
+			stageNames[*step.FromStage] = true
+			templateStages = append(templateStages, &templatemanagergrpc.TemplateStage{
+				Name: *step.FromStage,
+				Source: &templatemanagergrpc.TemplateStage_FromTemplate{
+					FromTemplate: fromTemplate,
+				},
+			})
+		}
+
+		return nil
+	}
+
+	result := make([]*templatemanagergrpc.TemplateStage, 0, len(apiStages))
+	for _, stage := range apiStages {
+		hasImage := stage.FromImage != nil && *stage.FromImage != ""
+		hasTemplate := stage.FromTemplate != nil && *stage.FromTemplate != ""
+
+		converted := &templatemanagergrpc.TemplateStage{
+			Name:  stage.Name,
+			Steps: convertTemplateSteps(stage.Steps),
+		}
+
+		switch {
+		case hasImage && hasTemplate:
+			return nil, fmt.Errorf("cannot specify both fromImage and fromTemplate for stage '%s'", stage.Name)
+		case !hasImage && !hasTemplate:
+			return nil, fmt.Errorf("must specify either fromImage or fromTemplate for stage '%s'", stage.Name)
+		case hasTemplate:
+			fromTemplate, err := getFromTemplate(ctx, tm, teamID, *stage.FromTemplate)
+			if err != nil {
+				return nil, err
+			}
+
+			converted.Source = &templatemanagergrpc.TemplateStage_FromTemplate{FromTemplate: fromTemplate}
+		default:
+			converted.Source = &templatemanagergrpc.TemplateStage_FromImage{FromImage: *stage.FromImage}
+		}
+
+		if err := addTemplateStages(stage.Steps); err != nil {
+			return nil, err
+		}
+
+		result = append(result, converted)
+	}
+
+	if err := addTemplateStages(steps); err != nil {
+		return nil, err
+	}
+
+	return append(templateStages, result...), nil
+}
@@ -22,8 +22,11 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -43,6 +46,11 @@ type TemplateManager struct {
 	buildCache    *templatecache.TemplatesBuildCache
 	templateCache *templatecache.TemplateCache
 	sqlcDB        *sqlcdb.Client
+
+	queueLock           sync.Mutex
+	queue               map[uuid.UUID]queuedBuild
+	dispatchSignal      chan struct{}
+	maxBuildsPerBuilder int64
 }
 
 type DeleteBuild struct {
@@ -55,8 +63,12 @@ type DeleteBuild struct {
 
 const (
 	syncInterval = time.Minute * 1
+
+	buildCancelledReason = "build was cancelled"
 )
 
+var ErrBuildNotInProgress = errors.New("build is not in progress")
+
 func New(
 	config cfg.Config,
 	tracerProvider trace.TracerProvider,
@@ -82,6 +94,11 @@ func New(
 
 		lock:       sync.Mutex{},
 		processing: make(map[uuid.UUID]processingBuilds),
+
+		queueLock:           sync.Mutex{},
+		queue:               make(map[uuid.UUID]queuedBuild),
+		dispatchSignal:      make(chan struct{}, 1),
+		maxBuildsPerBuilder: config.TemplateBuilderMaxBuilds,
 	}
 
 	return tm, nil
@@ -168,6 +185,13 @@ func (tm *TemplateManager) DeleteBuild(ctx context.Context, buildID uuid.UUID, t
 	)
 	defer span.End()
 
+	// The queued build wasn't started on any builder yet, it's enough to remove it from the queue
+	if tm.removeFromQueue(buildID) {
+		return tm.SetStatus(ctx, templateID, buildID, envbuild.StatusCancelled, &templatemanagergrpc.TemplateBuildStatusReason{
+			Message: buildCancelledReason,
+		})
+	}
+
 	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
 	if err != nil {
 		// nodeID can be an orchestrator ID, if the build corresponds to a snapshot.
@@ -201,6 +225,74 @@ func (tm *TemplateManager) DeleteBuild(ctx context.Context, buildID uuid.UUID, t
 	return nil
 }
 
+// CancelBuild aborts the build which isn't finished yet. The build is marked as cancelled first, so it can't be started anymore,
+// then the build already dispatched to the builder is cancelled there as well.
+func (tm *TemplateManager) CancelBuild(ctx context.Context, buildID uuid.UUID, templateID string, status envbuild.Status, clusterID uuid.UUID, nodeID string) error {
+	ctx, span := tracer.Start(ctx, "cancel-template-build",
+		trace.WithAttributes(
+			telemetry.WithBuildID(buildID.String()),
+		),
+	)
+	defer span.End()
+
+	reason := types.BuildReason{Message: buildCancelledReason}
+	cancelled, err := tm.sqlcDB.CancelTemplateBuild(ctx, queries.CancelTemplateBuildParams{
+		Reason:     reason,
+		BuildID:    buildID,
+		TemplateID: templateID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cancel env build '%s': %w", buildID, err)
+	}
+
+	if cancelled == 0 {
+		return ErrBuildNotInProgress
+	}
+
+	tm.removeFromQueue(buildID)
+	tm.buildCache.SetStatus(buildID, envbuild.StatusCancelled, reason)
+	telemetry.ReportEvent(ctx, "cancelled template build")
+
+	switch status {
+	case envbuild.StatusBuilding:
+		err = tm.cancelOnBuilder(ctx, buildID, templateID, clusterID, nodeID)
+		if err != nil {
+			return err
+		}
+	case envbuild.StatusWaiting:
+		// The build may not be created on the builder yet, then it's cancelled when the start of the build finishes
+		err = tm.cancelOnBuilder(ctx, buildID, templateID, clusterID, nodeID)
+		if err != nil {
+			zap.L().Debug("Waiting build wasn't cancelled on the builder", zap.Error(err), logger.WithBuildID(buildID.String()))
+		}
+	}
+
+	return nil
+}
+
+// cancelOnBuilder cancels the build running on the builder node.
+func (tm *TemplateManager) cancelOnBuilder(ctx context.Context, buildID uuid.UUID, templateID string, clusterID uuid.UUID, nodeID string) error {
+	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get builder client: %w", err)
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, client.GRPC.Metadata)
+	_, err = client.GRPC.Client.Template.TemplateBuildCancel(
+		reqCtx, &templatemanagergrpc.TemplateBuildCancelRequest{
+			BuildID:    buildID.String(),
+			TemplateID: templateID,
+		},
+	)
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to cancel env build '%s': %w", buildID, err)
+	}
+
+	return nil
+}
+
 func (tm *TemplateManager) DeleteBuilds(ctx context.Context, builds []DeleteBuild) error {
 	for _, build := range builds {
 		err := tm.DeleteBuild(ctx, build.BuildID, build.TemplateID, build.ClusterID, build.NodeID)
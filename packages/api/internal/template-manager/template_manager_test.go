@@ -158,6 +158,19 @@ func TestPollBuildStatus_dispatchBasedOnStatus(t *testing.T) {
 			wantCompleteState: false,
 			wantErr:           true,
 		},
+		{
+			name: "should complete on cancelled status",
+			fields: fields{
+				templateManagerClient: &fakeTemplateManagerClient{},
+			},
+			args: args{
+				status: &templatemanagergrpc.TemplateBuildStatusResponse{
+					Status: templatemanagergrpc.TemplateBuildState_Cancelled,
+				},
+			},
+			wantCompleteState: true,
+			wantErr:           false,
+		},
 		{
 			name: "should handle completed status with nil metadata",
 			fields: fields{
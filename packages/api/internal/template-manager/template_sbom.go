@@ -0,0 +1,49 @@
+package template_manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+var ErrBuildSBOMNotFound = errors.New("build SBOM not found")
+
+// GetBuildSBOM reads the SBOM and the provenance record of the finished build,
+// they are in the template storage, so any builder of the cluster can serve them.
+func (tm *TemplateManager) GetBuildSBOM(ctx context.Context, clusterID uuid.UUID, buildID uuid.UUID, templateID string) (*templatemanagergrpc.TemplateBuildSBOMResponse, error) {
+	nodeID, err := tm.GetAvailableBuildClient(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available build client: %w", err)
+	}
+
+	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build client for template '%s': %w", templateID, err)
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, client.GRPC.Metadata)
+	resp, err := client.GRPC.Client.Template.TemplateBuildSBOM(
+		reqCtx, &templatemanagergrpc.TemplateBuildSBOMRequest{
+			BuildID:    buildID.String(),
+			TemplateID: templateID,
+		},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrBuildSBOMNotFound
+	}
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SBOM of build '%s': %w", buildID, err)
+	}
+
+	return resp, nil
+}
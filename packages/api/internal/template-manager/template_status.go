@@ -51,6 +51,23 @@ func (tm *TemplateManager) BuildStatusSync(ctx context.Context, buildID uuid.UUI
 		return nil
 	}
 
+	// waiting in the queue for a free builder, the builds of the API instances that are gone are failed by the queue dispatcher
+	if envBuildDb.Status == envbuild.StatusQueued {
+		if envBuildDb.QueuedAt != nil && time.Since(*envBuildDb.QueuedAt) > queueTimeout {
+			tm.removeFromQueue(buildID)
+			err = tm.SetStatus(ctx, templateID, buildID, envbuild.StatusFailed, &templatemanagergrpc.TemplateBuildStatusReason{
+				Message: fmt.Sprintf("build was in the queue for too long. Maximum queue time is %s.", queueTimeout),
+			})
+			return fmt.Errorf("build is in the queue for too long, failing it: %w", err)
+		}
+
+		// just wait for next sync
+		return nil
+	}
+
+	// the builder slot is freed when the build is done
+	defer tm.triggerDispatch()
+
 	checker := &PollBuildStatus{
 		client: tm,
 		logger: zap.L().With(logger.WithBuildID(buildID.String()), logger.WithTemplateID(templateID)),
@@ -177,6 +194,13 @@ func (c *PollBuildStatus) dispatchBasedOnStatus(ctx context.Context, status *tem
 			return false, errors.Wrap(err, "error when setting build status")
 		}
 		return true, nil
+	case templatemanagergrpc.TemplateBuildState_Cancelled:
+		// build cancelled
+		err := c.client.SetStatus(ctx, c.templateID, c.buildID, envbuild.StatusCancelled, status.GetReason())
+		if err != nil {
+			return false, errors.Wrap(err, "error when setting build status")
+		}
+		return true, nil
 	case templatemanagergrpc.TemplateBuildState_Completed:
 		// build completed
 		meta := status.GetMetadata()
@@ -188,6 +212,10 @@ func (c *PollBuildStatus) dispatchBasedOnStatus(ctx context.Context, status *tem
 		if err != nil {
 			return false, errors.Wrap(err, "error when finishing build")
 		}
+
+		if commitSHA := meta.GetGitCommitSha(); commitSHA != "" {
+			c.logger.Info("Template built from git commit", zap.String("git_commit_sha", commitSHA))
+		}
 		return true, nil
 	default:
 		c.logger.Debug("skipping status", zap.String("status", status.GetStatus().String()))
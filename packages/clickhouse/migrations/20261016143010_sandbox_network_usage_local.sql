@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sandbox_network_usage_local (
+    timestamp DateTime64(9) CODEC (Delta, ZSTD(1)),
+    sandbox_id String CODEC (ZSTD(1)),
+    sandbox_execution_id String CODEC (ZSTD(1)),
+    team_id UUID CODEC (ZSTD(1)),
+    ingress_bytes UInt64 CODEC (T64, ZSTD(1)),
+    egress_bytes UInt64 CODEC (T64, ZSTD(1))
+) ENGINE = MergeTree
+    PARTITION BY toDate(timestamp)
+    ORDER BY (team_id, sandbox_id, timestamp)
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sandbox_network_usage_local;
+-- +goose StatementEnd
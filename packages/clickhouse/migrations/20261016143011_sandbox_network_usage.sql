@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sandbox_network_usage as sandbox_network_usage_local
+    ENGINE = Distributed('cluster', currentDatabase(), 'sandbox_network_usage_local', xxHash64(team_id));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sandbox_network_usage;
+-- +goose StatementEnd
@@ -0,0 +1,113 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+
+	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
+)
+
+type SandboxNetworkUsageInsertBatcher struct {
+	*Batcher[clickhouse.SandboxNetworkUsage]
+
+	conn driver.Conn
+}
+
+const InsertSandboxNetworkUsageQuery = `
+INSERT INTO sandbox_network_usage
+(
+    timestamp,
+    sandbox_id,
+    sandbox_execution_id,
+    team_id,
+    ingress_bytes,
+    egress_bytes
+)
+VALUES (
+    ?,
+    ?,
+    ?,
+    ?,
+    ?,
+    ?
+)`
+
+func NewSandboxNetworkUsageInsertsBatcher(conn driver.Conn, opts BatcherOptions) (*SandboxNetworkUsageInsertBatcher, error) {
+	b := &SandboxNetworkUsageInsertBatcher{
+		conn: conn,
+	}
+
+	batcher, err := NewBatcher(b.processInsertSandboxNetworkUsageBatch, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := batcher.Start(); err != nil {
+		return nil, err
+	}
+
+	b.Batcher = batcher
+
+	return b, nil
+}
+
+func (b *SandboxNetworkUsageInsertBatcher) processInsertSandboxNetworkUsageBatch(usages []clickhouse.SandboxNetworkUsage) error {
+	ctx := context.Background()
+	batch, err := b.conn.PrepareBatch(
+		ctx, InsertSandboxNetworkUsageQuery, driver.WithReleaseConnection())
+	if err != nil {
+		return fmt.Errorf("error preparing batch: %w", err)
+	}
+
+	for _, usage := range usages {
+		err := batch.Append(
+			usage.Timestamp,
+			usage.SandboxID,
+			usage.SandboxExecutionID,
+			usage.TeamID,
+			usage.IngressBytes,
+			usage.EgressBytes,
+		)
+		if err != nil {
+			return fmt.Errorf("error appending %d sandbox network usage records to batch: %w", len(usages), err)
+		}
+	}
+
+	err = batch.Send()
+	if err != nil {
+		return fmt.Errorf("error sending %d sandbox network usage records batch: %w", len(usages), err)
+	}
+
+	return nil
+}
+
+func (b *SandboxNetworkUsageInsertBatcher) Push(usage clickhouse.SandboxNetworkUsage) error {
+	success, err := b.Batcher.Push(usage)
+	if err != nil {
+		return err
+	}
+	if !success {
+		return ErrBatcherQueueFull
+	}
+	return nil
+}
+
+func (b *SandboxNetworkUsageInsertBatcher) Close(ctx context.Context) error {
+	stopErr := b.Batcher.Stop()
+	closeErr := b.conn.Close()
+
+	var errs []error
+	if stopErr != nil {
+		errs = append(errs, fmt.Errorf("error stopping sandbox network usage insert batcher: %w", stopErr))
+	}
+	if closeErr != nil {
+		errs = append(errs, fmt.Errorf("error closing sandbox network usage insert batcher connection: %w", closeErr))
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
@@ -18,6 +18,10 @@ type Clickhouse interface {
 	QuerySandboxMetrics(ctx context.Context, sandboxID, teamID string, start time.Time, end time.Time, step time.Duration) ([]Metrics, error)
 	QueryLatestMetrics(ctx context.Context, sandboxIDs []string, teamID string) ([]Metrics, error)
 
+	// Sandbox network usage queries
+	QuerySandboxNetworkUsage(ctx context.Context, sandboxID, teamID string, start time.Time, end time.Time, step time.Duration) ([]SandboxNetworkUsageTotal, error)
+	QuerySandboxesNetworkUsage(ctx context.Context, sandboxIDs []string, teamID string) ([]SandboxNetworkUsageTotal, error)
+
 	// Events queries
 	ExistsSandboxId(ctx context.Context, sandboxID string) (bool, error)
 	SelectSandboxEventsBySandboxId(ctx context.Context, sandboxID string, offset, limit int, orderAsc bool) ([]SandboxEvent, error)
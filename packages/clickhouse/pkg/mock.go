@@ -29,6 +29,14 @@ func (m *NoopClient) QuerySandboxMetrics(ctx context.Context, sandboxID string,
 	return nil, nil
 }
 
+func (m *NoopClient) QuerySandboxNetworkUsage(ctx context.Context, sandboxID string, teamID string, start time.Time, end time.Time, step time.Duration) ([]SandboxNetworkUsageTotal, error) {
+	return nil, nil
+}
+
+func (m *NoopClient) QuerySandboxesNetworkUsage(ctx context.Context, sandboxIDs []string, teamID string) ([]SandboxNetworkUsageTotal, error) {
+	return nil, nil
+}
+
 func (m *NoopClient) ExistsSandboxId(ctx context.Context, sandboxID string) (bool, error) {
 	return false, nil
 }
@@ -21,6 +21,8 @@ type Metrics struct {
 	MemUsed        float64   `ch:"ram_used"`
 	DiskTotal      float64   `ch:"disk_total"`
 	DiskUsed       float64   `ch:"disk_used"`
+	NetworkIngress float64   `ch:"network_ingress"`
+	NetworkEgress  float64   `ch:"network_egress"`
 }
 
 var latestMetricsSelectQuery = fmt.Sprintf(`
@@ -32,6 +34,8 @@ SELECT sandbox_id,
        argMaxIf(value, timestamp, metric_name = '%s')  AS ram_used,
        argMaxIf(value, timestamp, metric_name = '%s')  AS disk_total,
        argMaxIf(value, timestamp, metric_name = '%s')  AS disk_used,
+       argMaxIf(value, timestamp, metric_name = '%s')  AS network_ingress,
+       argMaxIf(value, timestamp, metric_name = '%s')  AS network_egress,
        -- All metrics are recorded at the same time, so we can use max(timestamp) to get the latest one
        max(timestamp) as ts
 FROM   sandbox_metrics_gauge
@@ -39,7 +43,7 @@ WHERE  sandbox_id IN ?
        AND team_id = ?
 GROUP  BY sandbox_id,
           team_id; 
-`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName)
+`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName, telemetry.SandboxNetworkIngressGaugeName, telemetry.SandboxNetworkEgressGaugeName)
 
 // QueryLatestMetrics returns rows ordered by timestamp, paged by limit.
 func (c *Client) QueryLatestMetrics(ctx context.Context, sandboxIDs []string, teamID string) ([]Metrics, error) {
@@ -83,7 +87,9 @@ SELECT   toStartOfInterval(timestamp, interval {step:UInt32} second) AS ts,
          maxIf(value, metric_name = '%s')         					 AS ram_total,
          maxIf(value, metric_name = '%s')          					 AS ram_used,
          maxIf(value, metric_name = '%s')        					 AS disk_total,
-         maxIf(value, metric_name = '%s')         					 AS disk_used
+         maxIf(value, metric_name = '%s')         					 AS disk_used,
+         maxIf(value, metric_name = '%s')         					 AS network_ingress,
+         maxIf(value, metric_name = '%s')         					 AS network_egress
 FROM     sandbox_metrics_gauge s
 WHERE    sandbox_id = {sandbox_id:String}
 AND      team_id = {team_id:String}
@@ -91,7 +97,7 @@ AND      timestamp >= {start_time:DateTime64}
 AND      timestamp <= {end_time:DateTime64}
 GROUP BY ts
 ORDER BY ts;
-`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName)
+`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName, telemetry.SandboxNetworkIngressGaugeName, telemetry.SandboxNetworkEgressGaugeName)
 
 func (c *Client) QuerySandboxTimeRange(ctx context.Context, sandboxID string, teamID string) (time.Time, time.Time, error) {
 	var start, end time.Time
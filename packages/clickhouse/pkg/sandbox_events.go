@@ -28,6 +28,9 @@ const (
 	SandboxEventLabelResume SandboxEventLabel = "resume"
 	SandboxEventLabelUpdate SandboxEventLabel = "update"
 	SandboxEventLabelKill   SandboxEventLabel = "kill"
+	SandboxEventLabelFork   SandboxEventLabel = "fork"
+
+	SandboxEventLabelReadyProbeFailed SandboxEventLabel = "ready_probe_failed"
 )
 
 type SandboxEvent struct {
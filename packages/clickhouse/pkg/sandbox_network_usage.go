@@ -0,0 +1,119 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/google/uuid"
+)
+
+// SandboxNetworkUsage is the network traffic of the sandbox execution since the previous sample.
+type SandboxNetworkUsage struct {
+	Timestamp          time.Time `ch:"timestamp"`
+	SandboxID          string    `ch:"sandbox_id"`
+	SandboxExecutionID string    `ch:"sandbox_execution_id"`
+	TeamID             uuid.UUID `ch:"team_id"`
+	IngressBytes       uint64    `ch:"ingress_bytes"`
+	EgressBytes        uint64    `ch:"egress_bytes"`
+}
+
+// SandboxNetworkUsageTotal is the network traffic of the sandbox across all its executions up to the timestamp.
+type SandboxNetworkUsageTotal struct {
+	SandboxID    string    `ch:"sandbox_id"`
+	Timestamp    time.Time `ch:"ts"`
+	IngressBytes uint64    `ch:"ingress_bytes"`
+	EgressBytes  uint64    `ch:"egress_bytes"`
+}
+
+const sandboxNetworkUsageSelectQuery = `
+SELECT   sandbox_id,
+         ts,
+         ingress_bytes,
+         egress_bytes
+FROM (
+    SELECT   sandbox_id,
+             toStartOfInterval(timestamp, interval {step:UInt32} second) AS ts,
+             -- The running sum includes the traffic before the start of the interval
+             sum(sum(ingress_bytes)) OVER (ORDER BY ts)                  AS ingress_bytes,
+             sum(sum(egress_bytes)) OVER (ORDER BY ts)                   AS egress_bytes
+    FROM     sandbox_network_usage
+    WHERE    sandbox_id = {sandbox_id:String}
+    AND      team_id = toUUID({team_id:String})
+    AND      timestamp <= {end_time:DateTime64}
+    GROUP BY sandbox_id, ts
+)
+WHERE    ts >= toStartOfInterval({start_time:DateTime64}, interval {step:UInt32} second)
+ORDER BY ts;
+`
+
+// QuerySandboxNetworkUsage returns the total network traffic of the sandbox at the end of each step in the interval.
+// Steps without any traffic are omitted.
+func (c *Client) QuerySandboxNetworkUsage(ctx context.Context, sandboxID string, teamID string, start time.Time, end time.Time, step time.Duration) ([]SandboxNetworkUsageTotal, error) {
+	rows, err := c.conn.Query(ctx, sandboxNetworkUsageSelectQuery,
+		clickhouse.Named("sandbox_id", sandboxID),
+		clickhouse.Named("team_id", teamID),
+		clickhouse.DateNamed("start_time", start, clickhouse.Seconds),
+		clickhouse.DateNamed("end_time", end, clickhouse.Seconds),
+		clickhouse.Named("step", strconv.Itoa(int(step.Seconds()))),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query sandbox network usage: %w", err)
+	}
+
+	defer rows.Close()
+	var out []SandboxNetworkUsageTotal
+	for rows.Next() {
+		var u SandboxNetworkUsageTotal
+		if err := rows.ScanStruct(&u); err != nil {
+			return nil, fmt.Errorf("error scanning sandbox network usage: %w", err)
+		}
+		out = append(out, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over sandbox network usage rows: %w", err)
+	}
+
+	return out, nil
+}
+
+const sandboxesNetworkUsageSelectQuery = `
+SELECT sandbox_id,
+       max(timestamp)     AS ts,
+       sum(ingress_bytes) AS ingress_bytes,
+       sum(egress_bytes)  AS egress_bytes
+FROM   sandbox_network_usage
+WHERE  sandbox_id IN ?
+       AND team_id = toUUID(?)
+GROUP  BY sandbox_id;
+`
+
+// QuerySandboxesNetworkUsage returns the total network traffic of the sandboxes, sandboxes without any traffic are omitted.
+func (c *Client) QuerySandboxesNetworkUsage(ctx context.Context, sandboxIDs []string, teamID string) ([]SandboxNetworkUsageTotal, error) {
+	if len(sandboxIDs) == 0 {
+		return make([]SandboxNetworkUsageTotal, 0), nil
+	}
+
+	rows, err := c.conn.Query(ctx, sandboxesNetworkUsageSelectQuery,
+		sandboxIDs,
+		teamID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query sandboxes network usage: %w", err)
+	}
+	defer rows.Close()
+
+	var out []SandboxNetworkUsageTotal
+	for rows.Next() {
+		var u SandboxNetworkUsageTotal
+		if err := rows.ScanStruct(&u); err != nil {
+			return nil, fmt.Errorf("error scanning sandboxes network usage: %w", err)
+		}
+		out = append(out, u)
+	}
+
+	return out, rows.Err()
+}
@@ -15,6 +15,8 @@ type TeamMetrics struct {
 	Timestamp           time.Time `ch:"ts"`
 	SandboxStartedRate  float64   `ch:"started_sandboxes_rate"`
 	ConcurrentSandboxes int64     `ch:"concurrent_sandboxes"`
+	NetworkIngress      uint64    `ch:"network_ingress"`
+	NetworkEgress       uint64    `ch:"network_egress"`
 }
 
 var teamMetricsSelectQuery = fmt.Sprintf(`
@@ -39,18 +41,33 @@ WITH
       AND timestamp BETWEEN {start_time:DateTime64} AND {end_time:DateTime64}
 	GROUP BY ts
   ),
+  network AS (
+    SELECT
+      toStartOfInterval(timestamp, interval {step:UInt32} second) AS ts,
+      sum(ingress_bytes) AS network_ingress,
+      sum(egress_bytes) AS network_egress
+    FROM sandbox_network_usage
+    WHERE team_id = toUUID({team_id:String})
+      AND timestamp BETWEEN {start_time:DateTime64} AND {end_time:DateTime64}
+	GROUP BY ts
+  ),
   all_ts AS (
     SELECT ts FROM created
     UNION DISTINCT
     SELECT ts FROM concurrent
+    UNION DISTINCT
+    SELECT ts FROM network
   )
 SELECT
   all_ts.ts AS ts,
   COALESCE(created_sandboxes / {step:UInt32}::Float32, 0.0) AS started_sandboxes_rate,
-  COALESCE(concurrent_sandboxes, 0)                         AS concurrent_sandboxes
+  COALESCE(concurrent_sandboxes, 0)                         AS concurrent_sandboxes,
+  COALESCE(network_ingress, 0)                              AS network_ingress,
+  COALESCE(network_egress, 0)                               AS network_egress
 FROM all_ts
 LEFT JOIN created cr      ON cr.ts = all_ts.ts
 LEFT JOIN concurrent con ON con.ts = all_ts.ts
+LEFT JOIN network net     ON net.ts = all_ts.ts
 ORDER BY all_ts.ts ASC;
 `, telemetry.TeamSandboxCreated, telemetry.TeamSandboxRunningGaugeName)
 
@@ -82,10 +82,16 @@ func (s *NodePassThroughServer) catalogDeleteEventHandler(ctx context.Context, m
 		return nil, err
 	}
 
-	err = s.catalog.DeleteSandbox(ctx, d.SandboxID, d.ExecutionID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete sandbox from catalog: %w", err)
-	}
+	// The sandbox is removed only after the request succeeds, a failed fork keeps the source sandbox running.
+	return func(err error) {
+		if err != nil {
+			return
+		}
 
-	return nil, nil
+		ctx := context.WithoutCancel(ctx)
+		deleteErr := s.catalog.DeleteSandbox(ctx, d.SandboxID, d.ExecutionID)
+		if deleteErr != nil {
+			zap.L().Error("Failed to delete sandbox from catalog after request", zap.Error(deleteErr))
+		}
+	}, nil
 }
@@ -1,12 +1,22 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	api "github.com/e2b-dev/infra/packages/shared/pkg/http/edge"
+	l "github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -39,6 +49,14 @@ func (a *APIStore) V1TemplateBuildLogs(c *gin.Context, buildID string, params ap
 		offset = *params.Offset
 	}
 
+	// Stream the logs directly from the orchestrator while it still keeps the build
+	if strings.Contains(c.GetHeader("Accept"), "text/event-stream") {
+		streamed := a.streamTemplateBuildLogs(c, buildID, params, offset)
+		if streamed {
+			return
+		}
+	}
+
 	logsRaw, err := a.queryLogsProvider.QueryBuildLogs(ctx, params.TemplateID, buildID, start, end, templateBuildLogsLimit, offset, apiLevelToLogLevel(params.Level))
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when fetching template build logs")
@@ -66,3 +84,75 @@ func (a *APIStore) V1TemplateBuildLogs(c *gin.Context, buildID string, params ap
 		},
 	)
 }
+
+// streamTemplateBuildLogs sends the build logs as server-sent events until the build is finished,
+// returns false when the orchestrator doesn't have the build anymore and nothing was sent
+func (a *APIStore) streamTemplateBuildLogs(c *gin.Context, buildID string, params api.V1TemplateBuildLogsParams, offset int32) bool {
+	ctx := c.Request.Context()
+
+	o, ok := a.orchestratorPool.GetOrchestratorByNodeID(params.OrchestratorID)
+	if !ok {
+		return false
+	}
+
+	var level *templatemanagergrpc.LogLevel
+	if lvl := apiLevelToLogLevel(params.Level); lvl != nil {
+		level = templatemanagergrpc.LogLevel(*lvl).Enum()
+	}
+
+	stream, err := o.GetClient().Template.TemplateBuildLogsStream(
+		ctx, &templatemanagergrpc.TemplateStatusRequest{
+			TemplateID: params.TemplateID,
+			BuildID:    buildID,
+			Offset:     &offset,
+			Level:      level,
+		},
+	)
+	if err != nil {
+		zap.L().Error("failed to open template build logs stream", zap.Error(err), l.WithBuildID(buildID))
+		return false
+	}
+
+	// The first message tells if the orchestrator still has the build
+	entry, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		if status.Code(err) != codes.NotFound {
+			zap.L().Error("failed to receive template build logs", zap.Error(err), l.WithBuildID(buildID))
+		}
+
+		return false
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	index := int64(offset)
+	for err == nil {
+		index++
+
+		payload, marshalErr := json.Marshal(api.BuildLogEntry{
+			Timestamp: entry.GetTimestamp().AsTime(),
+			Message:   entry.GetMessage(),
+			Level:     api.LogLevel(logs.LevelToString(logs.LogLevel(entry.GetLevel()))),
+			Fields:    entry.GetFields(),
+		})
+		if marshalErr != nil {
+			zap.L().Error("failed to marshal template build log entry", zap.Error(marshalErr), l.WithBuildID(buildID))
+			return true
+		}
+
+		_, _ = fmt.Fprintf(c.Writer, "id: %d\nevent: log\ndata: %s\n\n", index, payload)
+		c.Writer.Flush()
+
+		entry, err = stream.Recv()
+	}
+
+	if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+		telemetry.ReportError(ctx, "error when streaming template build logs", err)
+	}
+
+	return true
+}
@@ -81,6 +81,17 @@ func (p *OrchestratorsPool) GetOrchestrator(instanceID string) (i *OrchestratorI
 	return nil, false
 }
 
+func (p *OrchestratorsPool) GetOrchestratorByNodeID(nodeID string) (i *OrchestratorInstance, ok bool) {
+	orchestrators := p.GetOrchestrators()
+	for _, i = range orchestrators {
+		if i.GetInfo().NodeID == nodeID {
+			return i, true
+		}
+	}
+
+	return nil, false
+}
+
 func (p *OrchestratorsPool) statusLogSync() {
 	ticker := time.NewTicker(statusLogInterval)
 	defer ticker.Stop()
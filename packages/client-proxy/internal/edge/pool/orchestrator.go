@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	e2bgrpcorchestratorinfo "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator-info"
+	e2bgrpctemplatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	l "github.com/e2b-dev/infra/packages/shared/pkg/logger"
 )
 
@@ -57,6 +58,7 @@ type OrchestratorInstance struct {
 
 type OrchestratorGRPCClient struct {
 	Info       e2bgrpcorchestratorinfo.InfoServiceClient
+	Template   e2bgrpctemplatemanager.TemplateServiceClient
 	Connection *grpc.ClientConn
 }
 
@@ -176,6 +178,7 @@ func newClient(tracerProvider trace.TracerProvider, meterProvider metric.MeterPr
 
 	return &OrchestratorGRPCClient{
 		Info:       e2bgrpcorchestratorinfo.NewInfoServiceClient(conn),
+		Template:   e2bgrpctemplatemanager.NewTemplateServiceClient(conn),
 		Connection: conn,
 	}, nil
 }
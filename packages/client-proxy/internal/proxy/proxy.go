@@ -81,19 +81,35 @@ func dnsResolution(sandboxId string, logger *zap.Logger) (string, error) {
 	return node, nil
 }
 
-func catalogResolution(ctx context.Context, sandboxId string, c catalog.SandboxesCatalog) (string, error) {
+func catalogResolution(ctx context.Context, sandboxId string, c catalog.SandboxesCatalog) (string, *reverseproxy.PortPolicy, error) {
 	s, err := c.GetSandbox(ctx, sandboxId)
 	if err != nil {
 		if errors.Is(err, catalog.ErrSandboxNotFound) {
-			return "", ErrNodeNotFound
+			return "", nil, ErrNodeNotFound
 		}
 
-		return "", fmt.Errorf("failed to get sandbox from catalog: %w", err)
+		return "", nil, fmt.Errorf("failed to get sandbox from catalog: %w", err)
 	}
 
 	// todo: when we will use edge for orchestrators discovery we can stop sending IP in the catalog
 	//  and just resolve node from pool to get the IP of the node
-	return s.OrchestratorIP, nil
+	return s.OrchestratorIP, s.PortPolicy, nil
+}
+
+// hostnameResolution resolves the custom hostnames registered by the teams in the catalog.
+func hostnameResolution(c catalog.SandboxesCatalog) reverseproxy.HostnameResolver {
+	return func(ctx context.Context, hostname string) (*reverseproxy.Route, error) {
+		h, err := c.GetHostname(ctx, hostname)
+		if err != nil {
+			if !errors.Is(err, catalog.ErrHostnameNotFound) {
+				zap.L().Warn("failed to resolve hostname with Redis resolution", zap.String("hostname", hostname), zap.Error(err))
+			}
+
+			return nil, nil
+		}
+
+		return &reverseproxy.Route{SandboxID: h.SandboxID, Port: h.Port}, nil
+	}
 }
 
 func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port uint, catalog catalog.SandboxesCatalog, useCatalogResolution bool, useDnsResolution bool) (*reverseproxy.Proxy, error) {
@@ -101,15 +117,23 @@ func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port
 		return nil, errors.New("catalog resolution and DNS resolution are both disabled, at least one must be enabled")
 	}
 
+	// Custom hostnames are stored only in the catalog.
+	var resolveHostname reverseproxy.HostnameResolver
+	if useCatalogResolution {
+		resolveHostname = hostnameResolution(catalog)
+	}
+
 	proxy := reverseproxy.New(
 		port,
 		idleTimeout,
 		func(r *http.Request) (*pool.Destination, error) {
-			sandboxId, port, err := reverseproxy.ParseHost(r.Host)
+			route, err := reverseproxy.ParseRequest(r, resolveHostname)
 			if err != nil {
 				return nil, err
 			}
 
+			sandboxId, port := route.SandboxID, route.Port
+
 			logger := zap.L().With(
 				zap.String("host", r.Host),
 				l.WithSandboxID(sandboxId),
@@ -118,9 +142,10 @@ func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port
 			)
 
 			var nodeIP string
+			var portPolicy *reverseproxy.PortPolicy
 
 			if useCatalogResolution {
-				nodeIP, err = catalogResolution(r.Context(), sandboxId, catalog)
+				nodeIP, portPolicy, err = catalogResolution(r.Context(), sandboxId, catalog)
 				if err != nil {
 					if !errors.Is(err, ErrNodeNotFound) {
 						logger.Warn("failed to resolve node ip with Redis resolution", zap.Error(err))
@@ -150,12 +175,30 @@ func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port
 				}
 			}
 
+			// The orchestrator proxy checks the port policy again, the sandboxes resolved by DNS are checked only there.
+			authorize := portPolicy.Authorize
+			// The tunnel upgrade is forwarded to the orchestrator proxy, which connects it to the sandbox.
+			// The policy of the sandboxes resolved by DNS is unknown, their tunnels are authorized only there.
+			if reverseproxy.TunnelProtocol(r) != "" && portPolicy != nil {
+				authorize = portPolicy.AuthorizeTunnel
+			}
+
+			if err := authorize(r, sandboxId, port); err != nil {
+				return nil, err
+			}
+
+			// The orchestrator proxy can't resolve the path-based routes and custom hostnames itself.
+			reverseproxy.SetRouteHeaders(r.Header, sandboxId, port)
+			// Only the orchestrators can tunnel the team network connections, which skip the port policy.
+			reverseproxy.DeleteTeamNetworkHeaders(r.Header)
+
 			logger.Debug("Proxying request", zap.String("node_ip", nodeIP))
 
 			return &pool.Destination{
 				SandboxId:     sandboxId,
 				RequestLogger: logger,
 				SandboxPort:   port,
+				PathPrefix:    route.PathPrefix,
 				ConnectionKey: clientProxyConnectionKey,
 				Url: &url.URL{
 					Scheme: "http",
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Add network rate limit columns to tiers table, 0 means unlimited
+ALTER TABLE "public"."tiers"
+    ADD COLUMN "network_ingress_bytes_per_second" bigint NOT NULL DEFAULT 0,
+    ADD COLUMN "network_egress_bytes_per_second" bigint NOT NULL DEFAULT 0,
+    ADD COLUMN "network_ingress_packets_per_second" bigint NOT NULL DEFAULT 0,
+    ADD COLUMN "network_egress_packets_per_second" bigint NOT NULL DEFAULT 0;
+
+-- Add check constraint for the network rate limits
+ALTER TABLE "public"."tiers" ADD CONSTRAINT "tiers_network_limits_check" CHECK (
+    network_ingress_bytes_per_second >= 0
+    AND network_egress_bytes_per_second >= 0
+    AND network_ingress_packets_per_second >= 0
+    AND network_egress_packets_per_second >= 0
+);
+
+-- Add comments for the new columns
+COMMENT ON COLUMN public.tiers.network_ingress_bytes_per_second
+    IS 'The maximum rate of the traffic to the sandbox in bytes per second, 0 means unlimited';
+COMMENT ON COLUMN public.tiers.network_egress_bytes_per_second
+    IS 'The maximum rate of the traffic from the sandbox in bytes per second, 0 means unlimited';
+COMMENT ON COLUMN public.tiers.network_ingress_packets_per_second
+    IS 'The maximum rate of the traffic to the sandbox in packets per second, 0 means unlimited';
+COMMENT ON COLUMN public.tiers.network_egress_packets_per_second
+    IS 'The maximum rate of the traffic from the sandbox in packets per second, 0 means unlimited';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- Drop the constraint and columns
+ALTER TABLE "public"."tiers" DROP CONSTRAINT IF EXISTS "tiers_network_limits_check";
+ALTER TABLE "public"."tiers"
+    DROP COLUMN IF EXISTS "network_ingress_bytes_per_second",
+    DROP COLUMN IF EXISTS "network_egress_bytes_per_second",
+    DROP COLUMN IF EXISTS "network_ingress_packets_per_second",
+    DROP COLUMN IF EXISTS "network_egress_packets_per_second";
+
+-- +goose StatementEnd
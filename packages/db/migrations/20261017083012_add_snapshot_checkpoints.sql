@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Add the scheduled checkpoints configuration of the sandbox
+ALTER TABLE "public"."snapshots" ADD COLUMN "checkpoint" jsonb NULL;
+
+-- Create "snapshot_checkpoints" table, the checkpoints are the snapshot builds created periodically while the sandbox is running
+CREATE TABLE IF NOT EXISTS "public"."snapshot_checkpoints" (
+    build_id uuid not null,
+    created_at timestamptz not null default CURRENT_TIMESTAMP,
+    snapshot_id uuid not null,
+    parent_build_id uuid null,
+    constraint snapshot_checkpoints_pkey primary key (build_id),
+    constraint snapshot_checkpoints_env_builds_build_id
+        foreign key (build_id) references "public"."env_builds" ("id") on update no action on delete cascade,
+    constraint snapshot_checkpoints_snapshots_snapshot_id
+        foreign key (snapshot_id) references "public"."snapshots" ("id") on update no action on delete cascade,
+    constraint snapshot_checkpoints_env_builds_parent_build_id
+        foreign key (parent_build_id) references "public"."env_builds" ("id") on update no action on delete set null
+);
+ALTER TABLE "public"."snapshot_checkpoints" ENABLE ROW LEVEL SECURITY;
+
+CREATE INDEX IF NOT EXISTS idx_snapshot_checkpoints_snapshot_created
+    ON "public"."snapshot_checkpoints" (snapshot_id, created_at DESC);
+
+COMMENT ON COLUMN public.snapshot_checkpoints.parent_build_id
+    IS 'The build the sandbox was running from when the checkpoint was created, the checkpoint diff is based on it';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."snapshot_checkpoints";
+ALTER TABLE "public"."snapshots" DROP COLUMN IF EXISTS "checkpoint";
+-- +goose StatementEnd
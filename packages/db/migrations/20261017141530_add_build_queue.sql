@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- The started builds wait in the queue with the "queued" status until a builder has a free slot
+ALTER TABLE "public"."env_builds" ADD COLUMN "queued_at" timestamptz NULL;
+
+-- Add the priority class of the queued builds to the tiers, the builds of the higher priority are dispatched first
+ALTER TABLE "public"."tiers" ADD COLUMN "build_priority" integer NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN public.env_builds.queued_at
+    IS 'The time the build was added to the build queue, the builds of the same priority and the same number of running builds are dispatched in this order';
+COMMENT ON COLUMN public.tiers.build_priority
+    IS 'The priority class of the template builds, the queued builds with the higher priority are dispatched first';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers" DROP COLUMN IF EXISTS "build_priority";
+ALTER TABLE "public"."env_builds" DROP COLUMN IF EXISTS "queued_at";
+-- +goose StatementEnd
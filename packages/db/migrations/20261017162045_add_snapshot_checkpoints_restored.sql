@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Mark the checkpoints a sandbox was restored from, the snapshots of the restored sandbox are based on their builds
+ALTER TABLE "public"."snapshot_checkpoints" ADD COLUMN "restored" boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN public.snapshot_checkpoints.restored
+    IS 'The sandbox was restored from the checkpoint, its build is kept when the checkpoint expires as the newer snapshots can be based on it';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshot_checkpoints" DROP COLUMN IF EXISTS "restored";
+-- +goose StatementEnd
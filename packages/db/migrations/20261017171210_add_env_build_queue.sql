@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Create "env_build_queue" table, the build config of the queued build is kept only in the memory of the API instance that queued it
+CREATE TABLE IF NOT EXISTS "public"."env_build_queue" (
+    build_id uuid not null,
+    heartbeat_at timestamptz not null default CURRENT_TIMESTAMP,
+    constraint env_build_queue_pkey primary key (build_id),
+    constraint env_build_queue_env_builds_build_id
+        foreign key (build_id) references "public"."env_builds" ("id") on update no action on delete cascade
+);
+ALTER TABLE "public"."env_build_queue" ENABLE ROW LEVEL SECURITY;
+
+COMMENT ON COLUMN public.env_build_queue.heartbeat_at
+    IS 'Refreshed by the API instance holding the queued build, the build is failed when the instance is gone and the heartbeat is stale';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."env_build_queue";
+-- +goose StatementEnd
@@ -0,0 +1,68 @@
+-- name: EnqueueTemplateBuild :execrows
+WITH queued AS (
+    UPDATE "public"."env_builds"
+    SET status = 'queued',
+        queued_at = now(),
+        updated_at = now()
+    WHERE id = @build_id AND env_id = @template_id AND status = 'waiting'
+    RETURNING id
+)
+INSERT INTO "public"."env_build_queue" (build_id)
+SELECT id FROM queued
+ON CONFLICT (build_id) DO UPDATE SET heartbeat_at = now();
+
+-- name: RefreshQueuedTemplateBuilds :exec
+UPDATE "public"."env_build_queue"
+SET heartbeat_at = now()
+WHERE build_id = ANY(@build_ids::uuid[]);
+
+-- name: FailOrphanedQueuedTemplateBuilds :many
+-- The queued builds without a recent heartbeat were held by an API instance that is gone, so they can't be started anymore.
+-- The heartbeats of the builds that already left the queue are removed.
+WITH dequeued AS (
+    DELETE FROM "public"."env_build_queue" q
+    USING "public"."env_builds" b
+    WHERE q.build_id = b.id AND b.status <> 'queued'
+)
+UPDATE "public"."env_builds" b
+SET status = 'failed',
+    finished_at = now(),
+    updated_at = now(),
+    reason = @reason
+WHERE b.status = 'queued' AND NOT EXISTS (
+    SELECT 1
+    FROM "public"."env_build_queue" q
+    WHERE q.build_id = b.id AND q.heartbeat_at > @stale_before
+)
+RETURNING b.id, b.env_id;
+
+-- name: GetQueuedTemplateBuilds :many
+SELECT b.id AS build_id, b.env_id AS template_id, b.queued_at, e.team_id, t.cluster_id, tier.build_priority
+FROM "public"."env_builds" b
+JOIN "public"."envs" e ON e.id = b.env_id
+JOIN "public"."teams" t ON t.id = e.team_id
+JOIN "public"."tiers" tier ON tier.id = t.tier
+WHERE b.status = 'queued'
+ORDER BY b.queued_at ASC;
+
+-- name: GetRunningTemplateBuildsCount :many
+SELECT e.team_id, b.cluster_node_id, count(*) AS builds
+FROM "public"."env_builds" b
+JOIN "public"."envs" e ON e.id = b.env_id
+WHERE b.status = 'building'
+GROUP BY e.team_id, b.cluster_node_id;
+
+-- name: DequeueTemplateBuild :execrows
+-- The build is moved back to the waiting status on the builder node, the status changes to building when the build is started there.
+UPDATE "public"."env_builds"
+SET status = 'waiting',
+    cluster_node_id = @node_id,
+    updated_at = now()
+WHERE id = @build_id AND status = 'queued';
+
+-- name: StartTemplateBuild :execrows
+-- The build is marked as building only when it wasn't cancelled or failed while it was being started on the builder.
+UPDATE "public"."env_builds"
+SET status = 'building',
+    updated_at = now()
+WHERE id = @build_id AND env_id = @template_id AND status = 'waiting';
@@ -0,0 +1,7 @@
+-- name: CancelTemplateBuild :execrows
+-- Only the builds which are not finished yet can be cancelled.
+UPDATE "public"."env_builds"
+SET status = 'cancelled',
+    finished_at = now(),
+    reason = sqlc.narg(reason)
+WHERE id = @build_id AND env_id = @template_id AND status IN ('waiting', 'queued', 'building', 'snapshotting');
@@ -3,6 +3,6 @@ SELECT sqlc.embed(t), sqlc.embed(e), sqlc.embed(b)
 FROM public.env_builds b
 JOIN public.envs e ON e.id = b.env_id
 JOIN public.teams t ON e.team_id = t.id
-WHERE b.status = 'waiting' OR b.status = 'building'
+WHERE b.status = 'waiting' OR b.status = 'queued' OR b.status = 'building'
 ORDER BY b.created_at DESC;
 
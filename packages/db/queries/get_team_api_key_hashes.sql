@@ -0,0 +1,4 @@
+-- name: GetTeamAPIKeyHashes :many
+SELECT api_key_hash
+FROM "public"."team_api_keys"
+WHERE team_id = @team_id;
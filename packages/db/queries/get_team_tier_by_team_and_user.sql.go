@@ -12,7 +12,7 @@ import (
 )
 
 const getTeamWithTierByTeamAndUser = `-- name: GetTeamWithTierByTeamAndUser :one
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, tier.id, tier.name, tier.disk_mb, tier.concurrent_instances, tier.max_length_hours, tier.max_vcpu, tier.max_ram_mb, tier.concurrent_template_builds
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, tier.id, tier.name, tier.disk_mb, tier.concurrent_instances, tier.max_length_hours, tier.max_vcpu, tier.max_ram_mb, tier.concurrent_template_builds, tier.network_ingress_bytes_per_second, tier.network_egress_bytes_per_second, tier.network_ingress_packets_per_second, tier.network_egress_packets_per_second, tier.build_priority
 FROM "public"."teams" t
 JOIN "public"."tiers" tier ON t.tier = tier.id
 JOIN "public"."users_teams" ut ON ut.team_id = t.id
@@ -50,6 +50,11 @@ func (q *Queries) GetTeamWithTierByTeamAndUser(ctx context.Context, arg GetTeamW
 		&i.Tier.MaxVcpu,
 		&i.Tier.MaxRamMb,
 		&i.Tier.ConcurrentTemplateBuilds,
+		&i.Tier.NetworkIngressBytesPerSecond,
+		&i.Tier.NetworkEgressBytesPerSecond,
+		&i.Tier.NetworkIngressPacketsPerSecond,
+		&i.Tier.NetworkEgressPacketsPerSecond,
+		&i.Tier.BuildPriority,
 	)
 	return i, err
 }
@@ -0,0 +1,5 @@
+-- name: GetTeamWithTier :one
+SELECT sqlc.embed(t), sqlc.embed(tier)
+FROM "public"."teams" t
+JOIN "public"."tiers" tier ON t.tier = tier.id
+WHERE t.id = $1;
@@ -78,6 +78,14 @@ type EnvBuild struct {
 	ReadyCmd           *string
 	ClusterNodeID      string
 	Reason             types.BuildReason
+	// The time the build was added to the build queue, the builds of the same priority and the same number of running builds are dispatched in this order
+	QueuedAt *time.Time
+}
+
+type EnvBuildQueue struct {
+	BuildID uuid.UUID
+	// Refreshed by the API instance holding the queued build, the build is failed when the instance is gone and the heartbeat is stale
+	HeartbeatAt time.Time
 }
 
 type Snapshot struct {
@@ -93,6 +101,18 @@ type Snapshot struct {
 	AllowInternetAccess *bool
 	AutoPause           bool
 	TeamID              uuid.UUID
+	Network             *types.SandboxNetworkConfig
+	Checkpoint          *types.SandboxCheckpointConfig
+}
+
+type SnapshotCheckpoint struct {
+	BuildID    uuid.UUID
+	CreatedAt  time.Time
+	SnapshotID uuid.UUID
+	// The build the sandbox was running from when the checkpoint was created, the checkpoint diff is based on it
+	ParentBuildID *uuid.UUID
+	// The sandbox was restored from the checkpoint, its build is kept when the checkpoint expires as the newer snapshots can be based on it
+	Restored bool
 }
 
 type Team struct {
@@ -134,6 +154,16 @@ type Tier struct {
 	MaxRamMb            int64
 	// The number of concurrent template builds the team can run
 	ConcurrentTemplateBuilds int64
+	// The maximum rate of the traffic to the sandbox in bytes per second, 0 means unlimited
+	NetworkIngressBytesPerSecond int64
+	// The maximum rate of the traffic from the sandbox in bytes per second, 0 means unlimited
+	NetworkEgressBytesPerSecond int64
+	// The maximum rate of the traffic to the sandbox in packets per second, 0 means unlimited
+	NetworkIngressPacketsPerSecond int64
+	// The maximum rate of the traffic from the sandbox in packets per second, 0 means unlimited
+	NetworkEgressPacketsPerSecond int64
+	// The priority class of the template builds, the queued builds with the higher priority are dispatched first
+	BuildPriority int32
 }
 
 type UsersTeam struct {
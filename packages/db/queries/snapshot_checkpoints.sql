@@ -0,0 +1,49 @@
+-- name: CreateSnapshotCheckpoint :exec
+INSERT INTO "public"."snapshot_checkpoints" (build_id, snapshot_id, parent_build_id)
+SELECT @build_id, s.id, sqlc.narg(parent_build_id)
+FROM "public"."snapshots" s
+WHERE s.sandbox_id = @sandbox_id AND s.team_id = @team_id;
+
+-- name: DeleteExpiredSnapshotCheckpoints :many
+-- The builds of the expired checkpoints are deleted and returned, so their files can be removed from the storage.
+-- The builds of the restored checkpoints are kept, as the snapshots of the restored sandbox are based on them,
+-- they are deleted together with the rest of the sandbox snapshot builds.
+WITH expired AS (
+    SELECT sc.build_id, sc.restored
+    FROM "public"."snapshot_checkpoints" sc
+    JOIN "public"."snapshots" s ON sc.snapshot_id = s.id
+    WHERE s.sandbox_id = @sandbox_id AND s.team_id = @team_id
+    ORDER BY sc.created_at DESC
+    OFFSET @retention
+), deleted_checkpoints AS (
+    DELETE FROM "public"."snapshot_checkpoints"
+    WHERE build_id IN (SELECT build_id FROM expired WHERE restored)
+)
+DELETE FROM "public"."env_builds" eb
+WHERE eb.id IN (SELECT build_id FROM expired WHERE NOT restored)
+RETURNING eb.id, eb.env_id, eb.cluster_node_id;
+
+-- name: MarkSnapshotCheckpointRestored :exec
+UPDATE "public"."snapshot_checkpoints"
+SET restored = TRUE
+WHERE build_id = @build_id;
+
+-- name: GetSnapshotCheckpoints :many
+SELECT sqlc.embed(sc)
+FROM "public"."snapshot_checkpoints" sc
+JOIN "public"."snapshots" s ON sc.snapshot_id = s.id
+JOIN "public"."env_builds" eb ON sc.build_id = eb.id
+WHERE s.sandbox_id = @sandbox_id AND s.team_id = @team_id AND eb.status = 'success'
+ORDER BY sc.created_at DESC;
+
+-- name: GetSnapshotCheckpoint :one
+SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, sqlc.embed(s), sqlc.embed(eb)
+FROM "public"."snapshot_checkpoints" sc
+JOIN "public"."snapshots" s ON sc.snapshot_id = s.id
+JOIN "public"."env_builds" eb ON sc.build_id = eb.id
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(alias ORDER BY alias) AS aliases
+    FROM "public"."env_aliases"
+    WHERE env_id = s.base_env_id
+) ea ON TRUE
+WHERE sc.build_id = @build_id AND s.sandbox_id = @sandbox_id AND s.team_id = @team_id AND eb.status = 'success';
@@ -9,3 +9,35 @@ type BuildReason struct {
 	// Step that failed
 	Step *string `json:"step,omitempty"`
 }
+
+type SandboxNetworkConfig struct {
+	// Egress policy of the sandbox, when set the sandbox can connect only to the allowed destinations
+	Egress *SandboxNetworkEgressConfig `json:"egress,omitempty"`
+	// Ports are the access rules of the sandbox ports, the ports without a rule are public
+	Ports []SandboxPortRule `json:"ports,omitempty"`
+	// TeamNetwork connects the sandbox to the private network of the team sandboxes
+	TeamNetwork *SandboxTeamNetworkConfig `json:"teamNetwork,omitempty"`
+}
+
+type SandboxTeamNetworkConfig struct {
+	Name  string `json:"name,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+type SandboxNetworkEgressConfig struct {
+	AllowedCIDRs   []string `json:"allowedCidrs,omitempty"`
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+	AllowedPorts   []uint32 `json:"allowedPorts,omitempty"`
+}
+
+type SandboxPortRule struct {
+	Port       uint32 `json:"port"`
+	Visibility string `json:"visibility"`
+}
+
+type SandboxCheckpointConfig struct {
+	// IntervalSeconds is the time between the scheduled checkpoints of the running sandbox
+	IntervalSeconds uint32 `json:"intervalSeconds"`
+	// Retention is the number of the latest checkpoints kept for the restore
+	Retention uint32 `json:"retention"`
+}
@@ -18,11 +18,20 @@ const (
 	AccessTokenAuthScopes = "AccessTokenAuth.Scopes"
 )
 
+// Defines values for ArchiveFormat.
+const (
+	TarGz ArchiveFormat = "tar.gz"
+	Zip   ArchiveFormat = "zip"
+)
+
 // Defines values for EntryInfoType.
 const (
 	File EntryInfoType = "file"
 )
 
+// ArchiveFormat Format of the archive
+type ArchiveFormat string
+
 // EntryInfo defines model for EntryInfo.
 type EntryInfo struct {
 	// Name Name of the file
@@ -74,6 +83,21 @@ type Metrics struct {
 	Ts *int64 `json:"ts,omitempty"`
 }
 
+// UploadSession defines model for UploadSession.
+type UploadSession struct {
+	// Id ID of the upload
+	Id string `json:"id"`
+
+	// Offset Number of bytes uploaded, the next chunk must start at this offset
+	Offset int64 `json:"offset"`
+
+	// Path Path the file is created at once the upload is completed
+	Path string `json:"path"`
+
+	// Size Declared size of the file in bytes
+	Size *int64 `json:"size,omitempty"`
+}
+
 // FilePath defines model for FilePath.
 type FilePath = string
 
@@ -83,6 +107,12 @@ type Signature = string
 // SignatureExpiration defines model for SignatureExpiration.
 type SignatureExpiration = int
 
+// UploadID defines model for UploadID.
+type UploadID = string
+
+// UploadOffset defines model for UploadOffset.
+type UploadOffset = int64
+
 // User defines model for User.
 type User = string
 
@@ -92,15 +122,30 @@ type FileNotFound = Error
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
+// InvalidChecksum defines model for InvalidChecksum.
+type InvalidChecksum = Error
+
 // InvalidPath defines model for InvalidPath.
 type InvalidPath = Error
 
+// InvalidUploadChunk defines model for InvalidUploadChunk.
+type InvalidUploadChunk = Error
+
 // InvalidUser defines model for InvalidUser.
 type InvalidUser = Error
 
 // NotEnoughDiskSpace defines model for NotEnoughDiskSpace.
 type NotEnoughDiskSpace = Error
 
+// UploadConflict defines model for UploadConflict.
+type UploadConflict = Error
+
+// UploadNotFound defines model for UploadNotFound.
+type UploadNotFound = Error
+
+// UploadSessionSuccess defines model for UploadSessionSuccess.
+type UploadSessionSuccess = UploadSession
+
 // UploadSuccess defines model for UploadSuccess.
 type UploadSuccess = []EntryInfo
 
@@ -139,6 +184,120 @@ type PostFilesParams struct {
 	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
 }
 
+// GetFilesArchiveParams defines parameters for GetFilesArchive.
+type GetFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+
+	// Format Format of the archive.
+	Format *ArchiveFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PostFilesArchiveParams defines parameters for PostFilesArchive.
+type PostFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+
+	// Format Format of the archive.
+	Format *ArchiveFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PostFilesUploadsJSONBody defines parameters for PostFilesUploads.
+type PostFilesUploadsJSONBody struct {
+	// Size Size of the file in bytes, if known
+	Size *int64 `json:"size,omitempty"`
+}
+
+// PostFilesUploadsParams defines parameters for PostFilesUploads.
+type PostFilesUploadsParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// DeleteFilesUploadsUploadIDParams defines parameters for DeleteFilesUploadsUploadID.
+type DeleteFilesUploadsUploadIDParams struct {
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// GetFilesUploadsUploadIDParams defines parameters for GetFilesUploadsUploadID.
+type GetFilesUploadsUploadIDParams struct {
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// PatchFilesUploadsUploadIDParams defines parameters for PatchFilesUploadsUploadID.
+type PatchFilesUploadsUploadIDParams struct {
+	// Offset Offset in bytes of the chunk in the file.
+	Offset UploadOffset `form:"offset" json:"offset"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// PostFilesUploadsUploadIDCompleteJSONBody defines parameters for PostFilesUploadsUploadIDComplete.
+type PostFilesUploadsUploadIDCompleteJSONBody struct {
+	// Sha256 Hex encoded SHA-256 checksum of the whole file
+	Sha256 string `json:"sha256"`
+}
+
+// PostFilesUploadsUploadIDCompleteParams defines parameters for PostFilesUploadsUploadIDComplete.
+type PostFilesUploadsUploadIDCompleteParams struct {
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
 // PostInitJSONBody defines parameters for PostInit.
 type PostInitJSONBody struct {
 	// AccessToken Access token for secure access to envd service
@@ -157,6 +316,12 @@ type PostInitJSONBody struct {
 // PostFilesMultipartRequestBody defines body for PostFiles for multipart/form-data ContentType.
 type PostFilesMultipartRequestBody PostFilesMultipartBody
 
+// PostFilesUploadsJSONRequestBody defines body for PostFilesUploads for application/json ContentType.
+type PostFilesUploadsJSONRequestBody PostFilesUploadsJSONBody
+
+// PostFilesUploadsUploadIDCompleteJSONRequestBody defines body for PostFilesUploadsUploadIDComplete for application/json ContentType.
+type PostFilesUploadsUploadIDCompleteJSONRequestBody PostFilesUploadsUploadIDCompleteJSONBody
+
 // PostInitJSONRequestBody defines body for PostInit for application/json ContentType.
 type PostInitJSONRequestBody PostInitJSONBody
 
@@ -171,6 +336,27 @@ type ServerInterface interface {
 	// Upload a file and ensure the parent directories exist. If the file exists, it will be overwritten.
 	// (POST /files)
 	PostFiles(w http.ResponseWriter, r *http.Request, params PostFilesParams)
+	// Download a directory as an archive, the paths in the archive are relative to the directory.
+	// (GET /files/archive)
+	GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams)
+	// Upload an archive and extract it to the directory, the directory and its parent directories are created if they don't exist. The existing files are overwritten.
+	// (POST /files/archive)
+	PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams)
+	// Start a resumable upload of a file. The content is uploaded in chunks and the file is created once the upload is completed.
+	// (POST /files/uploads)
+	PostFilesUploads(w http.ResponseWriter, r *http.Request, params PostFilesUploadsParams)
+	// Cancel the upload and remove the uploaded content.
+	// (DELETE /files/uploads/{uploadID})
+	DeleteFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params DeleteFilesUploadsUploadIDParams)
+	// Get the state of the upload, the offset is the position the upload should be resumed from.
+	// (GET /files/uploads/{uploadID})
+	GetFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params GetFilesUploadsUploadIDParams)
+	// Upload a chunk of the file, the offset must match the current offset of the upload.
+	// (PATCH /files/uploads/{uploadID})
+	PatchFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params PatchFilesUploadsUploadIDParams)
+	// Complete the upload, the checksum of the uploaded content is verified and the file is moved to its path. If the file exists, it will be overwritten.
+	// (POST /files/uploads/{uploadID}/complete)
+	PostFilesUploadsUploadIDComplete(w http.ResponseWriter, r *http.Request, uploadID UploadID, params PostFilesUploadsUploadIDCompleteParams)
 	// Check the health of the service
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
@@ -204,6 +390,48 @@ func (_ Unimplemented) PostFiles(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Download a directory as an archive, the paths in the archive are relative to the directory.
+// (GET /files/archive)
+func (_ Unimplemented) GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload an archive and extract it to the directory, the directory and its parent directories are created if they don't exist. The existing files are overwritten.
+// (POST /files/archive)
+func (_ Unimplemented) PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a resumable upload of a file. The content is uploaded in chunks and the file is created once the upload is completed.
+// (POST /files/uploads)
+func (_ Unimplemented) PostFilesUploads(w http.ResponseWriter, r *http.Request, params PostFilesUploadsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel the upload and remove the uploaded content.
+// (DELETE /files/uploads/{uploadID})
+func (_ Unimplemented) DeleteFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params DeleteFilesUploadsUploadIDParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the state of the upload, the offset is the position the upload should be resumed from.
+// (GET /files/uploads/{uploadID})
+func (_ Unimplemented) GetFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params GetFilesUploadsUploadIDParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a chunk of the file, the offset must match the current offset of the upload.
+// (PATCH /files/uploads/{uploadID})
+func (_ Unimplemented) PatchFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params PatchFilesUploadsUploadIDParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete the upload, the checksum of the uploaded content is verified and the file is moved to its path. If the file exists, it will be overwritten.
+// (POST /files/uploads/{uploadID}/complete)
+func (_ Unimplemented) PostFilesUploadsUploadIDComplete(w http.ResponseWriter, r *http.Request, uploadID UploadID, params PostFilesUploadsUploadIDCompleteParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Check the health of the service
 // (GET /health)
 func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
@@ -379,31 +607,69 @@ func (siw *ServerInterfaceWrapper) PostFiles(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// GetFilesArchive operation middleware
+func (siw *ServerInterfaceWrapper) GetFilesArchive(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFilesArchiveParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Required query parameter "username" -------------
 
-// PostInit operation middleware
-func (siw *ServerInterfaceWrapper) PostInit(w http.ResponseWriter, r *http.Request) {
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
 
-	ctx := r.Context()
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
 
-	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostInit(w, r)
+		siw.Handler.GetFilesArchive(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -413,8 +679,10 @@ func (siw *ServerInterfaceWrapper) PostInit(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// GetMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Request) {
+// PostFilesArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostFilesArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -422,8 +690,58 @@ func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Req
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFilesArchiveParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMetrics(w, r)
+		siw.Handler.PostFilesArchive(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -433,25 +751,418 @@ func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PostFilesUploads operation middleware
+func (siw *ServerInterfaceWrapper) PostFilesUploads(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
 
-func (e *UnmarshalingParamError) Error() string {
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFilesUploadsParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostFilesUploads(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFilesUploadsUploadID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFilesUploadsUploadID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", chi.URLParam(r, "uploadID"), &uploadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFilesUploadsUploadIDParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFilesUploadsUploadID(w, r, uploadID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFilesUploadsUploadID operation middleware
+func (siw *ServerInterfaceWrapper) GetFilesUploadsUploadID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", chi.URLParam(r, "uploadID"), &uploadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFilesUploadsUploadIDParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFilesUploadsUploadID(w, r, uploadID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchFilesUploadsUploadID operation middleware
+func (siw *ServerInterfaceWrapper) PatchFilesUploadsUploadID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", chi.URLParam(r, "uploadID"), &uploadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchFilesUploadsUploadIDParams
+
+	// ------------- Required query parameter "offset" -------------
+
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchFilesUploadsUploadID(w, r, uploadID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostFilesUploadsUploadIDComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostFilesUploadsUploadIDComplete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadID" -------------
+	var uploadID UploadID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadID", chi.URLParam(r, "uploadID"), &uploadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostFilesUploadsUploadIDCompleteParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signature_expiration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signature_expiration", r.URL.Query(), &params.SignatureExpiration)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature_expiration", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostFilesUploadsUploadIDComplete(w, r, uploadID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostInit operation middleware
+func (siw *ServerInterfaceWrapper) PostInit(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostInit(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AccessTokenAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMetrics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
 	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
@@ -555,6 +1266,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/files", wrapper.PostFiles)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/files/archive", wrapper.GetFilesArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/files/archive", wrapper.PostFilesArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/files/uploads", wrapper.PostFilesUploads)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/files/uploads/{uploadID}", wrapper.DeleteFilesUploadsUploadID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/files/uploads/{uploadID}", wrapper.GetFilesUploadsUploadID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/files/uploads/{uploadID}", wrapper.PatchFilesUploadsUploadID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/files/uploads/{uploadID}/complete", wrapper.PostFilesUploadsUploadIDComplete)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
 	})
@@ -0,0 +1,815 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"mime"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+)
+
+var (
+	errInvalidArchive  = errors.New("invalid archive")
+	errArchiveTooLarge = errors.New("not enough disk space for the extracted archive")
+)
+
+func archiveFormat(format *ArchiveFormat) ArchiveFormat {
+	if format == nil {
+		return TarGz
+	}
+
+	return *format
+}
+
+func (a *API) GetFilesArchive(w http.ResponseWriter, r *http.Request, params GetFilesArchiveParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	operationID := logs.AssignOperationID()
+
+	// signing authorization if needed
+	err := a.validateSigning(r, params.Signature, params.SignatureExpiration, params.Username, path, SigningReadOperation)
+	if err != nil {
+		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("error during auth validation")
+		jsonError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), operationID).
+			Str("path", path).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Archive read")
+	}()
+
+	u, err := user.Lookup(params.Username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", params.Username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	stat, err := os.Stat(resolvedPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			errMsg = fmt.Errorf("path '%s' does not exist", resolvedPath)
+			errorCode = http.StatusNotFound
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+
+		errMsg = fmt.Errorf("error checking if path exists '%s': %w", resolvedPath, err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if !stat.IsDir() {
+		errMsg = fmt.Errorf("path '%s' is not a directory", resolvedPath)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	name := filepath.Base(resolvedPath)
+	if name == string(filepath.Separator) {
+		name = "root"
+	}
+
+	var write func(w io.Writer, dir string) error
+	format := archiveFormat(params.Format)
+	switch format {
+	case TarGz:
+		w.Header().Set("Content-Type", "application/gzip")
+		write = writeTarGz
+	case Zip:
+		w.Header().Set("Content-Type", "application/zip")
+		write = writeZip
+	default:
+		errMsg = fmt.Errorf("unsupported archive format '%s'", format)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name + "." + string(format)}))
+	w.WriteHeader(http.StatusOK)
+
+	// The response is already started, so the failure can be only logged and the client gets a truncated archive
+	err = write(w, resolvedPath)
+	if err != nil {
+		errMsg = fmt.Errorf("error archiving directory '%s': %w", resolvedPath, err)
+		errorCode = http.StatusInternalServerError
+	}
+}
+
+func (a *API) PostFilesArchive(w http.ResponseWriter, r *http.Request, params PostFilesArchiveParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	operationID := logs.AssignOperationID()
+
+	// signing authorization if needed
+	err := a.validateSigning(r, params.Signature, params.SignatureExpiration, params.Username, path, SigningWriteOperation)
+	if err != nil {
+		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("error during auth validation")
+		jsonError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), operationID).
+			Str("path", path).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Archive write")
+	}()
+
+	u, err := user.Lookup(params.Username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", params.Username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	uid, gid, err := permissions.GetUserIds(u)
+	if err != nil {
+		errMsg = fmt.Errorf("error getting user ids: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = permissions.EnsureDirs(resolvedPath, int(uid), int(gid))
+	if err != nil {
+		errMsg = fmt.Errorf("error ensuring directories: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	freeSpace, err := freeDiskSpace(resolvedPath)
+	if err != nil {
+		errMsg = fmt.Errorf("error checking free disk space: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	// The extracted files take at least as much space as the compressed archive
+	if r.ContentLength > 0 && freeSpace < uint64(r.ContentLength) {
+		errMsg = fmt.Errorf("not enough disk space on '%s': %d bytes required, %d bytes free", resolvedPath, r.ContentLength, freeSpace)
+		errorCode = http.StatusInsufficientStorage
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	// The compressed size doesn't limit the size of the extracted files, so the extracted bytes are limited by the free space
+	extractor, err := newArchiveExtractor(resolvedPath, int(uid), int(gid), freeSpace)
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	var files []string
+	format := archiveFormat(params.Format)
+	switch format {
+	case TarGz:
+		files, err = extractor.extractTarGz(r.Body)
+	case Zip:
+		files, err = extractor.extractZipUpload(r.Body)
+	default:
+		err = fmt.Errorf("%w: unsupported archive format '%s'", errInvalidArchive, format)
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, errArchiveTooLarge):
+			errorCode = http.StatusInsufficientStorage
+		case errors.Is(err, errInvalidArchive):
+			errorCode = http.StatusBadRequest
+		default:
+			errorCode = http.StatusInternalServerError
+		}
+
+		errMsg = fmt.Errorf("error extracting archive to '%s': %w", resolvedPath, err)
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	paths := make(UploadSuccess, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, EntryInfo{
+			Path: file,
+			Name: filepath.Base(file),
+			Type: File,
+		})
+	}
+
+	data, err := json.Marshal(paths)
+	if err != nil {
+		errMsg = fmt.Errorf("error marshaling response: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// walkArchive calls the function for each entry of the directory that can be archived, the sockets and the devices are skipped.
+// The relative path uses the forward slashes and has the trailing slash for the directories.
+func walkArchive(dir string, fn func(name string, path string, info fs.FileInfo, link string) error) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == dir {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+		switch {
+		case info.Mode().IsRegular(), info.IsDir():
+		case info.Mode()&fs.ModeSymlink != 0:
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		name := filepath.ToSlash(rel)
+		if info.IsDir() {
+			name += "/"
+		}
+
+		return fn(name, path, info, link)
+	})
+}
+
+func writeTarGz(w io.Writer, dir string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := walkArchive(dir, func(name string, path string, info fs.FileInfo, link string) error {
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = name
+
+		err = tw.WriteHeader(hdr)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return copyFileTo(tw, path)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+func writeZip(w io.Writer, dir string) error {
+	zw := zip.NewWriter(w)
+
+	err := walkArchive(dir, func(name string, path string, info fs.FileInfo, link string) error {
+		hdr, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		hdr.Name = name
+		if info.Mode().IsRegular() {
+			hdr.Method = zip.Deflate
+		}
+
+		fw, err := zw.CreateHeader(hdr)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case info.Mode().IsRegular():
+			return copyFileTo(fw, path)
+		case link != "":
+			// The zip archives store the target of the symlink as its content
+			_, err = fw.Write([]byte(link))
+
+			return err
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func copyFileTo(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(w, file)
+
+	return err
+}
+
+// archiveExtractor extracts the archive entries to the directory, the created files are owned by the user.
+// The entries can't be written outside the directory, neither by the relative paths nor through the symlinks.
+// The total size of the extracted files is limited, so the highly compressed archive can't fill the disk.
+type archiveExtractor struct {
+	dir      string
+	realDir  string
+	uid, gid int
+
+	// limit is the maximum number of bytes of the extracted files, written is the number of bytes extracted so far
+	limit   uint64
+	written uint64
+}
+
+func newArchiveExtractor(dir string, uid, gid int, limit uint64) (*archiveExtractor, error) {
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving directory '%s': %w", dir, err)
+	}
+
+	return &archiveExtractor{dir: dir, realDir: realDir, uid: uid, gid: gid, limit: limit}, nil
+}
+
+// checkSpace fails when the size of the extracted files would exceed the limit.
+func (e *archiveExtractor) checkSpace(size uint64) error {
+	if size > e.limit-e.written {
+		return fmt.Errorf("%w: %d bytes required, %d bytes free on '%s'", errArchiveTooLarge, size, e.limit-e.written, e.dir)
+	}
+
+	return nil
+}
+
+// reserve accounts the size of the extracted file, it fails when the size would exceed the limit.
+func (e *archiveExtractor) reserve(size uint64) error {
+	err := e.checkSpace(size)
+	if err != nil {
+		return err
+	}
+
+	e.written += size
+
+	return nil
+}
+
+// remaining returns the number of bytes that can be still extracted, limited to fit the int64 readers.
+func (e *archiveExtractor) remaining() int64 {
+	return int64(min(e.limit-e.written, math.MaxInt64-1))
+}
+
+func (e *archiveExtractor) extractTarGz(r io.Reader) ([]string, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+	defer gz.Close()
+
+	var files []string
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidArchive, err)
+		}
+
+		target, err := e.target(hdr.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		mode := hdr.FileInfo().Mode()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = e.mkdir(target, mode)
+		case tar.TypeReg:
+			// The declared size is checked before the file is written, the written bytes are checked as well
+			err = e.checkSpace(uint64(max(hdr.Size, 0)))
+			if err != nil {
+				return nil, err
+			}
+
+			err = e.writeFile(target, mode, hdr.ModTime, tr)
+			files = append(files, target)
+		case tar.TypeSymlink:
+			err = e.symlink(target, hdr.Linkname)
+		case tar.TypeLink:
+			err = e.link(target, hdr.Linkname)
+		default:
+			// The devices and the pipes can't be created by the user
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// extractZipUpload saves the uploaded zip archive to a temporary file first, as the zip archives can't be read as a stream.
+// The saved archive takes the disk space as well, so it's accounted to the extracted bytes.
+func (e *archiveExtractor) extractZipUpload(r io.Reader) ([]string, error) {
+	tmpFile, err := os.CreateTemp("", "envd-archive-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	size, err := io.Copy(tmpFile, io.LimitReader(r, e.remaining()+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive: %w", err)
+	}
+
+	err = e.reserve(uint64(size))
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(tmpFile, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+
+	// Unlike the tar archives, the zip archives know the size of the extracted files upfront
+	var required uint64
+	for _, file := range zr.File {
+		required += file.UncompressedSize64
+	}
+
+	err = e.checkSpace(required)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.extractZip(zr)
+}
+
+func (e *archiveExtractor) extractZip(zr *zip.Reader) ([]string, error) {
+	var files []string
+	for _, file := range zr.File {
+		target, err := e.target(file.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		mode := file.Mode()
+		switch {
+		case mode.IsDir():
+			err = e.mkdir(target, mode)
+		case mode&fs.ModeSymlink != 0:
+			err = e.extractZipSymlink(target, file)
+		case mode.IsRegular():
+			err = e.extractZipFile(target, file)
+			files = append(files, target)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+func (e *archiveExtractor) extractZipFile(target string, file *zip.File) error {
+	rc, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+	defer rc.Close()
+
+	return e.writeFile(target, file.Mode(), file.Modified, rc)
+}
+
+func (e *archiveExtractor) extractZipSymlink(target string, file *zip.File) error {
+	rc, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+	defer rc.Close()
+
+	link, err := io.ReadAll(io.LimitReader(rc, 4096))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidArchive, err)
+	}
+
+	return e.symlink(target, string(link))
+}
+
+// target returns the path of the archive entry in the directory.
+func (e *archiveExtractor) target(name string) (string, error) {
+	target := filepath.Join(e.dir, filepath.FromSlash(name))
+
+	rel, err := filepath.Rel(e.dir, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: entry '%s' is outside of the directory", errInvalidArchive, name)
+	}
+
+	return target, nil
+}
+
+// prepare creates the parent directories of the entry and checks they don't lead outside the directory through a symlink.
+func (e *archiveExtractor) prepare(target string) error {
+	parent := filepath.Dir(target)
+
+	err := permissions.EnsureDirs(parent, e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error ensuring directories: %w", err)
+	}
+
+	return e.checkInside(parent)
+}
+
+func (e *archiveExtractor) checkInside(dir string) error {
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return fmt.Errorf("error resolving directory '%s': %w", dir, err)
+	}
+
+	rel, err := filepath.Rel(e.realDir, realDir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("%w: '%s' leads outside of the directory through a symlink", errInvalidArchive, dir)
+	}
+
+	return nil
+}
+
+// replace removes the existing entry at the path unless it's a directory.
+func (e *archiveExtractor) replace(target string) error {
+	stat, err := os.Lstat(target)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting file info: %w", err)
+	}
+
+	if stat.IsDir() {
+		return fmt.Errorf("%w: path '%s' is a directory", errInvalidArchive, target)
+	}
+
+	return os.Remove(target)
+}
+
+func (e *archiveExtractor) mkdir(target string, mode fs.FileMode) error {
+	if target == e.dir {
+		return nil
+	}
+
+	err := e.prepare(target)
+	if err != nil {
+		return err
+	}
+
+	stat, err := os.Lstat(target)
+	if err == nil {
+		if !stat.IsDir() {
+			return fmt.Errorf("%w: path '%s' is not a directory", errInvalidArchive, target)
+		}
+
+		return nil
+	}
+
+	err = os.Mkdir(target, mode.Perm())
+	if err != nil {
+		return fmt.Errorf("error creating directory: %w", err)
+	}
+
+	err = os.Chown(target, e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error changing directory ownership: %w", err)
+	}
+
+	// The umask could have removed some of the permissions
+	return os.Chmod(target, mode.Perm())
+}
+
+func (e *archiveExtractor) writeFile(target string, mode fs.FileMode, modTime time.Time, r io.Reader) error {
+	err := e.prepare(target)
+	if err != nil {
+		return err
+	}
+
+	// The existing symlink would be followed when writing the file
+	stat, err := os.Lstat(target)
+	if err == nil && stat.Mode()&fs.ModeSymlink != 0 {
+		err = os.Remove(target)
+		if err != nil {
+			return fmt.Errorf("error removing symlink: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|syscall.O_NOFOLLOW, mode.Perm())
+	if err != nil {
+		return fmt.Errorf("error creating file: %w", err)
+	}
+	defer file.Close()
+
+	err = file.Chown(e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error changing file ownership: %w", err)
+	}
+
+	err = file.Chmod(mode.Perm())
+	if err != nil {
+		return fmt.Errorf("error changing file permissions: %w", err)
+	}
+
+	// One byte more than the limit is read to detect the file exceeding it
+	n, err := file.ReadFrom(io.LimitReader(r, e.remaining()+1))
+	if err != nil {
+		return fmt.Errorf("error writing file: %w", err)
+	}
+
+	err = e.reserve(uint64(n))
+	if err != nil {
+		// The partially written file is removed, so it doesn't keep taking the disk space
+		_ = os.Remove(target)
+
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("error closing file: %w", err)
+	}
+
+	if !modTime.IsZero() {
+		err = os.Chtimes(target, modTime, modTime)
+		if err != nil {
+			return fmt.Errorf("error changing file times: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *archiveExtractor) symlink(target string, link string) error {
+	err := e.prepare(target)
+	if err != nil {
+		return err
+	}
+
+	err = e.replace(target)
+	if err != nil {
+		return err
+	}
+
+	err = os.Symlink(link, target)
+	if err != nil {
+		return fmt.Errorf("error creating symlink: %w", err)
+	}
+
+	err = os.Lchown(target, e.uid, e.gid)
+	if err != nil {
+		return fmt.Errorf("error changing symlink ownership: %w", err)
+	}
+
+	return nil
+}
+
+func (e *archiveExtractor) link(target string, name string) error {
+	source, err := e.target(name)
+	if err != nil {
+		return err
+	}
+
+	err = e.checkInside(filepath.Dir(source))
+	if err != nil {
+		return err
+	}
+
+	err = e.prepare(target)
+	if err != nil {
+		return err
+	}
+
+	err = e.replace(target)
+	if err != nil {
+		return err
+	}
+
+	err = os.Link(source, target)
+	if err != nil {
+		return fmt.Errorf("error creating hard link: %w", err)
+	}
+
+	return nil
+}
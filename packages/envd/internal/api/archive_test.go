@@ -0,0 +1,203 @@
+package api
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSourceDir(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "pkg"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "pkg", "main.go"), []byte("package main"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "run.sh"), []byte("#!/bin/sh"), 0o755))
+	require.NoError(t, os.Symlink("src/pkg/main.go", filepath.Join(dir, "main.go")))
+
+	return dir
+}
+
+func newTestExtractor(t *testing.T, dir string) *archiveExtractor {
+	t.Helper()
+
+	extractor, err := newArchiveExtractor(dir, os.Getuid(), os.Getgid(), math.MaxUint64)
+	require.NoError(t, err)
+
+	return extractor
+}
+
+func assertExtracted(t *testing.T, dir string, files []string) {
+	t.Helper()
+
+	assert.ElementsMatch(t, []string{
+		filepath.Join(dir, "README.md"),
+		filepath.Join(dir, "run.sh"),
+		filepath.Join(dir, "src", "pkg", "main.go"),
+	}, files)
+
+	content, err := os.ReadFile(filepath.Join(dir, "src", "pkg", "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main", string(content))
+
+	stat, err := os.Stat(filepath.Join(dir, "run.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), stat.Mode().Perm())
+
+	stat, err = os.Stat(filepath.Join(dir, "src", "pkg", "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), stat.Mode().Perm())
+
+	link, err := os.Readlink(filepath.Join(dir, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "src/pkg/main.go", link)
+}
+
+func TestArchiveTarGz(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeTarGz(&buf, createSourceDir(t)))
+
+	target := filepath.Join(t.TempDir(), "target")
+	require.NoError(t, os.Mkdir(target, 0o755))
+
+	files, err := newTestExtractor(t, target).extractTarGz(&buf)
+	require.NoError(t, err)
+
+	assertExtracted(t, target, files)
+}
+
+func TestArchiveZip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeZip(&buf, createSourceDir(t)))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	target := t.TempDir()
+	files, err := newTestExtractor(t, target).extractZip(zr)
+	require.NoError(t, err)
+
+	assertExtracted(t, target, files)
+}
+
+func tarGz(t *testing.T, headers ...*tar.Header) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, hdr := range headers {
+		require.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Typeflag == tar.TypeReg {
+			_, err := tw.Write(make([]byte, hdr.Size))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	return &buf
+}
+
+func TestArchiveExtract_OutsideDirectory(t *testing.T) {
+	outside := t.TempDir()
+
+	tests := []struct {
+		name    string
+		headers []*tar.Header
+	}{
+		{
+			name:    "relative path",
+			headers: []*tar.Header{{Name: "../escaped", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1}},
+		},
+		{
+			name: "symlink to the outside directory",
+			headers: []*tar.Header{
+				{Name: "link", Typeflag: tar.TypeSymlink, Linkname: outside},
+				{Name: "link/escaped", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1},
+			},
+		},
+		{
+			name:    "hard link to the outside file",
+			headers: []*tar.Header{{Name: "link", Typeflag: tar.TypeLink, Linkname: "../../etc/passwd"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := filepath.Join(t.TempDir(), "target")
+			require.NoError(t, os.Mkdir(target, 0o755))
+
+			_, err := newTestExtractor(t, target).extractTarGz(tarGz(t, tt.headers...))
+			require.ErrorIs(t, err, errInvalidArchive)
+
+			assert.NoFileExists(t, filepath.Join(outside, "escaped"))
+			assert.NoFileExists(t, filepath.Join(filepath.Dir(target), "escaped"))
+		})
+	}
+}
+
+func TestArchiveExtract_SizeLimit(t *testing.T) {
+	// The zeros compress to a tiny fraction of their size, like a gzip bomb
+	bomb := tarGz(t,
+		&tar.Header{Name: "small", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1 << 10},
+		&tar.Header{Name: "bomb", Typeflag: tar.TypeReg, Mode: 0o644, Size: 8 << 20},
+	)
+	require.Less(t, bomb.Len(), 1<<20)
+
+	target := t.TempDir()
+	extractor, err := newArchiveExtractor(target, os.Getuid(), os.Getgid(), 1<<20)
+	require.NoError(t, err)
+
+	_, err = extractor.extractTarGz(bomb)
+	require.ErrorIs(t, err, errArchiveTooLarge)
+
+	_, err = os.Lstat(filepath.Join(target, "bomb"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestArchiveExtract_WrittenSizeLimit(t *testing.T) {
+	target := t.TempDir()
+	extractor, err := newArchiveExtractor(target, os.Getuid(), os.Getgid(), 100)
+	require.NoError(t, err)
+
+	// The reader could produce more bytes than declared, the written bytes are limited as well
+	err = extractor.writeFile(filepath.Join(target, "first"), 0o644, time.Time{}, bytes.NewReader(make([]byte, 60)))
+	require.NoError(t, err)
+
+	err = extractor.writeFile(filepath.Join(target, "second"), 0o644, time.Time{}, bytes.NewReader(make([]byte, 60)))
+	require.ErrorIs(t, err, errArchiveTooLarge)
+
+	_, err = os.Lstat(filepath.Join(target, "second"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestArchiveExtract_ZipSizeLimit(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, err := zw.CreateHeader(&zip.FileHeader{Name: "bomb", Method: zip.Deflate})
+	require.NoError(t, err)
+	_, err = fw.Write(make([]byte, 8<<20))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	target := t.TempDir()
+	extractor, err := newArchiveExtractor(target, os.Getuid(), os.Getgid(), 1<<20)
+	require.NoError(t, err)
+
+	_, err = extractor.extractZipUpload(&buf)
+	require.ErrorIs(t, err, errArchiveTooLarge)
+
+	_, err = os.Lstat(filepath.Join(target, "bomb"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
@@ -23,6 +24,14 @@ var allowedPaths = []string{
 	"GET/health",
 	"GET/files",
 	"POST/files",
+	"GET/files/archive",
+	"POST/files/archive",
+	"POST/files/uploads",
+}
+
+// path prefixes that are always allowed without general authentication, the handlers validate the signing themselves
+var allowedPathPrefixes = []string{
+	"/files/uploads/",
 }
 
 func (a *API) WithAuthorization(handler http.Handler) http.Handler {
@@ -31,7 +40,10 @@ func (a *API) WithAuthorization(handler http.Handler) http.Handler {
 			authHeader := req.Header.Get(accessTokenHeader)
 
 			// check if this path is allowed without authentication (e.g., health check, endpoints supporting signing)
-			allowedPath := slices.Contains(allowedPaths, req.Method+req.URL.Path)
+			allowedPath := slices.Contains(allowedPaths, req.Method+req.URL.Path) ||
+				slices.ContainsFunc(allowedPathPrefixes, func(prefix string) bool {
+					return strings.HasPrefix(req.URL.Path, prefix)
+				})
 
 			if authHeader != *a.accessToken && !allowedPath {
 				a.logger.Error().Msg("Trying to access secured envd without correct access token")
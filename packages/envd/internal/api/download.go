@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"os"
 	"os/user"
-	"time"
 
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
 	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
@@ -101,5 +100,14 @@ func (a *API) GetFiles(w http.ResponseWriter, r *http.Request, params GetFilesPa
 	}
 	defer file.Close()
 
-	http.ServeContent(w, r, path, time.Now(), file)
+	// The ETag and Last-Modified headers let the clients resume the download with the Range and If-Range headers,
+	// the range is served only if the file wasn't changed in the meantime.
+	w.Header().Set("ETag", fileETag(stat))
+
+	http.ServeContent(w, r, path, stat.ModTime(), file)
+}
+
+// fileETag identifies the version of the file by its modification time and size.
+func fileETag(stat os.FileInfo) string {
+	return fmt.Sprintf(`"%x-%x"`, stat.ModTime().UnixNano(), stat.Size())
 }
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFiles_Range(t *testing.T) {
+	logger := zerolog.Nop()
+	api := &API{logger: &logger}
+
+	path := filepath.Join(t.TempDir(), "data.txt")
+	require.NoError(t, os.WriteFile(path, []byte("0123456789"), 0o644))
+
+	params := GetFilesParams{Path: &path, Username: "root"}
+
+	get := func(headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/files", nil)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+
+		w := httptest.NewRecorder()
+		api.GetFiles(w, req, params)
+
+		return w
+	}
+
+	full := get(nil)
+	require.Equal(t, http.StatusOK, full.Code)
+	assert.Equal(t, "bytes", full.Header().Get("Accept-Ranges"))
+	assert.NotEmpty(t, full.Header().Get("Last-Modified"))
+
+	etag := full.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	partial := get(map[string]string{"Range": "bytes=4-", "If-Range": etag})
+	require.Equal(t, http.StatusPartialContent, partial.Code)
+	assert.Equal(t, "456789", partial.Body.String())
+	assert.Equal(t, "bytes 4-9/10", partial.Header().Get("Content-Range"))
+
+	notModified := get(map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, notModified.Code)
+
+	require.NoError(t, os.WriteFile(path, []byte("changed content"), 0o644))
+
+	// The whole file is returned when it changed since the first part was downloaded.
+	changed := get(map[string]string{"Range": "bytes=4-", "If-Range": etag})
+	require.Equal(t, http.StatusOK, changed.Code)
+	assert.Equal(t, "changed content", changed.Body.String())
+}
@@ -18,13 +18,21 @@ type API struct {
 	envVars       *utils.Map[string, string]
 	mmdsChan      chan *host.MMDSOpts
 	hyperloopLock sync.Mutex
+	uploads       *uploadSessions
 
 	lastSetTime *utils.AtomicMax
 	initLock    sync.Mutex
 }
 
 func New(l *zerolog.Logger, envVars *utils.Map[string, string], mmdsChan chan *host.MMDSOpts, isNotFC bool) *API {
-	return &API{logger: l, envVars: envVars, mmdsChan: mmdsChan, isNotFC: isNotFC, lastSetTime: utils.NewAtomicMax()}
+	return &API{
+		logger:      l,
+		envVars:     envVars,
+		mmdsChan:    mmdsChan,
+		isNotFC:     isNotFC,
+		uploads:     newUploadSessions(),
+		lastSetTime: utils.NewAtomicMax(),
+	}
 }
 
 func (a *API) GetHealth(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,651 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/envd/internal/utils"
+)
+
+// uploadSessionTTL is the time after the last request when the unfinished upload is removed.
+const uploadSessionTTL = 24 * time.Hour
+
+var (
+	errUploadNotFound   = errors.New("upload not found")
+	errUploadInProgress = errors.New("another request for the upload is in progress")
+	errOffsetMismatch   = errors.New("offset doesn't match the current offset of the upload")
+	errUploadTooLarge   = errors.New("chunk exceeds the declared size of the file")
+	errUploadIncomplete = errors.New("upload isn't finished")
+	errChecksumMismatch = errors.New("checksum doesn't match the uploaded content")
+)
+
+// uploadSession is a resumable upload of a single file.
+// The content is written to a hidden file next to the destination, so the completed upload is moved to its path atomically.
+type uploadSession struct {
+	id string
+	// path is the path as requested, the signatures of the upload requests are validated against it.
+	path         string
+	username     string
+	resolvedPath string
+	tmpPath      string
+	size         *int64
+
+	mu           sync.Mutex
+	offset       int64
+	closed       bool
+	lastActivity time.Time
+}
+
+func (u *uploadSession) state() UploadSession {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return UploadSession{
+		Id:     u.id,
+		Path:   u.resolvedPath,
+		Offset: u.offset,
+		Size:   u.size,
+	}
+}
+
+// write appends the chunk to the upload, the offset must match the number of bytes already uploaded.
+// When the request is interrupted, the bytes written so far are kept and the client resumes from the new offset.
+func (u *uploadSession) write(offset int64, r io.Reader) error {
+	if !u.mu.TryLock() {
+		return errUploadInProgress
+	}
+	defer u.mu.Unlock()
+
+	if u.closed {
+		return errUploadNotFound
+	}
+
+	u.lastActivity = time.Now()
+
+	if offset != u.offset {
+		return fmt.Errorf("%w: expected offset %d, got %d", errOffsetMismatch, u.offset, offset)
+	}
+
+	file, err := os.OpenFile(u.tmpPath, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("error opening upload file: %w", err)
+	}
+	defer file.Close()
+
+	if u.size != nil {
+		// Read one byte over the declared size to detect the chunks exceeding it.
+		r = io.LimitReader(r, *u.size-u.offset+1)
+	}
+
+	n, err := io.Copy(io.NewOffsetWriter(file, offset), r)
+	u.offset += n
+
+	if u.size != nil && u.offset > *u.size {
+		u.offset = *u.size
+
+		truncateErr := file.Truncate(u.offset)
+		if truncateErr != nil {
+			return fmt.Errorf("error truncating upload file: %w", truncateErr)
+		}
+
+		return fmt.Errorf("%w: declared size is %d bytes", errUploadTooLarge, *u.size)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error writing chunk: %w", err)
+	}
+
+	return nil
+}
+
+// complete verifies the checksum of the uploaded content and moves the file to its path.
+// The upload is removed when the checksum doesn't match, as the content can't be fixed by uploading more chunks.
+func (u *uploadSession) complete(checksum string) error {
+	if !u.mu.TryLock() {
+		return errUploadInProgress
+	}
+	defer u.mu.Unlock()
+
+	if u.closed {
+		return errUploadNotFound
+	}
+
+	u.lastActivity = time.Now()
+
+	if u.size != nil && u.offset != *u.size {
+		return fmt.Errorf("%w: %d of %d bytes uploaded", errUploadIncomplete, u.offset, *u.size)
+	}
+
+	file, err := os.Open(u.tmpPath)
+	if err != nil {
+		return fmt.Errorf("error opening upload file: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return fmt.Errorf("error computing checksum: %w", err)
+	}
+
+	actual := hex.EncodeToString(hash.Sum(nil))
+	if !strings.EqualFold(actual, checksum) {
+		u.closed = true
+		os.Remove(u.tmpPath)
+
+		return fmt.Errorf("%w: expected %s, got %s", errChecksumMismatch, checksum, actual)
+	}
+
+	err = os.Rename(u.tmpPath, u.resolvedPath)
+	if err != nil {
+		return fmt.Errorf("error moving upload file to '%s': %w", u.resolvedPath, err)
+	}
+
+	u.closed = true
+
+	return nil
+}
+
+// cancel removes the uploaded content.
+func (u *uploadSession) cancel() error {
+	if !u.mu.TryLock() {
+		return errUploadInProgress
+	}
+	defer u.mu.Unlock()
+
+	if u.closed {
+		return errUploadNotFound
+	}
+
+	u.closed = true
+
+	err := os.Remove(u.tmpPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing upload file: %w", err)
+	}
+
+	return nil
+}
+
+type uploadSessions struct {
+	sessions *utils.Map[string, *uploadSession]
+}
+
+func newUploadSessions() *uploadSessions {
+	return &uploadSessions{sessions: utils.NewMap[string, *uploadSession]()}
+}
+
+// create starts the upload of the file, the parent directory of the file must exist.
+func (s *uploadSessions) create(path, username, resolvedPath string, size *int64, uid, gid int) (*uploadSession, error) {
+	s.removeExpired(time.Now())
+
+	id := uuid.NewString()
+	tmpPath := filepath.Join(filepath.Dir(resolvedPath), fmt.Sprintf(".%s.upload-%s", filepath.Base(resolvedPath), id))
+
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o666)
+	if err != nil {
+		return nil, fmt.Errorf("error creating upload file: %w", err)
+	}
+	file.Close()
+
+	err = os.Chown(tmpPath, uid, gid)
+	if err != nil {
+		os.Remove(tmpPath)
+
+		return nil, fmt.Errorf("error changing upload file ownership: %w", err)
+	}
+
+	session := &uploadSession{
+		id:           id,
+		path:         path,
+		username:     username,
+		resolvedPath: resolvedPath,
+		tmpPath:      tmpPath,
+		size:         size,
+		lastActivity: time.Now(),
+	}
+	s.sessions.Store(id, session)
+
+	return session, nil
+}
+
+func (s *uploadSessions) get(id string) (*uploadSession, bool) {
+	return s.sessions.Load(id)
+}
+
+func (s *uploadSessions) delete(id string) {
+	s.sessions.Delete(id)
+}
+
+// removeExpired cancels the uploads that weren't used for longer than the TTL.
+func (s *uploadSessions) removeExpired(now time.Time) {
+	s.sessions.Range(func(id string, session *uploadSession) bool {
+		if !session.mu.TryLock() {
+			return true
+		}
+		expired := now.Sub(session.lastActivity) > uploadSessionTTL
+		session.mu.Unlock()
+
+		if expired && session.cancel() == nil {
+			s.sessions.Delete(id)
+		}
+
+		return true
+	})
+}
+
+func uploadErrorCode(err error) int {
+	switch {
+	case errors.Is(err, errUploadNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, errUploadInProgress), errors.Is(err, errOffsetMismatch), errors.Is(err, errUploadIncomplete):
+		return http.StatusConflict
+	case errors.Is(err, errUploadTooLarge), errors.Is(err, errChecksumMismatch):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeUploadSession(w http.ResponseWriter, code int, session *uploadSession) error {
+	data, err := json.Marshal(session.state())
+	if err != nil {
+		return fmt.Errorf("error marshaling response: %w", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+
+	return nil
+}
+
+// lookupUpload finds the upload and checks the request is authorized for it.
+// The signatures of the upload requests are generated for the path the upload was started with.
+func (a *API) lookupUpload(r *http.Request, uploadID, username string, signature *string, signatureExpiration *int) (*uploadSession, int, error) {
+	session, ok := a.uploads.get(uploadID)
+	if !ok {
+		return nil, http.StatusNotFound, fmt.Errorf("%w: '%s'", errUploadNotFound, uploadID)
+	}
+
+	err := a.validateSigning(r, signature, signatureExpiration, username, session.path, SigningWriteOperation)
+	if err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+
+	if username != session.username {
+		return nil, http.StatusUnauthorized, fmt.Errorf("upload '%s' was started by a different user", uploadID)
+	}
+
+	return session, 0, nil
+}
+
+func (a *API) PostFilesUploads(w http.ResponseWriter, r *http.Request, params PostFilesUploadsParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+	var errMsg error
+
+	var path string
+	if params.Path != nil {
+		path = *params.Path
+	}
+
+	operationID := logs.AssignOperationID()
+
+	// signing authorization if needed
+	err := a.validateSigning(r, params.Signature, params.SignatureExpiration, params.Username, path, SigningWriteOperation)
+	if err != nil {
+		a.logger.Error().Err(err).Str(string(logs.OperationIDKey), operationID).Msg("error during auth validation")
+		jsonError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), operationID).
+			Str("path", path).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Upload start")
+	}()
+
+	var body PostFilesUploadsJSONBody
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil && !errors.Is(err, io.EOF) {
+		errMsg = fmt.Errorf("error decoding request body: %w", err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if body.Size != nil && *body.Size < 0 {
+		errMsg = fmt.Errorf("invalid size %d", *body.Size)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	u, err := user.Lookup(params.Username)
+	if err != nil {
+		errMsg = fmt.Errorf("error looking up user '%s': %w", params.Username, err)
+		errorCode = http.StatusUnauthorized
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	resolvedPath, err := permissions.ExpandAndResolve(path, u)
+	if err != nil {
+		errMsg = fmt.Errorf("error expanding and resolving path '%s': %w", path, err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	uid, gid, err := permissions.GetUserIds(u)
+	if err != nil {
+		errMsg = fmt.Errorf("error getting user ids: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = permissions.EnsureDirs(filepath.Dir(resolvedPath), int(uid), int(gid))
+	if err != nil {
+		errMsg = fmt.Errorf("error ensuring directories: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	stat, err := os.Stat(resolvedPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		errMsg = fmt.Errorf("error getting file info: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if err == nil && stat.IsDir() {
+		errMsg = fmt.Errorf("path '%s' is a directory", resolvedPath)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	if body.Size != nil {
+		freeSpace, err := freeDiskSpace(filepath.Dir(resolvedPath))
+		if err != nil {
+			errMsg = fmt.Errorf("error checking free disk space: %w", err)
+			errorCode = http.StatusInternalServerError
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+
+		if freeSpace < uint64(*body.Size) {
+			errMsg = fmt.Errorf("not enough disk space on '%s': %d bytes required, %d bytes free", filepath.Dir(resolvedPath), *body.Size, freeSpace)
+			errorCode = http.StatusInsufficientStorage
+			jsonError(w, errorCode, errMsg)
+
+			return
+		}
+	}
+
+	session, err := a.uploads.create(path, params.Username, resolvedPath, body.Size, int(uid), int(gid))
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = writeUploadSession(w, http.StatusCreated, session)
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+}
+
+func (a *API) GetFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params GetFilesUploadsUploadIDParams) {
+	defer r.Body.Close()
+
+	session, code, err := a.lookupUpload(r, uploadID, params.Username, params.Signature, params.SignatureExpiration)
+	if err != nil {
+		a.logger.Error().Err(err).Str("upload_id", uploadID).Msg("error looking up upload")
+		jsonError(w, code, err)
+
+		return
+	}
+
+	err = writeUploadSession(w, http.StatusOK, session)
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+}
+
+func (a *API) PatchFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params PatchFilesUploadsUploadIDParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+	var errMsg error
+
+	operationID := logs.AssignOperationID()
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), operationID).
+			Str("upload_id", uploadID).
+			Int64("offset", params.Offset).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Upload chunk write")
+	}()
+
+	session, code, err := a.lookupUpload(r, uploadID, params.Username, params.Signature, params.SignatureExpiration)
+	if err != nil {
+		errMsg = err
+		errorCode = code
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	freeSpace, err := freeDiskSpace(filepath.Dir(session.tmpPath))
+	if err != nil {
+		errMsg = fmt.Errorf("error checking free disk space: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	// The size can be unknown resulting in ContentLength being -1, the condition just evaluates false for it.
+	if r.ContentLength > 0 && freeSpace < uint64(r.ContentLength) {
+		errMsg = fmt.Errorf("not enough disk space on '%s': %d bytes required, %d bytes free", filepath.Dir(session.tmpPath), r.ContentLength, freeSpace)
+		errorCode = http.StatusInsufficientStorage
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = session.write(params.Offset, r.Body)
+	if err != nil {
+		errMsg = err
+		errorCode = uploadErrorCode(err)
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = writeUploadSession(w, http.StatusOK, session)
+	if err != nil {
+		errMsg = err
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+}
+
+func (a *API) PostFilesUploadsUploadIDComplete(w http.ResponseWriter, r *http.Request, uploadID UploadID, params PostFilesUploadsUploadIDCompleteParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+	var errMsg error
+
+	operationID := logs.AssignOperationID()
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), operationID).
+			Str("upload_id", uploadID).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Upload complete")
+	}()
+
+	session, code, err := a.lookupUpload(r, uploadID, params.Username, params.Signature, params.SignatureExpiration)
+	if err != nil {
+		errMsg = err
+		errorCode = code
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	var body PostFilesUploadsUploadIDCompleteJSONBody
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		errMsg = fmt.Errorf("error decoding request body: %w", err)
+		errorCode = http.StatusBadRequest
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = session.complete(body.Sha256)
+	if errors.Is(err, errChecksumMismatch) {
+		a.uploads.delete(uploadID)
+	}
+	if err != nil {
+		errMsg = err
+		errorCode = uploadErrorCode(err)
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	a.uploads.delete(uploadID)
+
+	data, err := json.Marshal(UploadSuccess{{
+		Path: session.resolvedPath,
+		Name: filepath.Base(session.resolvedPath),
+		Type: File,
+	}})
+	if err != nil {
+		errMsg = fmt.Errorf("error marshaling response: %w", err)
+		errorCode = http.StatusInternalServerError
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func (a *API) DeleteFilesUploadsUploadID(w http.ResponseWriter, r *http.Request, uploadID UploadID, params DeleteFilesUploadsUploadIDParams) {
+	defer r.Body.Close()
+
+	var errorCode int
+	var errMsg error
+
+	operationID := logs.AssignOperationID()
+
+	defer func() {
+		l := a.logger.
+			Err(errMsg).
+			Str("method", r.Method+" "+r.URL.Path).
+			Str(string(logs.OperationIDKey), operationID).
+			Str("upload_id", uploadID).
+			Str("username", params.Username)
+
+		if errMsg != nil {
+			l = l.Int("error_code", errorCode)
+		}
+
+		l.Msg("Upload cancel")
+	}()
+
+	session, code, err := a.lookupUpload(r, uploadID, params.Username, params.Signature, params.SignatureExpiration)
+	if err != nil {
+		errMsg = err
+		errorCode = code
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	err = session.cancel()
+	if !errors.Is(err, errUploadInProgress) {
+		a.uploads.delete(uploadID)
+	}
+	if err != nil {
+		errMsg = err
+		errorCode = uploadErrorCode(err)
+		jsonError(w, errorCode, errMsg)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUpload(t *testing.T, size *int64) (*uploadSessions, *uploadSession) {
+	t.Helper()
+
+	sessions := newUploadSessions()
+	resolvedPath := filepath.Join(t.TempDir(), "weights.bin")
+
+	session, err := sessions.create(resolvedPath, "root", resolvedPath, size, os.Getuid(), os.Getgid())
+	require.NoError(t, err)
+
+	return sessions, session
+}
+
+func checksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// interruptedReader returns the content and then fails, as the body of a dropped request.
+type interruptedReader struct {
+	r io.Reader
+}
+
+func (r *interruptedReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		return n, io.ErrUnexpectedEOF
+	}
+
+	return n, err
+}
+
+func TestUploadSession_Resume(t *testing.T) {
+	_, session := newTestUpload(t, nil)
+
+	require.NoError(t, session.write(0, strings.NewReader("hello ")))
+
+	err := session.write(0, strings.NewReader("again"))
+	require.ErrorIs(t, err, errOffsetMismatch)
+
+	err = session.write(6, &interruptedReader{r: strings.NewReader("wor")})
+	require.Error(t, err)
+	assert.Equal(t, int64(9), session.state().Offset)
+
+	require.NoError(t, session.write(9, strings.NewReader("ld")))
+	require.NoError(t, session.complete(checksum("hello world")))
+
+	content, err := os.ReadFile(session.resolvedPath)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+	assert.NoFileExists(t, session.tmpPath)
+
+	err = session.write(11, strings.NewReader("!"))
+	require.ErrorIs(t, err, errUploadNotFound)
+}
+
+func TestUploadSession_DeclaredSize(t *testing.T) {
+	size := int64(5)
+	_, session := newTestUpload(t, &size)
+
+	require.NoError(t, session.write(0, strings.NewReader("abc")))
+
+	err := session.complete(checksum("abc"))
+	require.ErrorIs(t, err, errUploadIncomplete)
+
+	err = session.write(3, strings.NewReader("defgh"))
+	require.ErrorIs(t, err, errUploadTooLarge)
+	assert.Equal(t, int64(5), session.state().Offset)
+
+	require.NoError(t, session.complete(checksum("abcde")))
+
+	content, err := os.ReadFile(session.resolvedPath)
+	require.NoError(t, err)
+	assert.Equal(t, "abcde", string(content))
+}
+
+func TestUploadSession_ChecksumMismatch(t *testing.T) {
+	_, session := newTestUpload(t, nil)
+
+	require.NoError(t, session.write(0, strings.NewReader("content")))
+
+	err := session.complete(checksum("other content"))
+	require.ErrorIs(t, err, errChecksumMismatch)
+
+	assert.NoFileExists(t, session.tmpPath)
+	assert.NoFileExists(t, session.resolvedPath)
+}
+
+func TestUploadSessions_RemoveExpired(t *testing.T) {
+	sessions, session := newTestUpload(t, nil)
+
+	sessions.removeExpired(time.Now())
+	_, ok := sessions.get(session.id)
+	assert.True(t, ok)
+
+	sessions.removeExpired(time.Now().Add(uploadSessionTTL + time.Minute))
+	_, ok = sessions.get(session.id)
+	assert.False(t, ok)
+	assert.NoFileExists(t, session.tmpPath)
+}
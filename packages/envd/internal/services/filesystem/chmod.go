@@ -0,0 +1,285 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"syscall"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+const (
+	maxMode = 0o7777
+	// maxUserMode is the maximum mode set by the users other than root, they can't set the setuid, setgid and sticky bits.
+	maxUserMode = 0o777
+)
+
+func (Service) Chmod(ctx context.Context, req *connect.Request[rpc.ChmodRequest]) (*connect.Response[rpc.ChmodResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	uid, _, err := permissions.GetUserIds(u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	mode := req.Msg.GetMode()
+	if mode > maxMode {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid mode %o", mode))
+	}
+
+	if uid != 0 && mode > maxUserMode {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only root can set the mode %o", mode))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, pathError("error getting file info", err)
+	}
+
+	err = checkOwner(path, info, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = unix.Chmod(path, mode)
+	if err != nil {
+		return nil, pathError("error changing mode", err)
+	}
+
+	if req.Msg.GetRecursive() {
+		err = walkEntries(path, func(entryPath string, entry fs.DirEntry) error {
+			// The mode of the symlinks can't be changed, chmod would change the target.
+			if entry.Type()&fs.ModeSymlink != 0 {
+				return nil
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+
+			err = checkOwner(entryPath, info, uid)
+			if err != nil {
+				return err
+			}
+
+			return unix.Chmod(entryPath, mode)
+		})
+		if err != nil {
+			return nil, pathError("error changing mode", err)
+		}
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rpc.ChmodResponse{
+		Entry: entry,
+	}), nil
+}
+
+func (Service) Chown(ctx context.Context, req *connect.Request[rpc.ChownRequest]) (*connect.Response[rpc.ChownResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if req.Msg.GetOwner() == "" && req.Msg.GetGroup() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("owner or group must be set"))
+	}
+
+	uid, err := lookupOwner(req.Msg.GetOwner())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	gid, err := lookupGroup(req.Msg.GetGroup())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userUID, _, err := permissions.GetUserIds(u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = checkOwnership(u, userUID, uid, gid)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, pathError("error getting file info", err)
+	}
+
+	err = checkOwner(path, info, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chown(path, uid, gid)
+	if err != nil {
+		return nil, pathError("error changing ownership", err)
+	}
+
+	if req.Msg.GetRecursive() {
+		err = walkEntries(path, func(entryPath string, entry fs.DirEntry) error {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+
+			err = checkOwner(entryPath, info, userUID)
+			if err != nil {
+				return err
+			}
+
+			return os.Lchown(entryPath, uid, gid)
+		})
+		if err != nil {
+			return nil, pathError("error changing ownership", err)
+		}
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rpc.ChownResponse{
+		Entry: entry,
+	}), nil
+}
+
+// checkOwner checks if the user can change the file, only the owner of the file or root can change it.
+func checkOwner(path string, info fs.FileInfo, uid uint32) error {
+	if uid == 0 {
+		return nil
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || stat.Uid != uid {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("user %d is not the owner of %s", uid, path))
+	}
+
+	return nil
+}
+
+// checkOwnership checks if the user can give the files to the owner and group, -1 keeps them unchanged.
+// The users other than root can only set themselves as the owner and their own groups.
+func checkOwnership(u *user.User, userUID uint32, uid, gid int) error {
+	if userUID == 0 {
+		return nil
+	}
+
+	if uid != -1 && uid != int(userUID) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only root can change the owner to %d", uid))
+	}
+
+	if gid == -1 {
+		return nil
+	}
+
+	groups, err := u.GroupIds()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error looking up groups of user '%s': %w", u.Username, err))
+	}
+
+	if !slices.Contains(groups, strconv.Itoa(gid)) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("user '%s' is not a member of group %d", u.Username, gid))
+	}
+
+	return nil
+}
+
+// lookupOwner returns the uid of the user name or numeric ID, -1 keeps the owner unchanged.
+func lookupOwner(owner string) (int, error) {
+	if owner == "" {
+		return -1, nil
+	}
+
+	if uid, err := strconv.Atoi(owner); err == nil && uid >= 0 {
+		return uid, nil
+	}
+
+	u, err := user.Lookup(owner)
+	if err != nil {
+		return 0, fmt.Errorf("error looking up user '%s': %w", owner, err)
+	}
+
+	return strconv.Atoi(u.Uid)
+}
+
+// lookupGroup returns the gid of the group name or numeric ID, -1 keeps the group unchanged.
+func lookupGroup(group string) (int, error) {
+	if group == "" {
+		return -1, nil
+	}
+
+	if gid, err := strconv.Atoi(group); err == nil && gid >= 0 {
+		return gid, nil
+	}
+
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, fmt.Errorf("error looking up group '%s': %w", group, err)
+	}
+
+	return strconv.Atoi(g.Gid)
+}
+
+// walkEntries calls fn for all entries in the directory tree (doesn't follow symlinks), except the directory itself.
+// It does nothing if the path isn't a directory.
+func walkEntries(dirPath string, fn func(path string, entry fs.DirEntry) error) error {
+	return filepath.WalkDir(dirPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == dirPath {
+			return nil
+		}
+
+		return fn(path, entry)
+	})
+}
+
+func pathError(msg string, err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	if errors.Is(err, os.ErrNotExist) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("file not found: %w", err))
+	}
+
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("%s: %w", msg, err))
+}
@@ -0,0 +1,188 @@
+package filesystem
+
+import (
+	"os"
+	"os/user"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func TestChmod_Recursive(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	dir := filepath.Join(root, "dir")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "nested"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "file.txt"), []byte("content"), 0o644))
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), u)
+
+	resp, err := svc.Chmod(ctx, connect.NewRequest(&filesystem.ChmodRequest{
+		Path:      dir,
+		Mode:      0o700,
+		Recursive: true,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0o700), resp.Msg.GetEntry().GetMode())
+
+	info, err := os.Stat(filepath.Join(dir, "nested", "file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+	_, err = svc.Chmod(ctx, connect.NewRequest(&filesystem.ChmodRequest{
+		Path: dir,
+		Mode: 0o17777,
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = svc.Chmod(ctx, connect.NewRequest(&filesystem.ChmodRequest{
+		Path: filepath.Join(root, "missing"),
+		Mode: 0o700,
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestChown(t *testing.T) {
+	t.Parallel()
+
+	if os.Geteuid() != 0 {
+		t.Skip("changing the ownership requires root")
+	}
+
+	root := t.TempDir()
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	dir := filepath.Join(root, "dir")
+	require.NoError(t, os.Mkdir(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "file.txt"), []byte("content"), 0o644))
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), u)
+
+	_, err = svc.Chown(ctx, connect.NewRequest(&filesystem.ChownRequest{
+		Path:      dir,
+		Owner:     "1234",
+		Group:     "5678",
+		Recursive: true,
+	}))
+	require.NoError(t, err)
+
+	for _, path := range []string{dir, filepath.Join(dir, "file.txt")} {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+
+		sys := info.Sys().(*syscall.Stat_t)
+		assert.Equal(t, uint32(1234), sys.Uid)
+		assert.Equal(t, uint32(5678), sys.Gid)
+	}
+
+	// Only the owner is changed.
+	resp, err := svc.Chown(ctx, connect.NewRequest(&filesystem.ChownRequest{
+		Path:  dir,
+		Owner: u.Username,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, u.Username, resp.Msg.GetEntry().GetOwner())
+	assert.Equal(t, "5678", resp.Msg.GetEntry().GetGroup())
+
+	_, err = svc.Chown(ctx, connect.NewRequest(&filesystem.ChownRequest{Path: dir}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestChmod_NotOwner(t *testing.T) {
+	t.Parallel()
+
+	if os.Geteuid() != 0 {
+		t.Skip("changing the ownership requires root")
+	}
+
+	root := t.TempDir()
+	owned := filepath.Join(root, "owned.txt")
+	require.NoError(t, os.WriteFile(owned, []byte("content"), 0o644))
+	require.NoError(t, os.Chown(owned, 1234, 1234))
+	other := filepath.Join(root, "other.txt")
+	require.NoError(t, os.WriteFile(other, []byte("content"), 0o644))
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), &user.User{Uid: "1234", Gid: "1234", Username: "user", HomeDir: root})
+
+	_, err := svc.Chmod(ctx, connect.NewRequest(&filesystem.ChmodRequest{Path: owned, Mode: 0o600}))
+	require.NoError(t, err)
+
+	_, err = svc.Chmod(ctx, connect.NewRequest(&filesystem.ChmodRequest{Path: owned, Mode: 0o4755}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = svc.Chmod(ctx, connect.NewRequest(&filesystem.ChmodRequest{Path: other, Mode: 0o777}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// The files of other users in the directory aren't changed either.
+	require.NoError(t, os.Chown(root, 1234, 1234))
+	_, err = svc.Chmod(ctx, connect.NewRequest(&filesystem.ChmodRequest{Path: root, Mode: 0o777, Recursive: true}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	info, err := os.Stat(other)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+
+	_, err = svc.Chown(ctx, connect.NewRequest(&filesystem.ChownRequest{Path: owned, Owner: "0"}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = svc.Chown(ctx, connect.NewRequest(&filesystem.ChownRequest{Path: other, Owner: "1234"}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func TestSymlink(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	target := filepath.Join(root, "target.txt")
+	require.NoError(t, os.WriteFile(target, []byte("content"), 0o644))
+
+	path := filepath.Join(root, "links", "link.txt")
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), u)
+
+	resp, err := svc.Symlink(ctx, connect.NewRequest(&filesystem.SymlinkRequest{
+		Target: "../target.txt",
+		Path:   path,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, target, resp.Msg.GetEntry().GetSymlinkTarget())
+	assert.Equal(t, filesystem.FileType_FILE_TYPE_FILE, resp.Msg.GetEntry().GetType())
+
+	link, err := os.Readlink(path)
+	require.NoError(t, err)
+	assert.Equal(t, "../target.txt", link)
+
+	_, err = svc.Symlink(ctx, connect.NewRequest(&filesystem.SymlinkRequest{
+		Target: "../target.txt",
+		Path:   path,
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+}
@@ -0,0 +1,234 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func (Service) Copy(ctx context.Context, req *connect.Request[rpc.CopyRequest]) (*connect.Response[rpc.CopyResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := permissions.ExpandAndResolve(req.Msg.GetSource(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	destination, err := permissions.ExpandAndResolve(req.Msg.GetDestination(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if destination == source || strings.HasPrefix(destination, source+string(os.PathSeparator)) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot copy %s into itself", source))
+	}
+
+	_, err = os.Lstat(source)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("source not found: %w", err))
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting source info: %w", err))
+	}
+
+	_, err = os.Lstat(destination)
+	if err == nil && !req.Msg.GetOverwrite() {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("destination already exists: %s", destination))
+	}
+
+	if err != nil && !os.IsNotExist(err) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting destination info: %w", err))
+	}
+
+	uid, gid, userErr := permissions.GetUserIds(u)
+	if userErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, userErr)
+	}
+
+	userErr = permissions.EnsureDirs(filepath.Dir(destination), int(uid), int(gid))
+	if userErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, userErr)
+	}
+
+	err = copyTree(ctx, source, destination)
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error copying %s to %s: %w", source, destination, err))
+	}
+
+	entry, err := entryInfo(destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&rpc.CopyResponse{
+		Entry: entry,
+	}), nil
+}
+
+// copyTree copies the file or directory recursively, preserving the mode, ownership and timestamps.
+// Symlinks are copied as symlinks, the existing files at the destination are replaced.
+func copyTree(ctx context.Context, source, destination string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	info, err := os.Lstat(source)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case info.Mode().IsRegular():
+		err = copyFile(source, destination, info)
+	case info.IsDir():
+		err = copyDir(ctx, source, destination, info)
+	case info.Mode()&os.ModeSymlink != 0:
+		err = copySymlink(source, destination)
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot copy %s: unsupported file type %s", source, info.Mode().Type()))
+	}
+	if err != nil {
+		return err
+	}
+
+	return copyMetadata(destination, info)
+}
+
+func copyDir(ctx context.Context, source, destination string, info fs.FileInfo) error {
+	destInfo, err := os.Lstat(destination)
+	switch {
+	case err == nil && !destInfo.IsDir():
+		err = os.Remove(destination)
+		if err != nil {
+			return err
+		}
+
+		fallthrough
+	case os.IsNotExist(err):
+		err = os.Mkdir(destination, info.Mode().Perm())
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	}
+
+	entries, err := os.ReadDir(source)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		err = copyTree(ctx, filepath.Join(source, entry.Name()), filepath.Join(destination, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyFile(source, destination string, info fs.FileInfo) error {
+	err := removeIfNotRegular(destination)
+	if err != nil {
+		return err
+	}
+
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(destination, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		return err
+	}
+
+	return dst.Close()
+}
+
+func copySymlink(source, destination string) error {
+	target, err := os.Readlink(source)
+	if err != nil {
+		return err
+	}
+
+	err = os.RemoveAll(destination)
+	if err != nil {
+		return err
+	}
+
+	return os.Symlink(target, destination)
+}
+
+// removeIfNotRegular removes the destination if it exists and isn't a regular file, so it can be replaced by the file.
+func removeIfNotRegular(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if info.Mode().IsRegular() {
+		return nil
+	}
+
+	return os.RemoveAll(path)
+}
+
+// copyMetadata sets the ownership, mode and timestamps of the source on the destination without following symlinks.
+func copyMetadata(destination string, info fs.FileInfo) error {
+	sys, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fmt.Errorf("unsupported file info of %s", destination)
+	}
+
+	err := os.Lchown(destination, int(sys.Uid), int(sys.Gid))
+	if err != nil {
+		return err
+	}
+
+	// The mode of the symlinks can't be changed on Linux.
+	if info.Mode()&os.ModeSymlink == 0 {
+		// Chown clears the setuid and setgid bits, so the mode is set after it.
+		err = unix.Chmod(destination, uint32(info.Mode().Perm())|uint32(sys.Mode&(unix.S_ISUID|unix.S_ISGID|unix.S_ISVTX)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return unix.UtimesNanoAt(unix.AT_FDCWD, destination, []unix.Timespec{
+		unix.NsecToTimespec(syscall.TimespecToNsec(sys.Atim)),
+		unix.NsecToTimespec(syscall.TimespecToNsec(sys.Mtim)),
+	}, unix.AT_SYMLINK_NOFOLLOW)
+}
@@ -0,0 +1,123 @@
+package filesystem
+
+import (
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func TestCopyDirectory(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	source := filepath.Join(root, "source")
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "nested"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "nested", "script.sh"), []byte("#!/bin/sh"), 0o700))
+	require.NoError(t, os.Symlink("nested/script.sh", filepath.Join(source, "link")))
+
+	modTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, os.Chtimes(filepath.Join(source, "nested", "script.sh"), modTime, modTime))
+	require.NoError(t, os.Chtimes(filepath.Join(source, "nested"), modTime, modTime))
+
+	destination := filepath.Join(root, "parent", "destination")
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), u)
+
+	resp, err := svc.Copy(ctx, connect.NewRequest(&filesystem.CopyRequest{
+		Source:      source,
+		Destination: destination,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, destination, resp.Msg.GetEntry().GetPath())
+	assert.Equal(t, filesystem.FileType_FILE_TYPE_DIRECTORY, resp.Msg.GetEntry().GetType())
+
+	content, err := os.ReadFile(filepath.Join(destination, "nested", "script.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh", string(content))
+
+	info, err := os.Stat(filepath.Join(destination, "nested", "script.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+	assert.True(t, modTime.Equal(info.ModTime()))
+
+	info, err = os.Stat(filepath.Join(destination, "nested"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o750), info.Mode().Perm())
+	assert.True(t, modTime.Equal(info.ModTime()))
+
+	link, err := os.Readlink(filepath.Join(destination, "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "nested/script.sh", link)
+
+	// The source is kept
+	_, err = os.Stat(filepath.Join(source, "nested", "script.sh"))
+	require.NoError(t, err)
+}
+
+func TestCopyFile_Overwrite(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	source := filepath.Join(root, "source.txt")
+	destination := filepath.Join(root, "destination.txt")
+	require.NoError(t, os.WriteFile(source, []byte("new"), 0o644))
+	require.NoError(t, os.WriteFile(destination, []byte("old content"), 0o644))
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), u)
+
+	_, err = svc.Copy(ctx, connect.NewRequest(&filesystem.CopyRequest{
+		Source:      source,
+		Destination: destination,
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	_, err = svc.Copy(ctx, connect.NewRequest(&filesystem.CopyRequest{
+		Source:      source,
+		Destination: destination,
+		Overwrite:   true,
+	}))
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(destination)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(content))
+}
+
+func TestCopy_IntoItself(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	source := filepath.Join(root, "source")
+	require.NoError(t, os.Mkdir(source, 0o755))
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), u)
+
+	_, err = svc.Copy(ctx, connect.NewRequest(&filesystem.CopyRequest{
+		Source:      source,
+		Destination: filepath.Join(source, "copy"),
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
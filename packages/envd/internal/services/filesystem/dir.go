@@ -14,6 +14,13 @@ import (
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
 )
 
+const (
+	// listDirSha256MaxFileSize is the size of the largest file hashed by ListDir.
+	listDirSha256MaxFileSize = 64 << 20 // 64 MiB
+	// listDirSha256MaxTotalSize is the number of bytes hashed by one ListDir request, the files over it are left without the checksum.
+	listDirSha256MaxTotalSize = 512 << 20 // 512 MiB
+)
+
 func (Service) ListDir(ctx context.Context, req *connect.Request[rpc.ListDirRequest]) (*connect.Response[rpc.ListDirResponse], error) {
 	depth := req.Msg.GetDepth()
 	if depth == 0 {
@@ -43,7 +50,10 @@ func (Service) ListDir(ctx context.Context, req *connect.Request[rpc.ListDirRequ
 		return nil, err
 	}
 
-	entries, err := walkDir(requestedPath, resolvedPath, int(depth))
+	entries, err := walkDir(requestedPath, resolvedPath, int(depth), contentOptions{
+		sha256:   req.Msg.GetIncludeSha256(),
+		mimeType: req.Msg.GetIncludeMimeType(),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +144,10 @@ func checkIfDirectory(path string) error {
 }
 
 // walkDir walks the directory tree starting from dirPath up to the specified depth (doesn't follow symlinks).
-func walkDir(requestedPath string, dirPath string, depth int) (entries []*rpc.EntryInfo, err error) {
+// The checksums are computed only for the files up to listDirSha256MaxFileSize and up to listDirSha256MaxTotalSize in total.
+func walkDir(requestedPath string, dirPath string, depth int, opts contentOptions) (entries []*rpc.EntryInfo, err error) {
+	sha256Budget := int64(listDirSha256MaxTotalSize)
+
 	err = filepath.WalkDir(dirPath, func(path string, entry os.DirEntry, err error) error {
 		if err != nil {
 			return err
@@ -161,6 +174,22 @@ func walkDir(requestedPath string, dirPath string, depth int) (entries []*rpc.En
 			return err
 		}
 
+		entryOpts := opts
+		if opts.sha256 {
+			entryOpts.sha256MaxSize = min(listDirSha256MaxFileSize, sha256Budget)
+			// A zero size would mean no limit, the files are no longer hashed once the budget is spent.
+			entryOpts.sha256 = entryOpts.sha256MaxSize > 0
+		}
+
+		err = addContentInfo(entryInfo, entryOpts)
+		if err != nil {
+			return err
+		}
+
+		if entryInfo.Sha256 != nil {
+			sha256Budget -= entryInfo.GetSize()
+		}
+
 		// Return the requested path as the base path instead of the symlink-resolved path
 		path = filepath.Join(requestedPath, relPath)
 		entryInfo.Path = path
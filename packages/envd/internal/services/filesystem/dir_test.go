@@ -378,7 +378,7 @@ func TestWalkDir_Depth(t *testing.T) {
 	subsub := filepath.Join(sub, "subsub")
 	require.NoError(t, os.MkdirAll(subsub, 0o755))
 
-	entries, err := walkDir(root, root, 1)
+	entries, err := walkDir(root, root, 1, contentOptions{})
 	require.NoError(t, err)
 
 	// Collect the names for easier assertions.
@@ -394,7 +394,7 @@ func TestWalkDir_Depth(t *testing.T) {
 func TestWalkDir_Error(t *testing.T) {
 	t.Parallel()
 
-	_, err := walkDir("/does/not/exist", "/does/not/exist", 1)
+	_, err := walkDir("/does/not/exist", "/does/not/exist", 1, contentOptions{})
 	require.Error(t, err)
 
 	var cerr *connect.Error
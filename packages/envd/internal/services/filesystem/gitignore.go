@@ -0,0 +1,116 @@
+package filesystem
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+type gitignorePattern struct {
+	// pattern is matched against the path relative to the directory of the .gitignore file.
+	pattern string
+	negate  bool
+	dirOnly bool
+}
+
+// gitignore holds the patterns of a single .gitignore file.
+type gitignore struct {
+	// dir is the directory of the .gitignore file relative to the searched directory.
+	dir      string
+	patterns []gitignorePattern
+}
+
+func parseGitignore(dir string, r io.Reader) (gitignore, error) {
+	g := gitignore{dir: dir}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(strings.TrimSuffix(scanner.Text(), "\r"), " ")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var p gitignorePattern
+
+		if strings.HasPrefix(line, "!") {
+			p.negate = true
+			line = line[1:]
+		} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+			line = line[1:]
+		}
+
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+
+		// The patterns with a slash are relative to the .gitignore file, the others match at any depth.
+		if strings.Contains(line, "/") {
+			line = strings.TrimPrefix(line, "/")
+		} else {
+			line = "**/" + line
+		}
+
+		if line == "" || !doublestar.ValidatePattern(line) {
+			continue
+		}
+
+		p.pattern = line
+		g.patterns = append(g.patterns, p)
+	}
+
+	return g, scanner.Err()
+}
+
+// match returns whether any pattern matches the path and if the path is ignored by the last matching pattern.
+func (g gitignore) match(relPath string, isDir bool) (matched bool, ignored bool) {
+	if g.dir != "." {
+		relPath = strings.TrimPrefix(relPath, g.dir+"/")
+	}
+
+	for _, p := range g.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+
+		if ok, _ := doublestar.Match(p.pattern, relPath); ok {
+			matched = true
+			ignored = !p.negate
+		}
+	}
+
+	return matched, ignored
+}
+
+// gitignoreStack holds the .gitignore files of the directories from the searched directory down to the current one.
+type gitignoreStack []gitignore
+
+// enter drops the .gitignore files of the directories that aren't parents of the path.
+func (s gitignoreStack) enter(relPath string) gitignoreStack {
+	dir := path.Dir(relPath)
+	for len(s) > 0 {
+		top := s[len(s)-1].dir
+		if top == "." || dir == top || strings.HasPrefix(dir, top+"/") {
+			break
+		}
+
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
+
+// ignored checks the .gitignore files from the top, the patterns of the deeper files take precedence.
+func (s gitignoreStack) ignored(relPath string, isDir bool) bool {
+	ignored := false
+	for _, g := range s {
+		if matched, ignoredByFile := g.match(relPath, isDir); matched {
+			ignored = ignoredByFile
+		}
+	}
+
+	return ignored
+}
@@ -0,0 +1,351 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/logs"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+const (
+	defaultSearchMaxResults  = 1000
+	defaultSearchMaxFileSize = 10 << 20
+
+	// maxSnippetLength is the maximum length of the returned line in bytes.
+	maxSnippetLength = 256
+	// binaryCheckLength is the number of bytes checked for a NUL byte to detect binary files, the same as git does.
+	binaryCheckLength = 8000
+)
+
+func (s Service) Search(ctx context.Context, req *connect.Request[rpc.SearchRequest], stream *connect.ServerStream[rpc.SearchResponse]) error {
+	return logs.LogServerStreamWithoutEvents(ctx, s.logger, req, stream, s.searchHandler)
+}
+
+func (s Service) searchHandler(ctx context.Context, req *connect.Request[rpc.SearchRequest], stream *connect.ServerStream[rpc.SearchResponse]) error {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	requestedPath, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resolvedPath, err := followSymlink(requestedPath)
+	if err != nil {
+		return err
+	}
+
+	err = checkIfDirectory(resolvedPath)
+	if err != nil {
+		return err
+	}
+
+	matcher, err := compileSearchQuery(req.Msg.GetQuery(), req.Msg.GetRegex(), req.Msg.GetCaseInsensitive())
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	for _, pattern := range append(req.Msg.GetInclude(), req.Msg.GetExclude()...) {
+		if !doublestar.ValidatePattern(pattern) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid glob pattern %q", pattern))
+		}
+	}
+
+	keepaliveTicker, _ := permissions.GetKeepAliveTicker(req)
+	defer keepaliveTicker.Stop()
+
+	srch := &searcher{
+		requestedPath:  requestedPath,
+		matcher:        matcher,
+		include:        req.Msg.GetInclude(),
+		exclude:        req.Msg.GetExclude(),
+		maxResults:     int(req.Msg.GetMaxResults()),
+		maxFileSize:    req.Msg.GetMaxFileSize(),
+		includeIgnored: req.Msg.GetIncludeIgnored(),
+		keepalive:      keepaliveTicker.C,
+		send:           stream.Send,
+	}
+
+	end, err := srch.run(ctx, resolvedPath)
+	if err != nil {
+		return err
+	}
+
+	err = stream.Send(&rpc.SearchResponse{
+		Event: &rpc.SearchResponse_End{
+			End: end,
+		},
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending end event: %w", err))
+	}
+
+	return nil
+}
+
+func compileSearchQuery(query string, regex bool, caseInsensitive bool) (*regexp.Regexp, error) {
+	if query == "" {
+		return nil, errors.New("query must not be empty")
+	}
+
+	if !regex {
+		query = regexp.QuoteMeta(query)
+	}
+
+	if caseInsensitive {
+		query = "(?i)" + query
+	}
+
+	matcher, err := regexp.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %w", err)
+	}
+
+	return matcher, nil
+}
+
+// searcher walks the directory tree (doesn't follow symlinks) and sends the matches of the query in the text files.
+type searcher struct {
+	// requestedPath is used as the base of the returned paths instead of the symlink-resolved path.
+	requestedPath  string
+	matcher        *regexp.Regexp
+	include        []string
+	exclude        []string
+	maxResults     int
+	maxFileSize    int64
+	includeIgnored bool
+
+	keepalive <-chan time.Time
+	send      func(*rpc.SearchResponse) error
+
+	results       int
+	filesSearched uint32
+	limitReached  bool
+}
+
+func (s *searcher) run(ctx context.Context, dirPath string) (*rpc.SearchResponse_EndEvent, error) {
+	if s.maxResults <= 0 {
+		s.maxResults = defaultSearchMaxResults
+	}
+
+	if s.maxFileSize <= 0 {
+		s.maxFileSize = defaultSearchMaxFileSize
+	}
+
+	var ignores gitignoreStack
+
+	err := filepath.WalkDir(dirPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if filePath == dirPath {
+				return err
+			}
+
+			// Skip the entries that can't be read, e.g. because of the permissions.
+			return nil
+		}
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		select {
+		case <-s.keepalive:
+			keepaliveErr := s.send(&rpc.SearchResponse{
+				Event: &rpc.SearchResponse_Keepalive{
+					Keepalive: &rpc.SearchResponse_KeepAlive{},
+				},
+			})
+			if keepaliveErr != nil {
+				return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending keepalive: %w", keepaliveErr))
+			}
+		default:
+		}
+
+		if filePath == dirPath {
+			ignores = s.loadGitignore(ignores, filePath, ".")
+
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dirPath, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if !s.includeIgnored {
+			ignores = ignores.enter(relPath)
+		}
+
+		if entry.IsDir() {
+			if entry.Name() == ".git" || matchesGlob(s.exclude, relPath) || (!s.includeIgnored && ignores.ignored(relPath, true)) {
+				return filepath.SkipDir
+			}
+
+			ignores = s.loadGitignore(ignores, filePath, relPath)
+
+			return nil
+		}
+
+		if !entry.Type().IsRegular() || matchesGlob(s.exclude, relPath) {
+			return nil
+		}
+
+		if len(s.include) > 0 && !matchesGlob(s.include, relPath) {
+			return nil
+		}
+
+		if !s.includeIgnored && ignores.ignored(relPath, false) {
+			return nil
+		}
+
+		return s.searchFile(filePath, relPath)
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, connect.NewError(connect.CodeCanceled, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error searching directory %s: %w", dirPath, err))
+	}
+
+	return &rpc.SearchResponse_EndEvent{
+		FilesSearched: s.filesSearched,
+		LimitReached:  s.limitReached,
+	}, nil
+}
+
+func (s *searcher) loadGitignore(ignores gitignoreStack, dirPath string, relPath string) gitignoreStack {
+	if s.includeIgnored {
+		return ignores
+	}
+
+	file, err := os.Open(filepath.Join(dirPath, ".gitignore"))
+	if err != nil {
+		return ignores
+	}
+	defer file.Close()
+
+	g, err := parseGitignore(relPath, file)
+	if err != nil {
+		return ignores
+	}
+
+	return append(ignores, g)
+}
+
+func (s *searcher) searchFile(filePath string, relPath string) error {
+	info, err := os.Stat(filePath)
+	if err != nil || info.Size() > s.maxFileSize {
+		return nil
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil
+	}
+
+	if bytes.IndexByte(data[:min(len(data), binaryCheckLength)], 0) >= 0 {
+		return nil
+	}
+
+	s.filesSearched++
+
+	for lineNumber := 1; len(data) > 0; lineNumber++ {
+		line := data
+		if end := bytes.IndexByte(data, '\n'); end >= 0 {
+			line, data = data[:end], data[end+1:]
+		} else {
+			data = nil
+		}
+		line = bytes.TrimSuffix(line, []byte("\r"))
+
+		for _, loc := range s.matcher.FindAllIndex(line, -1) {
+			// Skip the empty matches of the patterns like "a*".
+			if loc[0] == loc[1] {
+				continue
+			}
+
+			err = s.send(&rpc.SearchResponse{
+				Event: &rpc.SearchResponse_Match_{
+					Match: &rpc.SearchResponse_Match{
+						Path:    path.Join(s.requestedPath, relPath),
+						Line:    uint32(lineNumber),
+						Column:  uint32(loc[0] + 1),
+						Snippet: snippet(line, loc[0]),
+					},
+				},
+			})
+			if err != nil {
+				return connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending match: %w", err))
+			}
+
+			s.results++
+			if s.results >= s.maxResults {
+				s.limitReached = true
+
+				return filepath.SkipAll
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchesGlob matches the patterns without a slash against the name of the file, the others against the relative path.
+func matchesGlob(patterns []string, relPath string) bool {
+	for _, pattern := range patterns {
+		target := relPath
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(relPath)
+		}
+
+		if ok, _ := doublestar.Match(pattern, target); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// snippet shortens the long lines around the match, without splitting the multi-byte characters.
+func snippet(line []byte, matchStart int) string {
+	if len(line) > maxSnippetLength {
+		from := max(0, matchStart-maxSnippetLength/4)
+		to := min(len(line), from+maxSnippetLength)
+
+		for from > 0 && !utf8.RuneStart(line[from]) {
+			from--
+		}
+
+		for to < len(line) && !utf8.RuneStart(line[to]) {
+			to--
+		}
+
+		line = line[from:to]
+	}
+
+	return strings.ToValidUTF8(string(line), "\uFFFD")
+}
@@ -0,0 +1,255 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func writeSearchTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	return root
+}
+
+func runSearch(t *testing.T, root string, srch *searcher) ([]*rpc.SearchResponse_Match, *rpc.SearchResponse_EndEvent) {
+	t.Helper()
+
+	var matches []*rpc.SearchResponse_Match
+
+	srch.requestedPath = root
+	srch.send = func(resp *rpc.SearchResponse) error {
+		if match := resp.GetMatch(); match != nil {
+			rel, err := filepath.Rel(root, match.GetPath())
+			require.NoError(t, err)
+			match.Path = rel
+
+			matches = append(matches, match)
+		}
+
+		return nil
+	}
+
+	end, err := srch.run(context.Background(), root)
+	require.NoError(t, err)
+
+	return matches, end
+}
+
+func matchPaths(matches []*rpc.SearchResponse_Match) []string {
+	paths := make([]string, 0, len(matches))
+	for _, match := range matches {
+		paths = append(paths, match.GetPath())
+	}
+
+	return paths
+}
+
+func TestSearch_Matches(t *testing.T) {
+	t.Parallel()
+
+	root := writeSearchTree(t, map[string]string{
+		"main.go":         "package main\n\nfunc main() {\n\tprintln(\"TODO: todo\")\n}\n",
+		"docs/readme.md":  "Nothing to do here\r\nTODO write docs\r\n",
+		"bin/app":         "TODO\x00binary",
+		"large/data.json": strings.Repeat("TODO ", 100),
+	})
+
+	tests := []struct {
+		name     string
+		query    string
+		regex    bool
+		ci       bool
+		expected []*rpc.SearchResponse_Match
+	}{
+		{
+			name:  "literal",
+			query: "TODO",
+			expected: []*rpc.SearchResponse_Match{
+				{Path: "docs/readme.md", Line: 2, Column: 1, Snippet: "TODO write docs"},
+				{Path: "main.go", Line: 4, Column: 11, Snippet: "\tprintln(\"TODO: todo\")"},
+			},
+		},
+		{
+			name:  "case insensitive literal",
+			query: "todo",
+			ci:    true,
+			expected: []*rpc.SearchResponse_Match{
+				{Path: "docs/readme.md", Line: 2, Column: 1, Snippet: "TODO write docs"},
+				{Path: "main.go", Line: 4, Column: 11, Snippet: "\tprintln(\"TODO: todo\")"},
+				{Path: "main.go", Line: 4, Column: 17, Snippet: "\tprintln(\"TODO: todo\")"},
+			},
+		},
+		{
+			name:  "regex",
+			query: `^func \w+`,
+			regex: true,
+			expected: []*rpc.SearchResponse_Match{
+				{Path: "main.go", Line: 3, Column: 1, Snippet: "func main() {"},
+			},
+		},
+		{
+			name:     "literal doesn't interpret regex",
+			query:    `^func`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			matcher, err := compileSearchQuery(tt.query, tt.regex, tt.ci)
+			require.NoError(t, err)
+
+			matches, end := runSearch(t, root, &searcher{matcher: matcher, maxFileSize: 100})
+
+			require.Len(t, matches, len(tt.expected))
+			for i, expected := range tt.expected {
+				assert.Equal(t, expected.GetPath(), matches[i].GetPath())
+				assert.Equal(t, expected.GetLine(), matches[i].GetLine())
+				assert.Equal(t, expected.GetColumn(), matches[i].GetColumn())
+				assert.Equal(t, expected.GetSnippet(), matches[i].GetSnippet())
+			}
+
+			// The binary file and the file over the size limit are skipped.
+			assert.Equal(t, uint32(2), end.GetFilesSearched())
+			assert.False(t, end.GetLimitReached())
+		})
+	}
+}
+
+func TestSearch_Filters(t *testing.T) {
+	t.Parallel()
+
+	root := writeSearchTree(t, map[string]string{
+		".gitignore":              "*.log\nbuild/\n/root-only.txt\n!keep.log\n",
+		"app.log":                 "needle",
+		"keep.log":                "needle",
+		"root-only.txt":           "needle",
+		"build/out.txt":           "needle",
+		"src/main.go":             "needle",
+		"src/main_test.go":        "needle",
+		"src/root-only.txt":       "needle",
+		"src/vendor/.gitignore":   "*.go\n",
+		"src/vendor/lib/x.go":     "needle",
+		"src/vendor/lib/x.txt":    "needle",
+		".git/objects/needle.txt": "needle",
+	})
+
+	matcher, err := compileSearchQuery("needle", false, false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		srch     *searcher
+		expected []string
+	}{
+		{
+			name: "gitignore",
+			srch: &searcher{},
+			expected: []string{
+				"keep.log",
+				"src/main.go",
+				"src/main_test.go",
+				"src/root-only.txt",
+				"src/vendor/lib/x.txt",
+			},
+		},
+		{
+			name: "include ignored",
+			srch: &searcher{includeIgnored: true},
+			expected: []string{
+				"app.log",
+				"build/out.txt",
+				"keep.log",
+				"root-only.txt",
+				"src/main.go",
+				"src/main_test.go",
+				"src/root-only.txt",
+				"src/vendor/lib/x.go",
+				"src/vendor/lib/x.txt",
+			},
+		},
+		{
+			name:     "include and exclude",
+			srch:     &searcher{include: []string{"src/**/*.go", "*.log"}, exclude: []string{"*_test.go"}},
+			expected: []string{"keep.log", "src/main.go"},
+		},
+		{
+			name:     "exclude directory",
+			srch:     &searcher{exclude: []string{"src/vendor"}},
+			expected: []string{"keep.log", "src/main.go", "src/main_test.go", "src/root-only.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.srch.matcher = matcher
+			matches, _ := runSearch(t, root, tt.srch)
+
+			assert.Equal(t, tt.expected, matchPaths(matches))
+		})
+	}
+}
+
+func TestSearch_MaxResults(t *testing.T) {
+	t.Parallel()
+
+	root := writeSearchTree(t, map[string]string{
+		"a.txt": "x x x",
+		"b.txt": "x",
+	})
+
+	matcher, err := compileSearchQuery("x", false, false)
+	require.NoError(t, err)
+
+	matches, end := runSearch(t, root, &searcher{matcher: matcher, maxResults: 2})
+
+	assert.Len(t, matches, 2)
+	assert.True(t, end.GetLimitReached())
+	assert.Equal(t, uint32(1), end.GetFilesSearched())
+}
+
+func TestSearch_InvalidQuery(t *testing.T) {
+	t.Parallel()
+
+	_, err := compileSearchQuery("", false, false)
+	require.Error(t, err)
+
+	_, err = compileSearchQuery("(unclosed", true, false)
+	require.Error(t, err)
+
+	_, err = compileSearchQuery("(unclosed", false, false)
+	require.NoError(t, err)
+}
+
+func TestSnippet(t *testing.T) {
+	t.Parallel()
+
+	short := []byte("short line")
+	assert.Equal(t, "short line", snippet(short, 0))
+
+	long := []byte(strings.Repeat("é", 200) + "match" + strings.Repeat("é", 200))
+	s := snippet(long, 400)
+
+	assert.LessOrEqual(t, len(s), maxSnippetLength)
+	assert.Contains(t, s, "match")
+	assert.True(t, strings.HasPrefix(s, "é"))
+}
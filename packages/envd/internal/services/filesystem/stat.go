@@ -25,5 +25,13 @@ func (Service) Stat(ctx context.Context, req *connect.Request[rpc.StatRequest])
 		return nil, err
 	}
 
+	err = addContentInfo(entry, contentOptions{
+		sha256:   req.Msg.GetIncludeSha256(),
+		mimeType: req.Msg.GetIncludeMimeType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return connect.NewResponse(&rpc.StatResponse{Entry: entry}), nil
 }
@@ -1,25 +1,46 @@
 package filesystem
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"net/http"
 	"os"
 	"os/user"
+	"path/filepath"
 	"syscall"
+	"time"
 
 	"connectrpc.com/connect"
+	"golang.org/x/sys/unix"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
 )
 
-// getEntryType determines the type of file entry based on its mode and path.
-// If the file is a symlink, it follows the symlink to determine the actual type.
+// mimeSniffLength is the number of bytes used for detecting the mime type.
+const mimeSniffLength = 512
+
+// getEntryType determines the type of file entry based on its mode.
 func getEntryType(mode os.FileMode) rpc.FileType {
 	switch {
 	case mode.IsRegular():
 		return rpc.FileType_FILE_TYPE_FILE
 	case mode.IsDir():
 		return rpc.FileType_FILE_TYPE_DIRECTORY
+	case mode&os.ModeSymlink != 0:
+		return rpc.FileType_FILE_TYPE_SYMLINK
+	case mode&os.ModeSocket != 0:
+		return rpc.FileType_FILE_TYPE_SOCKET
+	case mode&os.ModeNamedPipe != 0:
+		return rpc.FileType_FILE_TYPE_FIFO
+	case mode&os.ModeCharDevice != 0:
+		return rpc.FileType_FILE_TYPE_CHAR_DEVICE
+	case mode&os.ModeDevice != 0:
+		return rpc.FileType_FILE_TYPE_BLOCK_DEVICE
 	default:
 		return rpc.FileType_FILE_TYPE_UNSPECIFIED
 	}
@@ -80,14 +101,14 @@ func entryInfo(path string) (*rpc.EntryInfo, error) {
 		// If it's a symlink, we need to determine the type of the target
 		targetInfo, err := os.Stat(*symlinkTarget)
 		if err != nil {
-			entryType = rpc.FileType_FILE_TYPE_UNSPECIFIED
+			entryType = rpc.FileType_FILE_TYPE_SYMLINK
 		} else {
 			entryType = getEntryType(targetInfo.Mode())
 			mode = uint32(targetInfo.Mode().Perm())
 		}
 	}
 
-	return &rpc.EntryInfo{
+	entry := &rpc.EntryInfo{
 		Name:          fileInfo.Name(),
 		Type:          entryType,
 		Path:          path,
@@ -98,5 +119,108 @@ func entryInfo(path string) (*rpc.EntryInfo, error) {
 		Group:         group,
 		ModifiedTime:  timestamppb.New(fileInfo.ModTime()),
 		SymlinkTarget: symlinkTarget,
-	}, nil
+	}
+
+	if sys, ok := fileInfo.Sys().(*syscall.Stat_t); ok {
+		entry.Inode = sys.Ino
+		entry.LinkCount = uint64(sys.Nlink)
+		entry.AccessTime = timestamppb.New(time.Unix(sys.Atim.Unix()))
+		entry.ChangeTime = timestamppb.New(time.Unix(sys.Ctim.Unix()))
+	}
+
+	entry.BirthTime = birthTime(path)
+
+	return entry, nil
+}
+
+// birthTime returns the creation time of the entry, or nil if the filesystem doesn't record it.
+func birthTime(path string) *timestamppb.Timestamp {
+	var stat unix.Statx_t
+
+	err := unix.Statx(unix.AT_FDCWD, path, unix.AT_SYMLINK_NOFOLLOW, unix.STATX_BTIME, &stat)
+	if err != nil || stat.Mask&unix.STATX_BTIME == 0 {
+		return nil
+	}
+
+	return timestamppb.New(time.Unix(stat.Btime.Sec, int64(stat.Btime.Nsec)))
+}
+
+// contentOptions selects the information about the file content added to the entry.
+type contentOptions struct {
+	sha256 bool
+	// sha256MaxSize is the size of the largest file hashed, the larger files are left without the checksum (0 means no limit).
+	sha256MaxSize int64
+	mimeType      bool
+}
+
+// addContentInfo reads the content of the file to compute the checksum and detect the mime type.
+// The entries that aren't files (or symlinks to files) are left unchanged.
+func addContentInfo(entry *rpc.EntryInfo, opts contentOptions) error {
+	if entry.GetType() != rpc.FileType_FILE_TYPE_FILE || (!opts.sha256 && !opts.mimeType) {
+		return nil
+	}
+
+	file, err := os.Open(entry.GetPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("file not found: %w", err))
+		}
+
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error opening file: %w", err))
+	}
+	defer file.Close()
+
+	if opts.mimeType {
+		head := make([]byte, mimeSniffLength)
+
+		n, err := io.ReadFull(file, head)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("error reading file: %w", err))
+		}
+
+		mimeType := detectMimeType(entry.GetName(), head[:n])
+		entry.MimeType = &mimeType
+
+		_, err = file.Seek(0, io.SeekStart)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("error reading file: %w", err))
+		}
+	}
+
+	if opts.sha256 && (opts.sha256MaxSize == 0 || entry.GetSize() <= opts.sha256MaxSize) {
+		hash := sha256.New()
+
+		var content io.Reader = file
+		if opts.sha256MaxSize > 0 {
+			// The file can grow after the stat, read at most one byte over the limit to notice it.
+			content = io.LimitReader(file, opts.sha256MaxSize+1)
+		}
+
+		n, err := io.Copy(hash, content)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("error computing checksum: %w", err))
+		}
+
+		if opts.sha256MaxSize > 0 && n > opts.sha256MaxSize {
+			return nil
+		}
+
+		checksum := hex.EncodeToString(hash.Sum(nil))
+		entry.Sha256 = &checksum
+	}
+
+	return nil
+}
+
+// detectMimeType sniffs the content, the extension is used when the content only shows a generic type.
+func detectMimeType(name string, head []byte) string {
+	detected := http.DetectContentType(head)
+
+	if detected == "text/plain; charset=utf-8" || detected == "application/octet-stream" {
+		if byExtension := mime.TypeByExtension(filepath.Ext(name)); byExtension != "" {
+			return byExtension
+		}
+	}
+
+	return detected
 }
@@ -1,6 +1,9 @@
 package filesystem
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -9,6 +12,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
 
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
 )
@@ -46,7 +50,7 @@ func TestGetEntryType(t *testing.T) {
 		{
 			name:     "symlink to file",
 			path:     symlink,
-			expected: rpc.FileType_FILE_TYPE_UNSPECIFIED,
+			expected: rpc.FileType_FILE_TYPE_SYMLINK,
 		},
 	}
 
@@ -95,6 +99,13 @@ func TestEntryInfoFromFileInfo(t *testing.T) {
 	// Check that modified time is reasonable (within last minute)
 	modTime := result.GetModifiedTime().AsTime()
 	assert.WithinDuration(t, time.Now(), modTime, time.Minute)
+
+	assert.NotZero(t, result.GetInode())
+	assert.Equal(t, uint64(1), result.GetLinkCount())
+	assert.WithinDuration(t, time.Now(), result.GetAccessTime().AsTime(), time.Minute)
+	assert.WithinDuration(t, time.Now(), result.GetChangeTime().AsTime(), time.Minute)
+	assert.Nil(t, result.Sha256)
+	assert.Nil(t, result.MimeType)
 }
 
 func TestEntryInfoFromFileInfo_Directory(t *testing.T) {
@@ -159,7 +170,7 @@ func TestEntryInfoFromFileInfo_BrokenSymlink(t *testing.T) {
 
 	assert.Equal(t, "broken", result.GetName())
 	assert.Equal(t, brokenSymlink, result.GetPath())
-	assert.Equal(t, rpc.FileType_FILE_TYPE_UNSPECIFIED, result.GetType())
+	assert.Equal(t, rpc.FileType_FILE_TYPE_SYMLINK, result.GetType())
 	assert.Contains(t, result.GetPermissions(), "L")
 	// SymlinkTarget might be empty if followSymlink fails
 }
@@ -178,7 +189,7 @@ func TestEntryInfoFromFileInfo_CyclicSymlink(t *testing.T) {
 
 	assert.Equal(t, "cyclic", result.GetName())
 	assert.Equal(t, cyclicSymlink, result.GetPath())
-	assert.Equal(t, rpc.FileType_FILE_TYPE_UNSPECIFIED, result.GetType())
+	assert.Equal(t, rpc.FileType_FILE_TYPE_SYMLINK, result.GetType())
 	assert.Contains(t, result.GetPermissions(), "L")
 }
 
@@ -259,3 +270,104 @@ func TestEntryInfoFromFileInfo_SymlinkChain(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedTarget, result.GetSymlinkTarget())
 }
+
+func TestEntryInfoFromFileInfo_SpecialFiles(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+
+	fifo := filepath.Join(tempDir, "fifo")
+	require.NoError(t, unix.Mkfifo(fifo, 0o644))
+
+	socket := filepath.Join(tempDir, "socket")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	tests := []struct {
+		path     string
+		expected rpc.FileType
+	}{
+		{path: fifo, expected: rpc.FileType_FILE_TYPE_FIFO},
+		{path: socket, expected: rpc.FileType_FILE_TYPE_SOCKET},
+		{path: "/dev/null", expected: rpc.FileType_FILE_TYPE_CHAR_DEVICE},
+	}
+
+	for _, tt := range tests {
+		result, err := entryInfo(tt.path)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, result.GetType(), tt.path)
+
+		// The content of the special files isn't read.
+		require.NoError(t, addContentInfo(result, contentOptions{sha256: true, mimeType: true}))
+		assert.Nil(t, result.Sha256)
+		assert.Nil(t, result.MimeType)
+	}
+}
+
+func TestAddContentInfo(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		content  []byte
+		mimeType string
+	}{
+		{name: "page.html", content: []byte("<!DOCTYPE html><html></html>"), mimeType: "text/html; charset=utf-8"},
+		{name: "image.png", content: []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), mimeType: "image/png"},
+		{name: "data.json", content: []byte(`{"key": "value"}`), mimeType: "application/json"},
+		{name: "notes", content: []byte("plain text"), mimeType: "text/plain; charset=utf-8"},
+		{name: "empty", content: []byte{}, mimeType: "text/plain; charset=utf-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(tempDir, tt.name)
+			require.NoError(t, os.WriteFile(path, tt.content, 0o644))
+
+			result, err := entryInfo(path)
+			require.NoError(t, err)
+
+			require.NoError(t, addContentInfo(result, contentOptions{sha256: true, mimeType: true}))
+
+			checksum := sha256.Sum256(tt.content)
+			assert.Equal(t, hex.EncodeToString(checksum[:]), result.GetSha256())
+			assert.Equal(t, tt.mimeType, result.GetMimeType())
+		})
+	}
+}
+
+func TestAddContentInfo_Sha256MaxSize(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+
+	small := filepath.Join(tempDir, "small")
+	require.NoError(t, os.WriteFile(small, []byte("1234"), 0o644))
+
+	large := filepath.Join(tempDir, "large")
+	require.NoError(t, os.WriteFile(large, []byte("12345"), 0o644))
+
+	result, err := entryInfo(small)
+	require.NoError(t, err)
+	require.NoError(t, addContentInfo(result, contentOptions{sha256: true, sha256MaxSize: 4}))
+	assert.NotNil(t, result.Sha256)
+
+	// The larger files are left without the checksum, the mime type is still detected.
+	result, err = entryInfo(large)
+	require.NoError(t, err)
+	require.NoError(t, addContentInfo(result, contentOptions{sha256: true, sha256MaxSize: 4, mimeType: true}))
+	assert.Nil(t, result.Sha256)
+	assert.Equal(t, "text/plain; charset=utf-8", result.GetMimeType())
+
+	// The file growing after the stat isn't hashed past the limit.
+	result, err = entryInfo(small)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(small, []byte("12345678"), 0o644))
+	require.NoError(t, addContentInfo(result, contentOptions{sha256: true, sha256MaxSize: 4}))
+	assert.Nil(t, result.Sha256)
+}
@@ -0,0 +1,200 @@
+package filesystem
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+const defaultFileMode = 0o644
+
+// writeLock serializes the writes done through envd, so the precondition can't change between the check and the write.
+// The writes done by the processes in the sandbox aren't synchronized with it.
+var writeLock sync.Mutex
+
+// WriteFile replaces the content of the file atomically, the content is written to a temporary file that is renamed over the file.
+// The optional preconditions make the write a compare-and-swap operation.
+func (Service) WriteFile(ctx context.Context, req *connect.Request[rpc.WriteFileRequest]) (*connect.Response[rpc.WriteFileResponse], error) {
+	u, err := permissions.GetAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := permissions.ExpandAndResolve(req.Msg.GetPath(), u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if req.Msg.Mode != nil && req.Msg.GetMode() > maxMode {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid mode %o", req.Msg.GetMode()))
+	}
+
+	uid, gid, userErr := permissions.GetUserIds(u)
+	if userErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, userErr)
+	}
+
+	userErr = permissions.EnsureDirs(filepath.Dir(path), int(uid), int(gid))
+	if userErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, userErr)
+	}
+
+	writeLock.Lock()
+	defer writeLock.Unlock()
+
+	// The target of the symlink is written instead of replacing the symlink.
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		target = path
+	}
+
+	current, err := os.Stat(target)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error getting file info: %w", err))
+	}
+
+	exists := err == nil
+	if exists && current.IsDir() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path is a directory: %s", path))
+	}
+
+	if exists && req.Msg.GetCreateOnly() {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("file already exists: %s", path))
+	}
+
+	err = checkWritePrecondition(req.Msg, target, current)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerUID, ownerGID, mode := int(uid), int(gid), uint32(defaultFileMode)
+	if exists {
+		sys, ok := current.Sys().(*syscall.Stat_t)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unsupported file info of %s", path))
+		}
+
+		ownerUID, ownerGID, mode = int(sys.Uid), int(sys.Gid), sys.Mode&maxMode
+	}
+
+	if req.Msg.Mode != nil {
+		mode = req.Msg.GetMode()
+	}
+
+	err = writeFileAtomic(target, req.Msg.GetContent(), ownerUID, ownerGID, mode)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error writing file: %w", err))
+	}
+
+	entry, err := entryInfo(path)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(req.Msg.GetContent())
+
+	return connect.NewResponse(&rpc.WriteFileResponse{
+		Entry:  entry,
+		Sha256: hex.EncodeToString(checksum[:]),
+	}), nil
+}
+
+// checkWritePrecondition checks the expected modification time and checksum against the current file.
+func checkWritePrecondition(req *rpc.WriteFileRequest, path string, current fs.FileInfo) error {
+	if req.ExpectedModifiedTime == nil && req.ExpectedSha256 == nil {
+		return nil
+	}
+
+	if current == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("file doesn't exist: %s", path))
+	}
+
+	if req.ExpectedModifiedTime != nil && !current.ModTime().Equal(req.GetExpectedModifiedTime().AsTime()) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("file was modified at %s", current.ModTime().UTC()))
+	}
+
+	if req.ExpectedSha256 != nil {
+		checksum, err := fileChecksum(path)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("error computing checksum: %w", err))
+		}
+
+		if !strings.EqualFold(checksum, req.GetExpectedSha256()) {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("file content changed, current checksum is %s", checksum))
+		}
+	}
+
+	return nil
+}
+
+func fileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func writeFileAtomic(path string, content []byte, uid, gid int, mode uint32) (err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".write-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tmp.Close()
+
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	_, err = tmp.Write(content)
+	if err != nil {
+		return err
+	}
+
+	err = tmp.Sync()
+	if err != nil {
+		return err
+	}
+
+	err = tmp.Chown(uid, gid)
+	if err != nil {
+		return err
+	}
+
+	// Chown clears the setuid and setgid bits, so the mode is set after it.
+	err = unix.Fchmod(int(tmp.Fd()), mode)
+	if err != nil {
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
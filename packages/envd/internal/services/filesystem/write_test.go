@@ -0,0 +1,140 @@
+package filesystem
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/spec/filesystem"
+)
+
+func TestWriteFile_CompareAndSwap(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	path := filepath.Join(root, "dir", "config.json")
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), u)
+
+	resp, err := svc.WriteFile(ctx, connect.NewRequest(&filesystem.WriteFileRequest{
+		Path:       path,
+		Content:    []byte("v1"),
+		CreateOnly: true,
+	}))
+	require.NoError(t, err)
+
+	checksum := sha256.Sum256([]byte("v1"))
+	assert.Equal(t, hex.EncodeToString(checksum[:]), resp.Msg.GetSha256())
+	assert.Equal(t, uint32(defaultFileMode), resp.Msg.GetEntry().GetMode())
+
+	_, err = svc.WriteFile(ctx, connect.NewRequest(&filesystem.WriteFileRequest{
+		Path:       path,
+		Content:    []byte("v1 again"),
+		CreateOnly: true,
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	_, err = svc.WriteFile(ctx, connect.NewRequest(&filesystem.WriteFileRequest{
+		Path:           path,
+		Content:        []byte("v2"),
+		ExpectedSha256: proto.String(resp.Msg.GetSha256()),
+	}))
+	require.NoError(t, err)
+
+	// The checksum of the first version doesn't match anymore.
+	_, err = svc.WriteFile(ctx, connect.NewRequest(&filesystem.WriteFileRequest{
+		Path:           path,
+		Content:        []byte("v3"),
+		ExpectedSha256: proto.String(resp.Msg.GetSha256()),
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	modTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+	_, err = svc.WriteFile(ctx, connect.NewRequest(&filesystem.WriteFileRequest{
+		Path:                 path,
+		Content:              []byte("v3"),
+		ExpectedModifiedTime: timestamppb.New(modTime.Add(time.Second)),
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = svc.WriteFile(ctx, connect.NewRequest(&filesystem.WriteFileRequest{
+		Path:                 path,
+		Content:              []byte("v3"),
+		ExpectedModifiedTime: timestamppb.New(modTime),
+	}))
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "v3", string(content))
+
+	// No temporary files are left behind.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestWriteFile_KeepsModeAndSymlink(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	u, err := user.Current()
+	require.NoError(t, err)
+
+	target := filepath.Join(root, "run.sh")
+	link := filepath.Join(root, "link.sh")
+	require.NoError(t, os.WriteFile(target, []byte("old"), 0o755))
+	require.NoError(t, os.Symlink(target, link))
+
+	svc := Service{}
+	ctx := authn.SetInfo(t.Context(), u)
+
+	_, err = svc.WriteFile(ctx, connect.NewRequest(&filesystem.WriteFileRequest{
+		Path:    link,
+		Content: []byte("new"),
+	}))
+	require.NoError(t, err)
+
+	linkTarget, err := os.Readlink(link)
+	require.NoError(t, err)
+	assert.Equal(t, target, linkTarget)
+
+	content, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(content))
+
+	info, err := os.Stat(target)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	_, err = svc.WriteFile(ctx, connect.NewRequest(&filesystem.WriteFileRequest{
+		Path:    target,
+		Content: []byte("newer"),
+		Mode:    proto.Uint32(0o600),
+	}))
+	require.NoError(t, err)
+
+	info, err = os.Stat(target)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
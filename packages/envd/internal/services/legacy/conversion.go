@@ -19,11 +19,23 @@ func convertEntryInfo(info *filesystem.EntryInfo) *EntryInfo {
 
 	return &EntryInfo{
 		Name: info.GetName(),
-		Type: FileType(info.GetType()),
+		Type: convertFileType(info.GetType()),
 		Path: info.GetPath(),
 	}
 }
 
+// convertFileType maps the file types added after the legacy API to unspecified, the old clients don't know them.
+func convertFileType(fileType filesystem.FileType) FileType {
+	switch fileType {
+	case filesystem.FileType_FILE_TYPE_FILE:
+		return FileType_FILE_TYPE_FILE
+	case filesystem.FileType_FILE_TYPE_DIRECTORY:
+		return FileType_FILE_TYPE_DIRECTORY
+	default:
+		return FileType_FILE_TYPE_UNSPECIFIED
+	}
+}
+
 var (
 	protoConverters = make(map[reflect.Type]func(protoreflect.ProtoMessage) (connect.AnyResponse, error))
 	anyConverters   = make(map[reflect.Type]func(any) any)
@@ -95,6 +95,23 @@ func TestConversion(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name: "MoveResponse with a file type unknown to the legacy API",
+			input: connect.NewResponse(&filesystem.MoveResponse{
+				Entry: &filesystem.EntryInfo{
+					Name: "socket",
+					Type: filesystem.FileType_FILE_TYPE_SOCKET,
+					Path: "/test/socket",
+				},
+			}),
+			expected: connect.NewResponse(&MoveResponse{
+				Entry: &EntryInfo{
+					Name: "socket",
+					Type: FileType_FILE_TYPE_UNSPECIFIED,
+					Path: "/test/socket",
+				},
+			}),
+		},
 		{
 			name:     "MoveResponse with nil fields",
 			input:    connect.NewResponse(&filesystem.MoveResponse{}),
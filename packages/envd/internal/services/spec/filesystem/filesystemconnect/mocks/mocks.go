@@ -39,6 +39,210 @@ func (_m *MockFilesystemHandler) EXPECT() *MockFilesystemHandler_Expecter {
 	return &MockFilesystemHandler_Expecter{mock: &_m.Mock}
 }
 
+// Chmod provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) Chmod(context1 context.Context, request *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Chmod")
+	}
+
+	var r0 *connect.Response[filesystem.ChmodResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.ChmodRequest]) *connect.Response[filesystem.ChmodResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.ChmodResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.ChmodRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_Chmod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Chmod'
+type MockFilesystemHandler_Chmod_Call struct {
+	*mock.Call
+}
+
+// Chmod is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.ChmodRequest]
+func (_e *MockFilesystemHandler_Expecter) Chmod(context1 interface{}, request interface{}) *MockFilesystemHandler_Chmod_Call {
+	return &MockFilesystemHandler_Chmod_Call{Call: _e.mock.On("Chmod", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_Chmod_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.ChmodRequest])) *MockFilesystemHandler_Chmod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.ChmodRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.ChmodRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Chmod_Call) Return(response *connect.Response[filesystem.ChmodResponse], err error) *MockFilesystemHandler_Chmod_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Chmod_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error)) *MockFilesystemHandler_Chmod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Chown provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) Chown(context1 context.Context, request *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Chown")
+	}
+
+	var r0 *connect.Response[filesystem.ChownResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.ChownRequest]) *connect.Response[filesystem.ChownResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.ChownResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.ChownRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_Chown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Chown'
+type MockFilesystemHandler_Chown_Call struct {
+	*mock.Call
+}
+
+// Chown is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.ChownRequest]
+func (_e *MockFilesystemHandler_Expecter) Chown(context1 interface{}, request interface{}) *MockFilesystemHandler_Chown_Call {
+	return &MockFilesystemHandler_Chown_Call{Call: _e.mock.On("Chown", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_Chown_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.ChownRequest])) *MockFilesystemHandler_Chown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.ChownRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.ChownRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Chown_Call) Return(response *connect.Response[filesystem.ChownResponse], err error) *MockFilesystemHandler_Chown_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Chown_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error)) *MockFilesystemHandler_Chown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Copy provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) Copy(context1 context.Context, request *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Copy")
+	}
+
+	var r0 *connect.Response[filesystem.CopyResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.CopyRequest]) *connect.Response[filesystem.CopyResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.CopyResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.CopyRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_Copy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Copy'
+type MockFilesystemHandler_Copy_Call struct {
+	*mock.Call
+}
+
+// Copy is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.CopyRequest]
+func (_e *MockFilesystemHandler_Expecter) Copy(context1 interface{}, request interface{}) *MockFilesystemHandler_Copy_Call {
+	return &MockFilesystemHandler_Copy_Call{Call: _e.mock.On("Copy", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_Copy_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.CopyRequest])) *MockFilesystemHandler_Copy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.CopyRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.CopyRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Copy_Call) Return(response *connect.Response[filesystem.CopyResponse], err error) *MockFilesystemHandler_Copy_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Copy_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error)) *MockFilesystemHandler_Copy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWatcher provides a mock function for the type MockFilesystemHandler
 func (_mock *MockFilesystemHandler) CreateWatcher(context1 context.Context, request *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error) {
 	ret := _mock.Called(context1, request)
@@ -515,6 +719,69 @@ func (_c *MockFilesystemHandler_RemoveWatcher_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// Search provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) Search(context1 context.Context, request *connect.Request[filesystem.SearchRequest], serverStream *connect.ServerStream[filesystem.SearchResponse]) error {
+	ret := _mock.Called(context1, request, serverStream)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.SearchRequest], *connect.ServerStream[filesystem.SearchResponse]) error); ok {
+		r0 = returnFunc(context1, request, serverStream)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFilesystemHandler_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockFilesystemHandler_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.SearchRequest]
+//   - serverStream *connect.ServerStream[filesystem.SearchResponse]
+func (_e *MockFilesystemHandler_Expecter) Search(context1 interface{}, request interface{}, serverStream interface{}) *MockFilesystemHandler_Search_Call {
+	return &MockFilesystemHandler_Search_Call{Call: _e.mock.On("Search", context1, request, serverStream)}
+}
+
+func (_c *MockFilesystemHandler_Search_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.SearchRequest], serverStream *connect.ServerStream[filesystem.SearchResponse])) *MockFilesystemHandler_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.SearchRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.SearchRequest])
+		}
+		var arg2 *connect.ServerStream[filesystem.SearchResponse]
+		if args[2] != nil {
+			arg2 = args[2].(*connect.ServerStream[filesystem.SearchResponse])
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Search_Call) Return(err error) *MockFilesystemHandler_Search_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Search_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.SearchRequest], serverStream *connect.ServerStream[filesystem.SearchResponse]) error) *MockFilesystemHandler_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Stat provides a mock function for the type MockFilesystemHandler
 func (_mock *MockFilesystemHandler) Stat(context1 context.Context, request *connect.Request[filesystem.StatRequest]) (*connect.Response[filesystem.StatResponse], error) {
 	ret := _mock.Called(context1, request)
@@ -583,6 +850,74 @@ func (_c *MockFilesystemHandler_Stat_Call) RunAndReturn(run func(context1 contex
 	return _c
 }
 
+// Symlink provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) Symlink(context1 context.Context, request *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Symlink")
+	}
+
+	var r0 *connect.Response[filesystem.SymlinkResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.SymlinkRequest]) *connect.Response[filesystem.SymlinkResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.SymlinkResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.SymlinkRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_Symlink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Symlink'
+type MockFilesystemHandler_Symlink_Call struct {
+	*mock.Call
+}
+
+// Symlink is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.SymlinkRequest]
+func (_e *MockFilesystemHandler_Expecter) Symlink(context1 interface{}, request interface{}) *MockFilesystemHandler_Symlink_Call {
+	return &MockFilesystemHandler_Symlink_Call{Call: _e.mock.On("Symlink", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_Symlink_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.SymlinkRequest])) *MockFilesystemHandler_Symlink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.SymlinkRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.SymlinkRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Symlink_Call) Return(response *connect.Response[filesystem.SymlinkResponse], err error) *MockFilesystemHandler_Symlink_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_Symlink_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error)) *MockFilesystemHandler_Symlink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchDir provides a mock function for the type MockFilesystemHandler
 func (_mock *MockFilesystemHandler) WatchDir(context1 context.Context, request *connect.Request[filesystem.WatchDirRequest], serverStream *connect.ServerStream[filesystem.WatchDirResponse]) error {
 	ret := _mock.Called(context1, request, serverStream)
@@ -645,3 +980,71 @@ func (_c *MockFilesystemHandler_WatchDir_Call) RunAndReturn(run func(context1 co
 	_c.Call.Return(run)
 	return _c
 }
+
+// WriteFile provides a mock function for the type MockFilesystemHandler
+func (_mock *MockFilesystemHandler) WriteFile(context1 context.Context, request *connect.Request[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error) {
+	ret := _mock.Called(context1, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteFile")
+	}
+
+	var r0 *connect.Response[filesystem.WriteFileResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error)); ok {
+		return returnFunc(context1, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *connect.Request[filesystem.WriteFileRequest]) *connect.Response[filesystem.WriteFileResponse]); ok {
+		r0 = returnFunc(context1, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[filesystem.WriteFileResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *connect.Request[filesystem.WriteFileRequest]) error); ok {
+		r1 = returnFunc(context1, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFilesystemHandler_WriteFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteFile'
+type MockFilesystemHandler_WriteFile_Call struct {
+	*mock.Call
+}
+
+// WriteFile is a helper method to define mock.On call
+//   - context1 context.Context
+//   - request *connect.Request[filesystem.WriteFileRequest]
+func (_e *MockFilesystemHandler_Expecter) WriteFile(context1 interface{}, request interface{}) *MockFilesystemHandler_WriteFile_Call {
+	return &MockFilesystemHandler_WriteFile_Call{Call: _e.mock.On("WriteFile", context1, request)}
+}
+
+func (_c *MockFilesystemHandler_WriteFile_Call) Run(run func(context1 context.Context, request *connect.Request[filesystem.WriteFileRequest])) *MockFilesystemHandler_WriteFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *connect.Request[filesystem.WriteFileRequest]
+		if args[1] != nil {
+			arg1 = args[1].(*connect.Request[filesystem.WriteFileRequest])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFilesystemHandler_WriteFile_Call) Return(response *connect.Response[filesystem.WriteFileResponse], err error) *MockFilesystemHandler_WriteFile_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockFilesystemHandler_WriteFile_Call) RunAndReturn(run func(context1 context.Context, request *connect.Request[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error)) *MockFilesystemHandler_WriteFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
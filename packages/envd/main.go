@@ -38,7 +38,7 @@ const (
 )
 
 var (
-	Version = "0.3.4"
+	Version = "0.3.9"
 
 	commitSHA string
 
@@ -83,7 +83,7 @@ func buildTemplate(
 	// to propagate information about sandbox routing.
 	sandboxes := smap.New[*sandbox.Sandbox]()
 
-	sandboxProxy, err := proxy.NewSandboxProxy(noop.MeterProvider{}, proxyPort, sandboxes)
+	sandboxProxy, err := proxy.NewSandboxProxy(noop.MeterProvider{}, proxyPort, sandboxes, nil)
 	if err != nil {
 		logger.Fatal("failed to create sandbox proxy", zap.Error(err))
 	}
@@ -168,7 +168,7 @@ func buildTemplate(
 		zap.L().Fatal("failed to create build metrics", zap.Error(err))
 	}
 
-	sandboxFactory := sandbox.NewFactory(networkPool, devicePool, featureFlags, true)
+	sandboxFactory := sandbox.NewFactory(networkPool, devicePool, featureFlags, true, nil)
 
 	builder := build.NewBuilder(
 		logger,
@@ -181,6 +181,7 @@ func buildTemplate(
 		sandboxes,
 		templateCache,
 		buildMetrics,
+		"local",
 	)
 
 	logger = logger.
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/oci/auth"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/export"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	registryPasswordEnv   = "REGISTRY_PASSWORD"
+	awsSecretAccessKeyEnv = "AWS_SECRET_ACCESS_KEY"
+)
+
+// Exports the template build as an OCI image, so it can be run locally in Docker or shared outside the platform.
+// The image is either written as a tarball loadable by `docker load` or pushed to the registry.
+// It must be run as root, because the rootfs is mounted to archive its files.
+// The registry secrets are read from the environment variables or stdin, so they don't leak through the process list or shell history.
+func main() {
+	buildID := flag.String("build", "", "build id")
+	imageRef := flag.String("image", "", "image reference, e.g. docker.io/user/template:latest")
+	output := flag.String("output", "", "path of the image tarball, the image is pushed to the registry when not set")
+	workDir := flag.String("workdir", os.TempDir(), "directory for the reconstructed rootfs and the image layer")
+
+	username := flag.String("registry-username", "", "username for the registry")
+	passwordStdin := flag.Bool("registry-password-stdin", false, "read the password for the registry from stdin instead of the "+registryPasswordEnv+" environment variable")
+	awsAccessKeyID := flag.String("aws-access-key-id", "", "AWS access key ID for the ECR registry, the secret access key is read from the "+awsSecretAccessKeyEnv+" environment variable")
+	awsRegion := flag.String("aws-region", "", "AWS region of the ECR registry")
+	gcpServiceAccount := flag.String("gcp-service-account", "", "path of the GCP service account JSON for the Artifact Registry")
+
+	flag.Parse()
+
+	if *buildID == "" || *imageRef == "" {
+		log.Fatal("build and image must be set")
+	}
+
+	password := os.Getenv(registryPasswordEnv)
+	if *passwordStdin {
+		stdinPassword, err := readPassword(os.Stdin)
+		if err != nil {
+			log.Fatalf("error reading the registry password: %s", err)
+		}
+
+		password = stdinPassword
+	}
+
+	registry, err := registryConfig(*username, password, *awsAccessKeyID, os.Getenv(awsSecretAccessKeyEnv), *awsRegion, *gcpServiceAccount)
+	if err != nil {
+		log.Fatalf("invalid registry credentials: %s", err)
+	}
+
+	err = exportTemplate(context.Background(), *buildID, *imageRef, *output, *workDir, registry)
+	if err != nil {
+		log.Fatalf("error exporting template: %s", err)
+	}
+}
+
+func exportTemplate(
+	ctx context.Context,
+	buildID string,
+	imageRef string,
+	output string,
+	workDir string,
+	registry *templatemanager.FromImageRegistry,
+) error {
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		return fmt.Errorf("could not create logger: %w", err)
+	}
+
+	ref, err := name.ParseReference(imageRef)
+	if err != nil {
+		return fmt.Errorf("invalid image reference: %w", err)
+	}
+
+	persistence, err := storage.GetTemplateStorageProvider(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get storage provider: %w", err)
+	}
+
+	exportDir, err := os.MkdirTemp(workDir, "export-template")
+	if err != nil {
+		return fmt.Errorf("failed to create work directory: %w", err)
+	}
+	defer os.RemoveAll(exportDir)
+
+	img, err := export.Image(ctx, logger, persistence, buildID, exportDir)
+	if err != nil {
+		return err
+	}
+
+	if output != "" {
+		tag, ok := ref.(name.Tag)
+		if !ok {
+			return errors.New("the image reference must be a tag when writing the tarball")
+		}
+
+		logger.Info("Writing image tarball", zap.String("path", output))
+		err = tarball.WriteToFile(output, tag, img)
+		if err != nil {
+			return fmt.Errorf("failed to write image tarball: %w", err)
+		}
+
+		return nil
+	}
+
+	opts := []remote.Option{remote.WithContext(ctx)}
+	if authProvider := auth.NewAuthProvider(registry); authProvider != nil {
+		authOption, err := authProvider.GetAuthOption(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get registry auth: %w", err)
+		}
+
+		opts = append(opts, authOption)
+	}
+
+	logger.Info("Pushing image", zap.String("image", ref.String()))
+	err = remote.Write(ref, img, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to push image: %w", err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return fmt.Errorf("failed to get image digest: %w", err)
+	}
+
+	fmt.Printf("%s@%s\n", ref.Context().String(), digest.String())
+
+	return nil
+}
+
+// readPassword reads the password from the first line of the input.
+func readPassword(r io.Reader) (string, error) {
+	password, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return strings.TrimRight(password, "\r\n"), nil
+}
+
+// registryConfig returns the registry credentials the same way as they are configured for the template base image.
+func registryConfig(username, password, awsAccessKeyID, awsSecretAccessKey, awsRegion, gcpServiceAccount string) (*templatemanager.FromImageRegistry, error) {
+	switch {
+	case username != "":
+		return &templatemanager.FromImageRegistry{
+			Type: &templatemanager.FromImageRegistry_General{General: &templatemanager.GeneralRegistry{
+				Username: username,
+				Password: password,
+			}},
+		}, nil
+	case awsAccessKeyID != "":
+		if awsSecretAccessKey == "" || awsRegion == "" {
+			return nil, errors.New("AWS secret access key and region must be set with the access key ID")
+		}
+
+		return &templatemanager.FromImageRegistry{
+			Type: &templatemanager.FromImageRegistry_Aws{Aws: &templatemanager.AWSRegistry{
+				AwsAccessKeyId:     awsAccessKeyID,
+				AwsSecretAccessKey: awsSecretAccessKey,
+				AwsRegion:          awsRegion,
+			}},
+		}, nil
+	case gcpServiceAccount != "":
+		serviceAccountJSON, err := os.ReadFile(gcpServiceAccount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read GCP service account: %w", err)
+		}
+
+		return &templatemanager.FromImageRegistry{
+			Type: &templatemanager.FromImageRegistry_Gcp{Gcp: &templatemanager.GCPRegistry{
+				ServiceAccountJson: string(serviceAccountJSON),
+			}},
+		}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -49,6 +49,8 @@ func New(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider
 	ignoredLoggingRoutes := logger.WithoutRoutes(
 		logger.HealthCheckRoute,
 		"/TemplateService/TemplateBuildStatus",
+		// The request contains the build secrets
+		"/TemplateService/TemplateCreate",
 		"/TemplateService/HealthStatus",
 		"/InfoService/ServiceInfo",
 	)
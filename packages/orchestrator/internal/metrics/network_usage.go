@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
+	"github.com/e2b-dev/infra/packages/clickhouse/pkg/batcher"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const networkUsageReportInterval = 30 * time.Second
+
+type networkUsageEntry struct {
+	sandboxID   string
+	executionID string
+	teamID      uuid.UUID
+	// usage returns the current traffic counters of the sandbox slot.
+	usage func() (network.Usage, error)
+	// reported is the slot usage already written to ClickHouse.
+	reported network.Usage
+}
+
+// NetworkUsageReporter periodically samples the slot traffic counters of the sandboxes
+// and writes the traffic since the previous sample to ClickHouse.
+type NetworkUsageReporter struct {
+	batcher batcher.ClickhouseInsertBatcher[clickhouse.SandboxNetworkUsage]
+
+	mu sync.Mutex
+	// entries are keyed by the sandbox execution ID, so a resumed sandbox is a new entry.
+	entries map[string]*networkUsageEntry
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewNetworkUsageReporter(batcher batcher.ClickhouseInsertBatcher[clickhouse.SandboxNetworkUsage]) *NetworkUsageReporter {
+	r := &NetworkUsageReporter{
+		batcher: batcher,
+		entries: make(map[string]*networkUsageEntry),
+		done:    make(chan struct{}),
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		r.run()
+	}()
+
+	return r
+}
+
+func (r *NetworkUsageReporter) run() {
+	ticker := time.NewTicker(networkUsageReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			r.reportAll()
+		}
+	}
+}
+
+func (r *NetworkUsageReporter) reportAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, entry := range r.entries {
+		r.report(entry)
+	}
+}
+
+// Add starts reporting the network usage of the sandbox.
+func (r *NetworkUsageReporter) Add(sbx *sandbox.Sandbox) {
+	teamID, err := uuid.Parse(sbx.Runtime.TeamID)
+	if err != nil {
+		zap.L().Error("error parsing team ID, not reporting sandbox network usage", logger.WithSandboxID(sbx.Runtime.SandboxID), zap.String("team_id", sbx.Runtime.TeamID), zap.Error(err))
+
+		return
+	}
+
+	r.add(&networkUsageEntry{
+		sandboxID:   sbx.Runtime.SandboxID,
+		executionID: sbx.Runtime.ExecutionID,
+		teamID:      teamID,
+		usage:       sbx.Slot.Usage,
+	})
+}
+
+func (r *NetworkUsageReporter) add(entry *networkUsageEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[entry.executionID] = entry
+}
+
+// Remove reports the remaining network usage of the sandbox and stops reporting it.
+// It must be called before the sandbox is closed, the slot counters are reset when the slot is returned to the pool.
+func (r *NetworkUsageReporter) Remove(sbx *sandbox.Sandbox) {
+	r.remove(sbx.Runtime.ExecutionID)
+}
+
+func (r *NetworkUsageReporter) remove(executionID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[executionID]
+	if !ok {
+		return
+	}
+
+	r.report(entry)
+	delete(r.entries, executionID)
+}
+
+func (r *NetworkUsageReporter) report(entry *networkUsageEntry) {
+	usage, err := entry.usage()
+	if err != nil {
+		zap.L().Warn("failed to get sandbox network usage", logger.WithSandboxID(entry.sandboxID), zap.Error(err))
+
+		return
+	}
+
+	ingress := usage.IngressBytes - min(usage.IngressBytes, entry.reported.IngressBytes)
+	egress := usage.EgressBytes - min(usage.EgressBytes, entry.reported.EgressBytes)
+	if ingress == 0 && egress == 0 {
+		return
+	}
+
+	err = r.batcher.Push(clickhouse.SandboxNetworkUsage{
+		Timestamp:          time.Now().UTC(),
+		SandboxID:          entry.sandboxID,
+		SandboxExecutionID: entry.executionID,
+		TeamID:             entry.teamID,
+		IngressBytes:       ingress,
+		EgressBytes:        egress,
+	})
+	if err != nil {
+		// The usage is kept unreported, so it is included in the next sample.
+		zap.L().Error("error pushing sandbox network usage", logger.WithSandboxID(entry.sandboxID), zap.Error(err))
+
+		return
+	}
+
+	entry.reported = usage
+}
+
+// Close stops the periodic reporting, the batcher is closed separately.
+func (r *NetworkUsageReporter) Close(_ context.Context) error {
+	close(r.done)
+	r.wg.Wait()
+
+	return nil
+}
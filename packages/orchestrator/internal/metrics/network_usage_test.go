@@ -0,0 +1,159 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+)
+
+type recordingBatcher struct {
+	pushed []clickhouse.SandboxNetworkUsage
+	err    error
+}
+
+func (b *recordingBatcher) Push(usage clickhouse.SandboxNetworkUsage) error {
+	if b.err != nil {
+		return b.err
+	}
+
+	b.pushed = append(b.pushed, usage)
+
+	return nil
+}
+
+func (b *recordingBatcher) Close(context.Context) error {
+	return nil
+}
+
+type fakeSlotUsage struct {
+	usage network.Usage
+	err   error
+}
+
+func (f *fakeSlotUsage) get() (network.Usage, error) {
+	return f.usage, f.err
+}
+
+func newTestReporter(t *testing.T, b *recordingBatcher) *NetworkUsageReporter {
+	t.Helper()
+
+	r := NewNetworkUsageReporter(b)
+	t.Cleanup(func() {
+		r.Close(context.Background())
+	})
+
+	return r
+}
+
+func TestNetworkUsageReporter_ReportsDeltas(t *testing.T) {
+	b := &recordingBatcher{}
+	r := newTestReporter(t, b)
+
+	teamID := uuid.New()
+	slot := &fakeSlotUsage{usage: network.Usage{IngressBytes: 100, EgressBytes: 10}}
+	r.add(&networkUsageEntry{sandboxID: "sbx", executionID: "exec", teamID: teamID, usage: slot.get})
+
+	r.reportAll()
+	slot.usage = network.Usage{IngressBytes: 150, EgressBytes: 10}
+	r.reportAll()
+
+	require.Len(t, b.pushed, 2)
+	assert.Equal(t, "sbx", b.pushed[0].SandboxID)
+	assert.Equal(t, "exec", b.pushed[0].SandboxExecutionID)
+	assert.Equal(t, teamID, b.pushed[0].TeamID)
+	assert.Equal(t, uint64(100), b.pushed[0].IngressBytes)
+	assert.Equal(t, uint64(10), b.pushed[0].EgressBytes)
+	assert.Equal(t, uint64(50), b.pushed[1].IngressBytes)
+	assert.Equal(t, uint64(0), b.pushed[1].EgressBytes)
+}
+
+func TestNetworkUsageReporter_SkipsSamplesWithoutTraffic(t *testing.T) {
+	b := &recordingBatcher{}
+	r := newTestReporter(t, b)
+
+	slot := &fakeSlotUsage{usage: network.Usage{IngressBytes: 100}}
+	r.add(&networkUsageEntry{sandboxID: "sbx", executionID: "exec", usage: slot.get})
+
+	r.reportAll()
+	r.reportAll()
+
+	require.Len(t, b.pushed, 1)
+}
+
+func TestNetworkUsageReporter_KeepsUsageAfterFailedPush(t *testing.T) {
+	b := &recordingBatcher{err: errors.New("queue full")}
+	r := newTestReporter(t, b)
+
+	slot := &fakeSlotUsage{usage: network.Usage{IngressBytes: 100, EgressBytes: 20}}
+	r.add(&networkUsageEntry{sandboxID: "sbx", executionID: "exec", usage: slot.get})
+
+	r.reportAll()
+	require.Empty(t, b.pushed)
+
+	b.err = nil
+	slot.usage = network.Usage{IngressBytes: 120, EgressBytes: 20}
+	r.reportAll()
+
+	require.Len(t, b.pushed, 1)
+	assert.Equal(t, uint64(120), b.pushed[0].IngressBytes)
+	assert.Equal(t, uint64(20), b.pushed[0].EgressBytes)
+}
+
+func TestNetworkUsageReporter_IgnoresFailedSample(t *testing.T) {
+	b := &recordingBatcher{}
+	r := newTestReporter(t, b)
+
+	slot := &fakeSlotUsage{err: errors.New("firewall is not initialized")}
+	r.add(&networkUsageEntry{sandboxID: "sbx", executionID: "exec", usage: slot.get})
+
+	r.reportAll()
+
+	assert.Empty(t, b.pushed)
+}
+
+func TestNetworkUsageReporter_RemoveReportsRemainingUsage(t *testing.T) {
+	b := &recordingBatcher{}
+	r := newTestReporter(t, b)
+
+	slot := &fakeSlotUsage{usage: network.Usage{IngressBytes: 100}}
+	r.add(&networkUsageEntry{sandboxID: "sbx", executionID: "exec", usage: slot.get})
+
+	r.reportAll()
+	slot.usage = network.Usage{IngressBytes: 130, EgressBytes: 5}
+	r.remove("exec")
+
+	require.Len(t, b.pushed, 2)
+	assert.Equal(t, uint64(30), b.pushed[1].IngressBytes)
+	assert.Equal(t, uint64(5), b.pushed[1].EgressBytes)
+
+	// The removed sandbox is not reported anymore.
+	slot.usage = network.Usage{IngressBytes: 200, EgressBytes: 5}
+	r.reportAll()
+	r.remove("exec")
+
+	assert.Len(t, b.pushed, 2)
+}
+
+func TestNetworkUsageReporter_ResetCounters(t *testing.T) {
+	b := &recordingBatcher{}
+	r := newTestReporter(t, b)
+
+	slot := &fakeSlotUsage{usage: network.Usage{IngressBytes: 100, EgressBytes: 100}}
+	r.add(&networkUsageEntry{sandboxID: "sbx", executionID: "exec", usage: slot.get})
+
+	r.reportAll()
+	// Counters lower than the reported usage don't produce a negative delta.
+	slot.usage = network.Usage{IngressBytes: 50, EgressBytes: 150}
+	r.reportAll()
+
+	require.Len(t, b.pushed, 2)
+	assert.Equal(t, uint64(0), b.pushed[1].IngressBytes)
+	assert.Equal(t, uint64(50), b.pushed[1].EgressBytes)
+}
@@ -60,6 +60,9 @@ type SandboxObserver struct {
 	memoryUsed  metric.Int64ObservableGauge
 	diskTotal   metric.Int64ObservableGauge
 	diskUsed    metric.Int64ObservableGauge
+
+	networkIngress metric.Int64ObservableGauge
+	networkEgress  metric.Int64ObservableGauge
 }
 
 func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit, serviceVersion, serviceInstanceID string, sandboxes *smap.Map[*sandbox.Sandbox]) (*SandboxObserver, error) {
@@ -118,6 +121,16 @@ func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit,
 		return nil, fmt.Errorf("failed to create disk used gauge: %w", err)
 	}
 
+	networkIngress, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkIngressGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network ingress gauge: %w", err)
+	}
+
+	networkEgress, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkEgressGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network egress gauge: %w", err)
+	}
+
 	so := &SandboxObserver{
 		exportInterval: sandboxMetricExportPeriod,
 		meterExporter:  externalMeterExporter,
@@ -129,6 +142,8 @@ func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit,
 		memoryUsed:     memoryUsed,
 		diskTotal:      diskTotal,
 		diskUsed:       diskUsed,
+		networkIngress: networkIngress,
+		networkEgress:  networkEgress,
 	}
 
 	registration, err := so.startObserving()
@@ -237,6 +252,15 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 						o.ObserveInt64(so.diskUsed, sbxMetrics.DiskUsed, attributes)
 					}
 
+					// The network traffic is counted by the slot firewall, not reported by envd
+					usage, err := sbx.Slot.Usage()
+					if err != nil {
+						zap.L().Warn("Failed to get sandbox network usage", zap.Error(err), logger.WithSandboxID(sbx.Runtime.SandboxID))
+					} else {
+						o.ObserveInt64(so.networkIngress, int64(usage.IngressBytes), attributes)
+						o.ObserveInt64(so.networkEgress, int64(usage.EgressBytes), attributes)
+					}
+
 					// Log warnings if memory or CPU usage exceeds thresholds
 					// Round percentage to 2 decimal places
 					memUsedPct := float32(math.Floor(float64(memoryUsed)/float64(memoryTotal)*10000) / 100)
@@ -264,7 +288,7 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 			}
 
 			return nil
-		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed, so.diskTotal, so.diskUsed)
+		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed, so.diskTotal, so.diskUsed, so.networkIngress, so.networkEgress)
 	if err != nil {
 		return nil, err
 	}
@@ -29,21 +29,60 @@ type SandboxProxy struct {
 	proxy *reverseproxy.Proxy
 }
 
-func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *smap.Map[*sandbox.Sandbox]) (*SandboxProxy, error) {
+// NewSandboxProxy creates the proxy to the sandbox ports,
+// the team network secret verifies the tunnels from the sandboxes of the team networks.
+func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *smap.Map[*sandbox.Sandbox], teamNetworkSecret []byte) (*SandboxProxy, error) {
 	proxy := reverseproxy.New(
 		port,
 		idleTimeout,
 		func(r *http.Request) (*pool.Destination, error) {
-			sandboxId, port, err := reverseproxy.ParseHost(r.Host)
+			// The route resolved by the client proxy takes precedence, the path-based routes are resolved for the direct requests.
+			route, err := reverseproxy.ParseRouteHeaders(r.Header)
 			if err != nil {
 				return nil, err
 			}
 
+			if route == nil {
+				route, err = reverseproxy.ParseRequest(r, nil)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			sandboxId, port := route.SandboxID, route.Port
+
 			sbx, found := sandboxes.Get(sandboxId)
 			if !found {
 				return nil, reverseproxy.NewErrSandboxNotFound(sandboxId)
 			}
 
+			policy := sbx.PortPolicy()
+			authorize := policy.Authorize
+			if reverseproxy.TunnelProtocol(r) != "" {
+				authorize = policy.AuthorizeTunnel
+			}
+
+			// The connections from the team network only need the sandbox to be in the same team network.
+			teamNetwork, err := reverseproxy.ParseTeamNetworkHeaders(r.Header, sandboxId, port, teamNetworkSecret)
+			if err != nil {
+				zap.L().Warn("rejected team network request", logger.WithSandboxID(sandboxId), zap.Uint64("port", port), zap.Error(err))
+
+				return nil, reverseproxy.NewErrPortAccessDenied(sandboxId, port, false)
+			}
+
+			if teamNetwork != nil {
+				authorize = func(r *http.Request, sandboxID string, port uint64) error {
+					return authorizeTeamNetwork(r, sbx, *teamNetwork, port)
+				}
+			}
+
+			if err := authorize(r, sandboxId, port); err != nil {
+				return nil, err
+			}
+
+			// The access token and the team API key are only used to access the port, they're not passed to the sandbox.
+			policy.RemoveCredentials(r, port)
+
 			url := &url.URL{
 				Scheme: "http",
 				Host:   fmt.Sprintf("%s:%d", sbx.Slot.HostIPString(), port),
@@ -53,7 +92,9 @@ func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *s
 				Url:                                url,
 				SandboxId:                          sbx.Runtime.SandboxID,
 				SandboxPort:                        port,
+				PathPrefix:                         route.PathPrefix,
 				DefaultToPortError:                 true,
+				TerminateTunnels:                   true,
 				IncludeSandboxIdInProxyErrorLogger: true,
 				// We need to include id unique to sandbox to prevent reuse of connection to the same IP:port pair by different sandboxes reusing the network slot.
 				// We are not using sandbox id to prevent removing connections based on sandbox id (pause/resume race condition).
@@ -127,3 +168,23 @@ func (p *SandboxProxy) RemoveFromPool(connectionKey string) {
 func (p *SandboxProxy) GetAddr() string {
 	return p.proxy.Addr
 }
+
+// authorizeTeamNetwork checks if the TCP tunnel from the team network can access the sandbox port.
+// The blocked ports stay blocked, the other ports don't require any credentials.
+func authorizeTeamNetwork(r *http.Request, sbx *sandbox.Sandbox, network reverseproxy.TeamNetwork, port uint64) error {
+	sandboxID := sbx.Runtime.SandboxID
+
+	if reverseproxy.TunnelProtocol(r) != reverseproxy.TunnelProtocolTCP {
+		return reverseproxy.NewErrPortAccessDenied(sandboxID, port, false)
+	}
+
+	if sbx.Config.TeamNetwork == nil || !sbx.Config.TeamNetwork.Equal(network) {
+		return reverseproxy.NewErrPortAccessDenied(sandboxID, port, false)
+	}
+
+	if sbx.PortPolicy().Visibility(port) == reverseproxy.PortVisibilityBlocked {
+		return reverseproxy.NewErrPortAccessDenied(sandboxID, port, true)
+	}
+
+	return nil
+}
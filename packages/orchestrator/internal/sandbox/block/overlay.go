@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"sync/atomic"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
@@ -56,6 +57,15 @@ func (o *Overlay) EjectCache() (*Cache, error) {
 	return o.cache, nil
 }
 
+// ExportCache writes the changed blocks to the out without ejecting the cache, so the overlay can be used after.
+func (o *Overlay) ExportCache(out io.Writer) (*header.DiffMetadata, error) {
+	if o.cacheEjected.Load() {
+		return nil, fmt.Errorf("cache already ejected")
+	}
+
+	return o.cache.ExportToDiff(out)
+}
+
 // This method will not be very optimal if the length is not the same as the block size, because we cannot be just exposing the cache slice,
 // but creating and copying the bytes from the cache and device to the new slice.
 //
@@ -22,10 +22,17 @@ type TrackedSliceDevice struct {
 }
 
 func NewTrackedSliceDevice(blockSize int64, device ReadonlyDevice) (*TrackedSliceDevice, error) {
+	size, err := device.Size()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device size: %w", err)
+	}
+
 	return &TrackedSliceDevice{
 		data:      device,
 		empty:     make([]byte, blockSize),
 		blockSize: blockSize,
+		// Until Disable, the blocks are marked as dirty when they are loaded.
+		dirty: bitset.New(uint(header.TotalBlocks(size, blockSize))),
 	}, nil
 }
 
@@ -53,14 +60,29 @@ func (t *TrackedSliceDevice) Slice(ctx context.Context, off int64, length int64)
 		return t.empty, nil
 	}
 
+	t.dirtyMu.Lock()
+	for i := header.BlockIdx(off, t.blockSize); i <= header.BlockIdx(off+length-1, t.blockSize); i++ {
+		t.dirty.Set(uint(i))
+	}
+	t.dirtyMu.Unlock()
+
 	return t.data.Slice(ctx, off, length)
 }
 
 // Return which bytes were not read since Disable.
 // This effectively returns the bytes that have been requested after paused vm and are not dirty.
+// Before Disable, it returns the bytes that were loaded, only these can differ from the device.
 func (t *TrackedSliceDevice) Dirty() *bitset.BitSet {
 	t.dirtyMu.Lock()
 	defer t.dirtyMu.Unlock()
 
 	return t.dirty.Clone()
 }
+
+// ResetDirty replaces the dirty blocks, it's used to drop the blocks that were loaded without being changed.
+func (t *TrackedSliceDevice) ResetDirty(dirty *bitset.BitSet) {
+	t.dirtyMu.Lock()
+	defer t.dirtyMu.Unlock()
+
+	t.dirty = dirty.Clone()
+}
@@ -27,6 +27,15 @@ func (r *RootfsDiffCreator) process(ctx context.Context, out io.Writer) (*header
 	return r.rootfs.ExportDiff(ctx, out, r.closeHook)
 }
 
+// RootfsSnapshotDiffCreator exports the rootfs diff of the paused sandbox that keeps running after the snapshot.
+type RootfsSnapshotDiffCreator struct {
+	rootfs rootfs.Provider
+}
+
+func (r *RootfsSnapshotDiffCreator) process(ctx context.Context, out io.Writer) (*header.DiffMetadata, error) {
+	return r.rootfs.SnapshotDiff(ctx, out)
+}
+
 type MemoryDiffCreator struct {
 	memfile    *storage.TemporaryMemfile
 	dirtyPages *bitset.BitSet
@@ -463,6 +463,14 @@ func (p *Process) Pause(ctx context.Context) error {
 	return p.client.pauseVM(ctx)
 }
 
+// Unpause resumes the VM paused by Pause.
+func (p *Process) Unpause(ctx context.Context) error {
+	ctx, childSpan := tracer.Start(ctx, "unpause-fc")
+	defer childSpan.End()
+
+	return p.client.resumeVM(ctx)
+}
+
 // CreateSnapshot VM needs to be paused before creating a snapshot.
 func (p *Process) CreateSnapshot(ctx context.Context, snapfilePath string, memfilePath string) error {
 	ctx, childSpan := tracer.Start(ctx, "create-snapshot-fc")
@@ -0,0 +1,179 @@
+package network
+
+import (
+	"errors"
+	"log"
+	"net"
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+const (
+	dnsProxyPort = 53
+	dnsTimeout   = 5 * time.Second
+
+	hostResolvConfPath = "/etc/resolv.conf"
+	// fallbackDNSUpstream is the same resolver that is configured in the sandbox rootfs,
+	// it's used when the host has no resolver configured.
+	fallbackDNSUpstream = "8.8.8.8:53"
+)
+
+// dnsUpstream is the resolver the allowed queries are forwarded to.
+var dnsUpstream = sync.OnceValue(getDNSUpstream)
+
+// dnsProxy answers the DNS queries of the sandbox.
+// The names of the team network are answered by the team network proxy, the other queries are forwarded upstream
+// only when the egress policy allows the domain, all domains are allowed when the policy is nil.
+// The addresses from the answers are passed to onResolved before the answer is returned,
+// so the sandbox can connect to them as soon as it receives the answer.
+type dnsProxy struct {
+	policy     *EgressPolicy
+	team       *teamNetworkProxy
+	onResolved func(proxy *dnsProxy, addrs []netip.Addr)
+
+	// allowed tracks the addresses already passed to onResolved.
+	allowed map[netip.Addr]struct{}
+
+	packetConn net.PacketConn
+	listener   net.Listener
+}
+
+// newDNSProxy starts the proxy on the provided UDP and TCP sockets.
+// The sockets must be created in the slot network namespace, the upstream queries are made from the host namespace.
+func newDNSProxy(
+	policy *EgressPolicy,
+	team *teamNetworkProxy,
+	packetConn net.PacketConn,
+	listener net.Listener,
+	onResolved func(proxy *dnsProxy, addrs []netip.Addr),
+) *dnsProxy {
+	p := &dnsProxy{
+		policy:     policy,
+		team:       team,
+		onResolved: onResolved,
+		allowed:    make(map[netip.Addr]struct{}),
+		packetConn: packetConn,
+		listener:   listener,
+	}
+
+	for _, srv := range []*dns.Server{
+		{PacketConn: packetConn, Handler: p},
+		{Listener: listener, Handler: p},
+	} {
+		go func() {
+			// The server stops when the socket is closed.
+			err := srv.ActivateAndServe()
+			if err != nil && !errors.Is(err, net.ErrClosed) {
+				zap.L().Error("DNS proxy stopped", zap.Error(err))
+			}
+		}()
+	}
+
+	return p
+}
+
+func (p *dnsProxy) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	if len(r.Question) != 1 {
+		replyDNS(w, r, dns.RcodeNameError)
+
+		return
+	}
+
+	name := r.Question[0].Name
+	if p.team != nil && p.team.handlesName(name) {
+		p.team.ServeDNS(w, r)
+
+		return
+	}
+
+	if p.policy != nil && !p.policy.allowsDomain(name) {
+		replyDNS(w, r, dns.RcodeNameError)
+
+		return
+	}
+
+	network := "udp"
+	if _, ok := w.RemoteAddr().(*net.TCPAddr); ok {
+		network = "tcp"
+	}
+
+	client := &dns.Client{Net: network, Timeout: dnsTimeout}
+	res, _, err := client.Exchange(r, dnsUpstream())
+	if err != nil {
+		zap.L().Debug("DNS proxy upstream query failed", zap.String("name", name), zap.Error(err))
+		replyDNS(w, r, dns.RcodeServerFailure)
+
+		return
+	}
+
+	// Without the egress policy the resolved addresses don't have to be allowed.
+	if p.policy != nil {
+		var addrs []netip.Addr
+		for _, rr := range res.Answer {
+			a, ok := rr.(*dns.A)
+			if !ok {
+				continue
+			}
+
+			addr, ok := netip.AddrFromSlice(a.A.To4())
+			if ok {
+				addrs = append(addrs, addr)
+			}
+		}
+
+		if len(addrs) > 0 {
+			p.onResolved(p, addrs)
+		}
+	}
+
+	if err := w.WriteMsg(res); err != nil {
+		zap.L().Debug("DNS proxy failed to write response", zap.Error(err))
+	}
+}
+
+// Close stops the proxy by closing its sockets.
+func (p *dnsProxy) Close() error {
+	return errors.Join(p.packetConn.Close(), p.listener.Close())
+}
+
+// getDNSUpstream returns the resolver set in SANDBOXES_DNS_UPSTREAM,
+// by default the first nameserver of the host is used as the upstream queries are made from the host namespace.
+func getDNSUpstream() string {
+	if upstream := env.GetEnv("SANDBOXES_DNS_UPSTREAM", ""); upstream != "" {
+		if _, _, err := net.SplitHostPort(upstream); err != nil {
+			upstream = net.JoinHostPort(upstream, strconv.Itoa(dnsProxyPort))
+		}
+
+		log.Printf("Using DNS upstream %s", upstream)
+
+		return upstream
+	}
+
+	config, err := dns.ClientConfigFromFile(hostResolvConfPath)
+	if err != nil || len(config.Servers) == 0 {
+		log.Printf("Failed to read host resolvers from %s, using DNS upstream %s: %v", hostResolvConfPath, fallbackDNSUpstream, err)
+
+		return fallbackDNSUpstream
+	}
+
+	upstream := net.JoinHostPort(config.Servers[0], config.Port)
+	log.Printf("Using host DNS upstream %s", upstream)
+
+	return upstream
+}
+
+func replyDNS(w dns.ResponseWriter, r *dns.Msg, rcode int) {
+	m := new(dns.Msg)
+	m.SetRcode(r, rcode)
+
+	if err := w.WriteMsg(m); err != nil {
+		zap.L().Debug("DNS proxy failed to write response", zap.Error(err))
+	}
+}
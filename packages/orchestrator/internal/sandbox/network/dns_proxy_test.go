@@ -0,0 +1,18 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDNSUpstream(t *testing.T) {
+	t.Setenv("SANDBOXES_DNS_UPSTREAM", "10.0.0.2")
+	assert.Equal(t, "10.0.0.2:53", getDNSUpstream())
+
+	t.Setenv("SANDBOXES_DNS_UPSTREAM", "10.0.0.2:5353")
+	assert.Equal(t, "10.0.0.2:5353", getDNSUpstream())
+
+	t.Setenv("SANDBOXES_DNS_UPSTREAM", "")
+	assert.NotEmpty(t, getDNSUpstream())
+}
@@ -0,0 +1,160 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+const wildcardDomainPrefix = "*."
+
+var blockedPrefixes = mustParsePrefixes(blockedRanges)
+
+// EgressPolicy restricts the traffic leaving the sandbox.
+// Only the traffic to the allowed CIDRs and to the addresses the allowed domains resolve to is let through.
+type EgressPolicy struct {
+	AllowedCIDRs []string
+	// AllowedDomains are resolved by the DNS proxy in the slot network namespace.
+	// A domain prefixed with "*." allows all its subdomains.
+	AllowedDomains []string
+	// AllowedPorts restricts the destination ports, all ports are allowed when empty.
+	AllowedPorts []uint16
+
+	allowedPrefixes []netip.Prefix
+}
+
+// NewEgressPolicy validates and normalizes the egress policy.
+// CIDRs overlapping the internal ranges blocked by the firewall are rejected.
+func NewEgressPolicy(cidrs []string, domains []string, ports []uint32) (*EgressPolicy, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := parsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR '%s': %w", cidr, err)
+		}
+
+		for _, blocked := range blockedPrefixes {
+			if prefix.Overlaps(blocked) {
+				return nil, fmt.Errorf("CIDR '%s' overlaps with the blocked range '%s'", cidr, blocked)
+			}
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	// The firewall sets don't allow overlapping intervals, so the prefixes contained in other prefixes are dropped.
+	slices.SortFunc(prefixes, func(a, b netip.Prefix) int {
+		return a.Bits() - b.Bits()
+	})
+
+	policy := &EgressPolicy{}
+	for _, prefix := range prefixes {
+		if policy.allowsAddr(prefix.Addr()) {
+			continue
+		}
+
+		policy.allowedPrefixes = append(policy.allowedPrefixes, prefix)
+		policy.AllowedCIDRs = append(policy.AllowedCIDRs, prefix.String())
+	}
+
+	for _, domain := range domains {
+		normalized := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+		name := strings.TrimPrefix(normalized, wildcardDomainPrefix)
+		if name == "" || strings.Contains(name, "*") {
+			return nil, fmt.Errorf("invalid domain '%s'", domain)
+		}
+
+		policy.AllowedDomains = append(policy.AllowedDomains, normalized)
+	}
+
+	for _, port := range ports {
+		if port == 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid port %d", port)
+		}
+
+		policy.AllowedPorts = append(policy.AllowedPorts, uint16(port))
+	}
+
+	return policy, nil
+}
+
+// allowsDomain checks if the domain (with or without the trailing dot) is allowed by the policy.
+func (p *EgressPolicy) allowsDomain(name string) bool {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+
+	for _, domain := range p.AllowedDomains {
+		if suffix, ok := strings.CutPrefix(domain, wildcardDomainPrefix); ok {
+			if strings.HasSuffix(name, "."+suffix) {
+				return true
+			}
+
+			continue
+		}
+
+		if name == domain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowsAddr checks if the address is already allowed by the policy CIDRs.
+func (p *EgressPolicy) allowsAddr(addr netip.Addr) bool {
+	for _, prefix := range p.allowedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBlockedAddr checks if the address can never be allowed by the egress policy,
+// so a domain resolving to an internal address doesn't open access to it.
+func isBlockedAddr(addr netip.Addr) bool {
+	if !addr.Is4() || addr.IsLoopback() || addr.IsUnspecified() || addr.IsMulticast() {
+		return true
+	}
+
+	for _, blocked := range blockedPrefixes {
+		if blocked.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parsePrefix(cidr string) (netip.Prefix, error) {
+	if !strings.Contains(cidr, "/") {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		cidr = fmt.Sprintf("%s/%d", addr, addr.BitLen())
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if !prefix.Addr().Is4() {
+		return netip.Prefix{}, errors.New("only IPv4 is supported")
+	}
+
+	return prefix.Masked(), nil
+}
+
+func mustParsePrefixes(cidrs []string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefixes = append(prefixes, netip.MustParsePrefix(cidr))
+	}
+
+	return prefixes
+}
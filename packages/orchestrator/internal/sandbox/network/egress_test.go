@@ -0,0 +1,69 @@
+package network
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEgressPolicy(t *testing.T) {
+	policy, err := NewEgressPolicy(
+		[]string{"1.2.3.0/24", "1.2.3.4", "8.8.8.8/32", "1.0.0.0/8"},
+		[]string{"PyPI.org.", "*.githubusercontent.com"},
+		[]uint32{443, 80},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"1.0.0.0/8", "8.8.8.8/32"}, policy.AllowedCIDRs)
+	assert.Equal(t, []string{"pypi.org", "*.githubusercontent.com"}, policy.AllowedDomains)
+	assert.Equal(t, []uint16{443, 80}, policy.AllowedPorts)
+}
+
+func TestNewEgressPolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidrs   []string
+		domains []string
+		ports   []uint32
+	}{
+		{name: "invalid_cidr", cidrs: []string{"1.2.3.4/33"}},
+		{name: "ipv6_cidr", cidrs: []string{"2001:db8::/32"}},
+		{name: "private_cidr", cidrs: []string{"10.1.0.0/16"}},
+		{name: "all_internet", cidrs: []string{"0.0.0.0/0"}},
+		{name: "empty_domain", domains: []string{""}},
+		{name: "wildcard_only", domains: []string{"*."}},
+		{name: "wildcard_in_middle", domains: []string{"api.*.example.com"}},
+		{name: "zero_port", ports: []uint32{0}},
+		{name: "port_out_of_range", ports: []uint32{65536}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEgressPolicy(tt.cidrs, tt.domains, tt.ports)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEgressPolicy_AllowsDomain(t *testing.T) {
+	policy, err := NewEgressPolicy(nil, []string{"pypi.org", "*.github.com"}, nil)
+	require.NoError(t, err)
+
+	assert.True(t, policy.allowsDomain("pypi.org."))
+	assert.True(t, policy.allowsDomain("PYPI.ORG"))
+	assert.False(t, policy.allowsDomain("files.pypi.org."))
+	assert.True(t, policy.allowsDomain("api.github.com."))
+	assert.True(t, policy.allowsDomain("raw.objects.github.com."))
+	assert.False(t, policy.allowsDomain("github.com."))
+	assert.False(t, policy.allowsDomain("notgithub.com."))
+}
+
+func TestIsBlockedAddr(t *testing.T) {
+	assert.True(t, isBlockedAddr(netip.MustParseAddr("10.11.0.1")))
+	assert.True(t, isBlockedAddr(netip.MustParseAddr("169.254.169.254")))
+	assert.True(t, isBlockedAddr(netip.MustParseAddr("127.0.0.1")))
+	assert.True(t, isBlockedAddr(netip.MustParseAddr("0.0.0.0")))
+	assert.False(t, isBlockedAddr(netip.MustParseAddr("140.82.112.3")))
+}
@@ -5,6 +5,7 @@ import (
 	"net/netip"
 
 	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
 	"github.com/google/nftables/expr"
 	"github.com/ngrok/firewall_toolkit/pkg/expressions"
 	"github.com/ngrok/firewall_toolkit/pkg/rule"
@@ -13,6 +14,8 @@ import (
 
 const (
 	tableName = "slot-firewall"
+
+	allInternetCIDR = "0.0.0.0/0"
 )
 
 var blockedRanges = []string{
@@ -28,7 +31,12 @@ type Firewall struct {
 	chain        *nftables.Chain
 	blockSet     set.Set
 	allowSet     set.Set
+	portSet      set.Set
 	tapInterface string
+
+	rateLimitChain *nftables.Chain
+	ingressCounter *nftables.NamedObj
+	egressCounter  *nftables.NamedObj
 }
 
 func NewFirewall(tapIf string) (*Firewall, error) {
@@ -60,6 +68,10 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new allow set: %w", err)
 	}
+	portSet, err := set.New(conn, table, "filtered_ports", nftables.TypeInetService)
+	if err != nil {
+		return nil, fmt.Errorf("new port set: %w", err)
+	}
 
 	fw := &Firewall{
 		conn:         conn,
@@ -67,6 +79,7 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 		chain:        chain,
 		blockSet:     blockSet,
 		allowSet:     allowSet,
+		portSet:      portSet,
 		tapInterface: tapIf,
 	}
 
@@ -75,11 +88,20 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 		return nil, err
 	}
 
+	fw.installRateLimits()
+
 	// Populate the sets with initial data
 	err = fw.ResetAllCustom()
 	if err != nil {
 		return nil, fmt.Errorf("error while configuring initial block set: %w", err)
 	}
+
+	// Start counting the traffic without any limits
+	err = fw.SetRateLimits(RateLimits{})
+	if err != nil {
+		return nil, fmt.Errorf("error while configuring initial rate limits: %w", err)
+	}
+
 	return fw, nil
 }
 
@@ -100,25 +122,54 @@ func (fw *Firewall) installRules() error {
 		},
 	}
 
-	// Allow ESTABLISHED,RELATED
+	// The rules are evaluated in order:
+	// established connections are always allowed, then the port filter is applied,
+	// and only then the destination address is checked against the allow and block sets.
+
+	// Allow ESTABLISHED,RELATED of all protocols, so the replies to the UDP queries (like DNS) aren't dropped by the port filter
 	exprs, err := rule.Build(
 		expr.VerdictAccept,
-		rule.TransportProtocol(expressions.TCP),
 		rule.LoadConnectionTrackingState(expr.CtKeySTATE),
 		rule.ConnectionTrackingState(expr.CtStateBitRELATED|expr.CtStateBitESTABLISHED),
 	)
 	if err != nil {
 		return fmt.Errorf("build rule for established/related: %w", err)
 	}
-	fw.conn.InsertRule(&nftables.Rule{
+	fw.conn.AddRule(&nftables.Rule{
 		Table: fw.table, Chain: fw.chain,
 		Exprs: append(ifaceMatch,
 			exprs...,
 		),
 	})
 
+	// Drop TCP and UDP traffic to ports not in portSet
+	for _, proto := range []expressions.TransportProto{expressions.TCP, expressions.UDP} {
+		exprs, err := rule.Build(
+			expr.VerdictDrop,
+			rule.TransportProtocol(proto),
+			rule.Any(
+				expressions.DestinationPort(1),
+				&expr.Lookup{
+					SourceRegister: 1,
+					SetName:        fw.portSet.Set().Name,
+					SetID:          fw.portSet.Set().ID,
+					Invert:         true,
+				},
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("build rule for port filtering: %w", err)
+		}
+		fw.conn.AddRule(&nftables.Rule{
+			Table: fw.table, Chain: fw.chain,
+			Exprs: append(ifaceMatch,
+				exprs...,
+			),
+		})
+	}
+
 	// Allow anything in allowSet
-	fw.conn.InsertRule(&nftables.Rule{
+	fw.conn.AddRule(&nftables.Rule{
 		Table: fw.table, Chain: fw.chain,
 		Exprs: append(ifaceMatch,
 			expressions.IPv4DestinationAddress(1),
@@ -147,7 +198,7 @@ func (fw *Firewall) installRules() error {
 // AddBlockedIP adds a single CIDR to the block set at runtime.
 func (fw *Firewall) AddBlockedIP(cidr string) error {
 	// 0.0.0.0/0 is not valid IP per GoLang, so we handle it as a special case
-	if cidr == "0.0.0.0/0" {
+	if cidr == allInternetCIDR {
 		fw.conn.FlushSet(fw.blockSet.Set())
 
 		toAppend := []nftables.SetElement{
@@ -213,6 +264,9 @@ func (fw *Firewall) ResetAllCustom() error {
 	if err := fw.ResetAllowedCustom(); err != nil {
 		return fmt.Errorf("clear allow set: %w", err)
 	}
+	if err := fw.ResetPortsCustom(); err != nil {
+		return fmt.Errorf("clear port set: %w", err)
+	}
 
 	return nil
 }
@@ -232,5 +286,63 @@ func (fw *Firewall) ResetBlockedCustom() error {
 
 // ResetAllowedCustom resets allow set back to original ranges.
 func (fw *Firewall) ResetAllowedCustom() error {
+	fw.conn.FlushSet(fw.allowSet.Set())
+
+	return fw.conn.Flush()
+}
+
+// ResetPortsCustom resets the port set back to allowing all ports.
+func (fw *Firewall) ResetPortsCustom() error {
+	fw.conn.FlushSet(fw.portSet.Set())
+
+	// The interval covering all ports ends at the maximum port, so it doesn't need the interval end element.
+	toAppend := []nftables.SetElement{
+		{Key: binaryutil.BigEndian.PutUint16(0)},
+	}
+
+	if err := fw.conn.SetAddElements(fw.portSet.Set(), toAppend); err != nil {
+		return fmt.Errorf("add elements to port set: %w", err)
+	}
+
+	return fw.conn.Flush()
+}
+
+// SetEgressPolicy blocks all traffic leaving the sandbox except the traffic to the allowed CIDRs on the allowed ports.
+// When no ports are provided, all ports are allowed.
+// Addresses resolved for the allowed domains are added separately via AddAllowedIP.
+func (fw *Firewall) SetEgressPolicy(cidrs []string, ports []uint16) error {
+	// Block everything first, so there is no window in which the previous allow set is applied without the block.
+	if err := fw.AddBlockedIP(allInternetCIDR); err != nil {
+		return fmt.Errorf("block all traffic: %w", err)
+	}
+
+	allowData, err := set.AddressStringsToSetData(cidrs)
+	if err != nil {
+		return fmt.Errorf("parse allowed CIDRs: %w", err)
+	}
+	if len(allowData) == 0 {
+		fw.conn.FlushSet(fw.allowSet.Set())
+	} else if err := fw.allowSet.ClearAndAddElements(fw.conn, allowData); err != nil {
+		return fmt.Errorf("set allowed CIDRs: %w", err)
+	}
+	if err := fw.conn.Flush(); err != nil {
+		return fmt.Errorf("flush allowed CIDRs changes: %w", err)
+	}
+
+	if len(ports) == 0 {
+		return fw.ResetPortsCustom()
+	}
+
+	portData := make([]set.SetData, 0, len(ports))
+	for _, port := range ports {
+		portData = append(portData, set.SetData{Port: port})
+	}
+	if err := fw.portSet.ClearAndAddElements(fw.conn, portData); err != nil {
+		return fmt.Errorf("set allowed ports: %w", err)
+	}
+	if err := fw.conn.Flush(); err != nil {
+		return fmt.Errorf("flush allowed ports changes: %w", err)
+	}
+
 	return nil
 }
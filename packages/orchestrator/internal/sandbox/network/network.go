@@ -232,6 +232,27 @@ func (s *Slot) CreateNetwork() error {
 func (s *Slot) RemoveNetwork() error {
 	var errs []error
 
+	// The redirect rules are removed together with the namespace, only the proxies need to be stopped.
+	s.networkMu.Lock()
+	if s.dns != nil {
+		err := s.dns.Close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error closing DNS proxy: %w", err))
+		}
+
+		s.dns = nil
+	}
+
+	if s.team != nil {
+		err := s.team.Close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error closing team network proxy: %w", err))
+		}
+
+		s.team = nil
+	}
+	s.networkMu.Unlock()
+
 	err := s.CloseFirewall()
 	if err != nil {
 		errs = append(errs, fmt.Errorf("error closing firewall: %w", err))
@@ -111,7 +111,7 @@ func (p *Pool) populate(ctx context.Context) error {
 	}
 }
 
-func (p *Pool) Get(ctx context.Context, allowInternet bool) (*Slot, error) {
+func (p *Pool) Get(ctx context.Context, allowInternet bool, egress *EgressPolicy, team *TeamNetwork, limits RateLimits) (*Slot, error) {
 	var slot *Slot
 
 	select {
@@ -132,23 +132,33 @@ func (p *Pool) Get(ctx context.Context, allowInternet bool) (*Slot, error) {
 		}
 	}
 
-	err := slot.ConfigureInternet(ctx, allowInternet)
+	err := slot.ConfigureInternet(ctx, allowInternet, egress)
 	if err != nil {
 		return nil, fmt.Errorf("error setting slot internet access: %w", err)
 	}
 
+	err = slot.ConfigureTeamNetwork(ctx, team)
+	if err != nil {
+		return nil, fmt.Errorf("error setting slot team network: %w", err)
+	}
+
+	err = slot.ConfigureRateLimits(ctx, limits)
+	if err != nil {
+		return nil, fmt.Errorf("error setting slot rate limits: %w", err)
+	}
+
 	return slot, nil
 }
 
 func (p *Pool) Return(ctx context.Context, slot *Slot) error {
-	err := slot.ResetInternet(ctx)
+	err := errors.Join(slot.ResetInternet(ctx), slot.ResetTeamNetwork(ctx), slot.ResetRateLimits(ctx))
 	if err != nil {
-		// Cleanup the slot if resetting internet fails
+		// Cleanup the slot if resetting internet, team network or rate limits fails
 		if cerr := p.cleanup(slot); cerr != nil {
-			return fmt.Errorf("reset internet: %w; cleanup: %w", err, cerr)
+			return fmt.Errorf("reset slot: %w; cleanup: %w", err, cerr)
 		}
 
-		return fmt.Errorf("error resetting slot internet access: %w", err)
+		return fmt.Errorf("error resetting slot: %w", err)
 	}
 
 	select {
@@ -0,0 +1,182 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+)
+
+const (
+	rateLimitChainName = "RATE_LIMIT"
+
+	ingressCounterName = "ingress_bytes"
+	egressCounterName  = "egress_bytes"
+
+	// minRateLimitBurstBytes makes sure a packet of the maximum size always fits into the burst.
+	minRateLimitBurstBytes = 64 * 1024
+)
+
+// RateLimits are the limits of the sandbox network traffic, zero values mean the traffic is not limited.
+// Ingress is the traffic to the sandbox, egress is the traffic from the sandbox.
+type RateLimits struct {
+	IngressBytesPerSecond   uint64
+	EgressBytesPerSecond    uint64
+	IngressPacketsPerSecond uint64
+	EgressPacketsPerSecond  uint64
+}
+
+// Usage is the network traffic of the sandbox since the slot was assigned to it.
+type Usage struct {
+	IngressBytes uint64
+	EgressBytes  uint64
+}
+
+// installRateLimits creates the chain that limits and counts the traffic of the tap interface.
+// The chain is evaluated before the firewall rules, so the traffic dropped by the firewall is limited and counted too.
+func (fw *Firewall) installRateLimits() {
+	acceptPolicy := nftables.ChainPolicyAccept
+	fw.rateLimitChain = fw.conn.AddChain(&nftables.Chain{
+		Name:     rateLimitChainName,
+		Table:    fw.table,
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookForward,
+		Priority: nftables.ChainPriorityRef(*nftables.ChainPriorityFilter - 1),
+		Policy:   &acceptPolicy,
+	})
+
+	fw.ingressCounter = fw.conn.AddObj(&nftables.NamedObj{
+		Table: fw.table,
+		Name:  ingressCounterName,
+		Type:  nftables.ObjTypeCounter,
+		Obj:   &expr.Counter{},
+	}).(*nftables.NamedObj)
+
+	fw.egressCounter = fw.conn.AddObj(&nftables.NamedObj{
+		Table: fw.table,
+		Name:  egressCounterName,
+		Type:  nftables.ObjTypeCounter,
+		Obj:   &expr.Counter{},
+	}).(*nftables.NamedObj)
+}
+
+// SetRateLimits replaces the rate limits of the traffic to and from the sandbox.
+// The packets over the limits are dropped, the usage counters are kept.
+func (fw *Firewall) SetRateLimits(limits RateLimits) error {
+	fw.conn.FlushChain(fw.rateLimitChain)
+
+	// The traffic from the sandbox enters the namespace through the tap interface,
+	// the traffic to the sandbox leaves the namespace through it.
+	fw.addRateLimitRules(expr.MetaKeyIIFNAME, limits.EgressBytesPerSecond, limits.EgressPacketsPerSecond, fw.egressCounter)
+	fw.addRateLimitRules(expr.MetaKeyOIFNAME, limits.IngressBytesPerSecond, limits.IngressPacketsPerSecond, fw.ingressCounter)
+
+	if err := fw.conn.Flush(); err != nil {
+		return fmt.Errorf("set rate limits: %w", err)
+	}
+
+	return nil
+}
+
+// ResetRateLimits removes the rate limits and resets the usage counters.
+func (fw *Firewall) ResetRateLimits() error {
+	if err := fw.SetRateLimits(RateLimits{}); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, counter := range []*nftables.NamedObj{fw.ingressCounter, fw.egressCounter} {
+		if _, err := fw.conn.ResetObject(counter); err != nil {
+			errs = append(errs, fmt.Errorf("reset counter '%s': %w", counter.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Usage returns the traffic counted since the last reset.
+func (fw *Firewall) Usage() (Usage, error) {
+	ingress, err := fw.counterBytes(fw.ingressCounter)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	egress, err := fw.counterBytes(fw.egressCounter)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	return Usage{IngressBytes: ingress, EgressBytes: egress}, nil
+}
+
+func (fw *Firewall) counterBytes(counter *nftables.NamedObj) (uint64, error) {
+	obj, err := fw.conn.GetObject(counter)
+	if err != nil {
+		return 0, fmt.Errorf("get counter '%s': %w", counter.Name, err)
+	}
+
+	named, ok := obj.(*nftables.NamedObj)
+	if !ok {
+		return 0, fmt.Errorf("unexpected counter '%s' type %T", counter.Name, obj)
+	}
+
+	c, ok := named.Obj.(*expr.Counter)
+	if !ok {
+		return 0, fmt.Errorf("unexpected counter '%s' data type %T", counter.Name, named.Obj)
+	}
+
+	return c.Bytes, nil
+}
+
+func (fw *Firewall) addRateLimitRules(ifaceKey expr.MetaKey, bytesPerSecond, packetsPerSecond uint64, counter *nftables.NamedObj) {
+	ifaceMatch := []expr.Any{
+		&expr.Meta{Key: ifaceKey, Register: 1},
+		&expr.Cmp{
+			Register: 1,
+			Op:       expr.CmpOpEq,
+			Data:     append([]byte(fw.tapInterface), 0), // null-terminated
+		},
+	}
+
+	if bytesPerSecond > 0 {
+		fw.conn.AddRule(&nftables.Rule{
+			Table: fw.table, Chain: fw.rateLimitChain,
+			Exprs: append(ifaceMatch,
+				&expr.Limit{
+					Type: expr.LimitTypePktBytes,
+					Rate: bytesPerSecond,
+					Over: true,
+					Unit: expr.LimitTimeSecond,
+					// Allow bursts of up to one second of traffic.
+					Burst: uint32(min(max(bytesPerSecond, minRateLimitBurstBytes), math.MaxUint32)),
+				},
+				&expr.Verdict{Kind: expr.VerdictDrop},
+			),
+		})
+	}
+
+	if packetsPerSecond > 0 {
+		fw.conn.AddRule(&nftables.Rule{
+			Table: fw.table, Chain: fw.rateLimitChain,
+			Exprs: append(ifaceMatch,
+				&expr.Limit{
+					Type:  expr.LimitTypePkts,
+					Rate:  packetsPerSecond,
+					Over:  true,
+					Unit:  expr.LimitTimeSecond,
+					Burst: uint32(min(packetsPerSecond, math.MaxUint32)),
+				},
+				&expr.Verdict{Kind: expr.VerdictDrop},
+			),
+		})
+	}
+
+	// Only the traffic within the limits is counted.
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.rateLimitChain,
+		Exprs: append(ifaceMatch,
+			&expr.Objref{Type: int(nftables.ObjTypeCounter), Name: counter.Name},
+		),
+	})
+}
@@ -2,16 +2,23 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/netip"
 	"path/filepath"
+	"strconv"
+	"sync"
 	"sync/atomic"
 
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/vishvananda/netlink"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	netutils "k8s.io/utils/net"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal"
@@ -63,6 +70,15 @@ type Slot struct {
 	// firewallCustomRules is used to track if custom firewall rules are set for the slot and need a cleanup.
 	firewallCustomRules atomic.Bool
 
+	// networkMu guards the DNS proxy, the team network proxy and the firewall changes made for the egress policy.
+	networkMu sync.Mutex
+	// dnsPolicy restricts the domains resolved by the DNS proxy, nil when all domains are allowed.
+	dnsPolicy *EgressPolicy
+	// dns answers the DNS queries of the sandbox, nil when the sandbox resolves the names directly upstream.
+	dns *dnsProxy
+	// team tunnels the connections to the team network, nil when the sandbox isn't in a team network.
+	team *teamNetworkProxy
+
 	vPeerIp net.IP
 	vEthIp  net.IP
 	vrtMask net.IPMask
@@ -246,65 +262,403 @@ func (s *Slot) CloseFirewall() error {
 	return nil
 }
 
-func (s *Slot) ConfigureInternet(ctx context.Context, allowInternet bool) (e error) {
+func (s *Slot) ConfigureInternet(ctx context.Context, allowInternet bool, egress *EgressPolicy) (e error) {
 	_, span := tracer.Start(ctx, "slot-internet-configure", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
 		attribute.Bool("allow_internet", allowInternet),
+		attribute.Bool("egress_policy", egress != nil),
 	))
 	defer span.End()
 
-	if allowInternet {
+	if allowInternet && egress == nil {
 		// Internet access is allowed by default.
 		return nil
 	}
 
 	s.firewallCustomRules.Store(true)
 
-	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	return s.setInternet(allowInternet, egress)
+}
+
+// UpdateInternet replaces the internet access configuration of the slot that is in use.
+func (s *Slot) UpdateInternet(ctx context.Context, allowInternet bool, egress *EgressPolicy) error {
+	_, span := tracer.Start(ctx, "slot-internet-update", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+		attribute.Bool("allow_internet", allowInternet),
+		attribute.Bool("egress_policy", egress != nil),
+	))
+	defer span.End()
+
+	s.firewallCustomRules.Store(true)
+
+	return s.setInternet(allowInternet, egress)
+}
+
+func (s *Slot) ResetInternet(ctx context.Context) error {
+	_, span := tracer.Start(ctx, "slot-internet-reset", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+	))
+	defer span.End()
+
+	if !s.firewallCustomRules.CompareAndSwap(true, false) {
+		return nil
+	}
+
+	return s.setInternet(true, nil)
+}
+
+// ConfigureRateLimits limits the network traffic of the sandbox using the slot.
+func (s *Slot) ConfigureRateLimits(ctx context.Context, limits RateLimits) error {
+	_, span := tracer.Start(ctx, "slot-rate-limits-configure", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+		attribute.Int64("ingress_bytes_per_second", int64(limits.IngressBytesPerSecond)),
+		attribute.Int64("egress_bytes_per_second", int64(limits.EgressBytesPerSecond)),
+		attribute.Int64("ingress_packets_per_second", int64(limits.IngressPacketsPerSecond)),
+		attribute.Int64("egress_packets_per_second", int64(limits.EgressPacketsPerSecond)),
+	))
+	defer span.End()
+
+	if limits == (RateLimits{}) {
+		return nil
+	}
+
+	err := s.Firewall.SetRateLimits(limits)
 	if err != nil {
-		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+		return fmt.Errorf("error setting rate limits: %w", err)
 	}
-	defer n.Close()
 
-	err = n.Do(func(_ ns.NetNS) error {
-		err = s.Firewall.AddBlockedIP("0.0.0.0/0")
+	return nil
+}
+
+// ResetRateLimits removes the rate limits and resets the traffic counters, so the slot can be reused.
+func (s *Slot) ResetRateLimits(ctx context.Context) error {
+	_, span := tracer.Start(ctx, "slot-rate-limits-reset", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+	))
+	defer span.End()
+
+	err := s.Firewall.ResetRateLimits()
+	if err != nil {
+		return fmt.Errorf("error resetting rate limits: %w", err)
+	}
+
+	return nil
+}
+
+// Usage returns the network traffic of the sandbox using the slot.
+func (s *Slot) Usage() (Usage, error) {
+	if s.Firewall == nil {
+		return Usage{}, fmt.Errorf("firewall is not initialized for slot %s", s.Key)
+	}
+
+	return s.Firewall.Usage()
+}
+
+// setInternet applies the internet access configuration in the slot network namespace.
+// The egress policy takes precedence over allowInternet, as it already blocks everything it doesn't allow.
+func (s *Slot) setInternet(allowInternet bool, egress *EgressPolicy) error {
+	s.networkMu.Lock()
+	defer s.networkMu.Unlock()
+
+	return s.inNamespace(func() error {
+		err := s.stopDNS()
+		if err != nil {
+			return fmt.Errorf("error stopping DNS proxy: %w", err)
+		}
+
+		restricted := true
+		switch {
+		case egress != nil:
+			err = s.Firewall.SetEgressPolicy(egress.AllowedCIDRs, egress.AllowedPorts)
+			if err != nil {
+				return fmt.Errorf("error setting firewall egress policy: %w", err)
+			}
+
+			s.dnsPolicy = egress
+		case !allowInternet:
+			err = s.Firewall.SetEgressPolicy(nil, nil)
+			if err != nil {
+				return fmt.Errorf("error setting firewall rules: %w", err)
+			}
+
+			// The DNS proxy of the team network must not resolve the internet domains either.
+			s.dnsPolicy = &EgressPolicy{}
+		default:
+			err = s.Firewall.ResetAllCustom()
+			if err != nil {
+				return fmt.Errorf("error cleaning firewall rules: %w", err)
+			}
+
+			s.dnsPolicy = nil
+			restricted = false
+		}
+
+		err = s.startDNS()
 		if err != nil {
-			return fmt.Errorf("error setting firewall rules: %w", err)
+			return fmt.Errorf("error starting DNS proxy: %w", err)
+		}
+
+		if !restricted {
+			return nil
+		}
+
+		// The established connections are accepted by the firewall, so they have to be dropped to apply the new rules to them.
+		err = s.closeSandboxConnections()
+		if err != nil {
+			return fmt.Errorf("error closing sandbox connections: %w", err)
 		}
 
 		return nil
 	})
-	if err != nil {
-		return fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
+}
+
+// ConfigureTeamNetwork connects the sandbox using the slot to its team network.
+// The connections to the team network addresses are redirected to the team network proxy in the slot network namespace.
+func (s *Slot) ConfigureTeamNetwork(ctx context.Context, team *TeamNetwork) error {
+	if team == nil {
+		return nil
 	}
 
-	return nil
+	_, span := tracer.Start(ctx, "slot-team-network-configure", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+		attribute.String("team_network.group", team.Network.Group),
+	))
+	defer span.End()
+
+	s.networkMu.Lock()
+	defer s.networkMu.Unlock()
+
+	return s.inNamespace(func() error {
+		addr := net.JoinHostPort(s.TapIPString(), strconv.Itoa(teamNetworkProxyPort))
+
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return fmt.Errorf("error listening on tcp %s: %w", addr, err)
+		}
+
+		s.team = newTeamNetworkProxy(*team, listener)
+
+		tables, err := iptables.New()
+		if err != nil {
+			return fmt.Errorf("error initializing iptables: %w", err)
+		}
+
+		err = tables.AppendUnique("nat", "PREROUTING", s.teamNetworkRedirectRule()...)
+		if err != nil {
+			return fmt.Errorf("error creating team network redirect rule: %w", err)
+		}
+
+		// The DNS proxy is restarted to resolve the team network names.
+		err = s.stopDNS()
+		if err != nil {
+			return fmt.Errorf("error stopping DNS proxy: %w", err)
+		}
+
+		return s.startDNS()
+	})
 }
 
-func (s *Slot) ResetInternet(ctx context.Context) error {
-	_, span := tracer.Start(ctx, "slot-internet-reset", trace.WithAttributes(
+// ResetTeamNetwork disconnects the slot from the team network, so the slot can be reused.
+func (s *Slot) ResetTeamNetwork(ctx context.Context) error {
+	_, span := tracer.Start(ctx, "slot-team-network-reset", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
 	))
 	defer span.End()
 
-	if !s.firewallCustomRules.CompareAndSwap(true, false) {
+	s.networkMu.Lock()
+	defer s.networkMu.Unlock()
+
+	if s.team == nil {
 		return nil
 	}
 
-	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	return s.inNamespace(func() error {
+		var errs []error
+
+		tables, err := iptables.New()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error initializing iptables: %w", err))
+		} else {
+			err = tables.DeleteIfExists("nat", "PREROUTING", s.teamNetworkRedirectRule()...)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error deleting team network redirect rule: %w", err))
+			}
+		}
+
+		err = s.team.Close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error closing team network proxy: %w", err))
+		}
+
+		s.team = nil
+
+		err = s.stopDNS()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error stopping DNS proxy: %w", err))
+		} else {
+			err = s.startDNS()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error starting DNS proxy: %w", err))
+			}
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+func (s *Slot) teamNetworkRedirectRule() []string {
+	return []string{
+		"-i", s.TapName(), "-d", teamNetworkCIDR.String(), "-p", "tcp",
+		"-j", "REDIRECT", "--to-ports", strconv.Itoa(teamNetworkProxyPort),
+	}
+}
+
+// closeSandboxConnections removes the connection tracking entries of the connections opened from the sandbox,
+// so their next packets are checked against the current firewall rules.
+// It must be called in the slot network namespace.
+func (s *Slot) closeSandboxConnections() error {
+	filter := &netlink.ConntrackFilter{}
+
+	err := filter.AddIP(netlink.ConntrackOrigSrcIP, net.ParseIP(s.NamespaceIP()))
 	if err != nil {
-		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+		return fmt.Errorf("error creating conntrack filter: %w", err)
 	}
-	defer n.Close()
 
-	err = n.Do(func(_ ns.NetNS) error {
-		err := s.Firewall.ResetAllCustom()
+	_, err = netlink.ConntrackDeleteFilters(netlink.ConntrackTable, netlink.FAMILY_V4, filter)
+	if err != nil {
+		return fmt.Errorf("error deleting conntrack entries: %w", err)
+	}
+
+	return nil
+}
+
+// startDNS redirects the DNS queries of the sandbox to the DNS proxy, when the sandbox is in a team network
+// or the egress policy allows some domains. Otherwise the sandbox resolves the names directly upstream, if the firewall allows it.
+// It must be called in the slot network namespace, so the proxy sockets are bound there.
+func (s *Slot) startDNS() error {
+	if s.team == nil && (s.dnsPolicy == nil || len(s.dnsPolicy.AllowedDomains) == 0) {
+		return nil
+	}
+
+	addr := net.JoinHostPort(s.TapIPString(), strconv.Itoa(dnsProxyPort))
+
+	packetConn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return fmt.Errorf("error listening on udp %s: %w", addr, err)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.Join(fmt.Errorf("error listening on tcp %s: %w", addr, err), packetConn.Close())
+	}
+
+	s.dns = newDNSProxy(s.dnsPolicy, s.team, packetConn, listener, s.allowResolved)
+
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	for _, proto := range []string{"udp", "tcp"} {
+		err = tables.AppendUnique(
+			"nat", "PREROUTING", "-i", s.TapName(),
+			"-p", proto, "--dport", strconv.Itoa(dnsProxyPort),
+			"-j", "REDIRECT", "--to-ports", strconv.Itoa(dnsProxyPort),
+		)
 		if err != nil {
-			return fmt.Errorf("error cleaning firewall rules: %w", err)
+			return fmt.Errorf("error creating %s DNS redirect rule: %w", proto, err)
+		}
+	}
+
+	return nil
+}
+
+// stopDNS removes the DNS redirect and stops the DNS proxy if it is running.
+// It must be called in the slot network namespace.
+func (s *Slot) stopDNS() error {
+	if s.dns == nil {
+		return nil
+	}
+
+	var errs []error
+
+	tables, err := iptables.New()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error initializing iptables: %w", err))
+	} else {
+		for _, proto := range []string{"udp", "tcp"} {
+			err = tables.DeleteIfExists(
+				"nat", "PREROUTING", "-i", s.TapName(),
+				"-p", proto, "--dport", strconv.Itoa(dnsProxyPort),
+				"-j", "REDIRECT", "--to-ports", strconv.Itoa(dnsProxyPort),
+			)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error deleting %s DNS redirect rule: %w", proto, err))
+			}
+		}
+	}
+
+	err = s.dns.Close()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error closing DNS proxy: %w", err))
+	}
+
+	s.dns = nil
+
+	return errors.Join(errs...)
+}
+
+// allowResolved adds the addresses resolved by the DNS proxy to the firewall allow set.
+func (s *Slot) allowResolved(proxy *dnsProxy, addrs []netip.Addr) {
+	s.networkMu.Lock()
+	defer s.networkMu.Unlock()
+
+	// The policy was changed while the query was being resolved.
+	if s.dns != proxy {
+		return
+	}
+
+	var toAllow []netip.Addr
+	for _, addr := range addrs {
+		if _, ok := proxy.allowed[addr]; ok || isBlockedAddr(addr) || proxy.policy.allowsAddr(addr) {
+			continue
+		}
+
+		toAllow = append(toAllow, addr)
+	}
+
+	if len(toAllow) == 0 {
+		return
+	}
+
+	err := s.inNamespace(func() error {
+		for _, addr := range toAllow {
+			err := s.Firewall.AddAllowedIP(addr.String())
+			if err != nil {
+				return fmt.Errorf("error allowing resolved address '%s': %w", addr, err)
+			}
+
+			proxy.allowed[addr] = struct{}{}
 		}
 
 		return nil
 	})
+	if err != nil {
+		zap.L().Error("failed to allow addresses resolved by egress DNS proxy", zap.String("namespace_id", s.NamespaceID()), zap.Error(err))
+	}
+}
+
+// inNamespace runs the function in the slot network namespace.
+func (s *Slot) inNamespace(fn func() error) error {
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		return fn()
+	})
 	if err != nil {
 		return fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
 	}
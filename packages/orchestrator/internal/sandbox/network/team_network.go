@@ -0,0 +1,318 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+)
+
+const (
+	defaultTeamNetworkCIDR = "10.13.0.0/16"
+
+	// teamNetworkDomain is the domain the sandbox resolves the other sandboxes of its team network in, e.g. `db.sandbox.internal`.
+	teamNetworkDomain = "sandbox.internal."
+	// teamNetworkProxyPort is the port of the team network proxy in the slot network namespace,
+	// the connections to the team network addresses are redirected to it.
+	teamNetworkProxyPort = 5011
+	teamNetworkDNSTTL    = 30
+	teamNetworkTimeout   = 10 * time.Second
+)
+
+var teamNetworkCIDR = getTeamNetworkCIDR()
+
+// TeamNetwork connects the sandbox to the other sandboxes of its team network.
+type TeamNetwork struct {
+	Network  proxy.TeamNetwork
+	Resolver *TeamNetworkResolver
+}
+
+// teamNetworkProxy assigns an address from the team network CIDR to each sandbox of the team network resolved by the sandbox
+// and tunnels the connections to the address to the orchestrator running the resolved sandbox.
+// The addresses are only valid in the slot network namespace, the sandboxes are resolved again for each connection.
+type teamNetworkProxy struct {
+	team     TeamNetwork
+	listener net.Listener
+
+	mu     sync.Mutex
+	addrs  map[string]netip.Addr
+	names  map[netip.Addr]string
+	last   netip.Addr
+	conns  map[net.Conn]struct{}
+	closed bool
+}
+
+// newTeamNetworkProxy starts the proxy on the provided listener, which must be created in the slot network namespace.
+func newTeamNetworkProxy(team TeamNetwork, listener net.Listener) *teamNetworkProxy {
+	p := &teamNetworkProxy{
+		team:     team,
+		listener: listener,
+		addrs:    make(map[string]netip.Addr),
+		names:    make(map[netip.Addr]string),
+		last:     teamNetworkCIDR.Addr(),
+		conns:    make(map[net.Conn]struct{}),
+	}
+
+	go p.serve()
+
+	return p
+}
+
+// handlesName checks if the name is in the team network domain.
+func (p *teamNetworkProxy) handlesName(name string) bool {
+	return dns.IsSubDomain(teamNetworkDomain, dns.CanonicalName(name))
+}
+
+func (p *teamNetworkProxy) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	q := r.Question[0]
+
+	name, ok := strings.CutSuffix(dns.CanonicalName(q.Name), "."+teamNetworkDomain)
+	if !ok || strings.Contains(name, ".") {
+		replyDNS(w, r, dns.RcodeNameError)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+	defer cancel()
+
+	peer, err := p.team.Resolver.resolve(ctx, p.team.Network, name)
+	if err != nil {
+		zap.L().Debug("team network query failed", zap.String("name", name), zap.Error(err))
+		replyDNS(w, r, dns.RcodeServerFailure)
+
+		return
+	}
+
+	if peer == nil {
+		replyDNS(w, r, dns.RcodeNameError)
+
+		return
+	}
+
+	m := new(dns.Msg)
+	m.SetReply(r)
+	m.Authoritative = true
+
+	// Only the IPv4 addresses are assigned, the other record types have an empty answer.
+	if q.Qtype == dns.TypeA {
+		addr, err := p.address(name)
+		if err != nil {
+			zap.L().Error("failed to assign team network address", zap.String("name", name), zap.Error(err))
+			replyDNS(w, r, dns.RcodeServerFailure)
+
+			return
+		}
+
+		m.Answer = append(m.Answer, &dns.A{
+			Hdr: dns.RR_Header{
+				Name:   q.Name,
+				Rrtype: dns.TypeA,
+				Class:  dns.ClassINET,
+				Ttl:    teamNetworkDNSTTL,
+			},
+			A: addr.AsSlice(),
+		})
+	}
+
+	if err := w.WriteMsg(m); err != nil {
+		zap.L().Debug("team network proxy failed to write DNS response", zap.Error(err))
+	}
+}
+
+// address returns the address assigned to the name, a new address is assigned to the names resolved for the first time.
+func (p *teamNetworkProxy) address(name string) (netip.Addr, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if addr, ok := p.addrs[name]; ok {
+		return addr, nil
+	}
+
+	addr := p.last.Next()
+	if !teamNetworkCIDR.Contains(addr) || addr == broadcastAddr(teamNetworkCIDR) {
+		return netip.Addr{}, fmt.Errorf("team network addresses in '%s' are exhausted", teamNetworkCIDR)
+	}
+
+	p.last = addr
+	p.addrs[name] = addr
+	p.names[addr] = name
+
+	return addr, nil
+}
+
+func (p *teamNetworkProxy) serve() {
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				zap.L().Error("team network proxy stopped", zap.Error(err))
+			}
+
+			return
+		}
+
+		go p.handle(conn)
+	}
+}
+
+func (p *teamNetworkProxy) handle(conn net.Conn) {
+	defer conn.Close()
+
+	if !p.track(conn) {
+		return
+	}
+	defer p.untrack(conn)
+
+	dst, err := originalDestination(conn)
+	if err != nil {
+		zap.L().Error("failed to get team network connection destination", zap.Error(err))
+
+		return
+	}
+
+	p.mu.Lock()
+	name, ok := p.names[dst.Addr()]
+	p.mu.Unlock()
+
+	if !ok {
+		zap.L().Debug("team network address was not assigned", zap.String("addr", dst.Addr().String()))
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), teamNetworkTimeout)
+	upstream, err := p.team.Resolver.dial(ctx, p.team.Network, name, dst.Port())
+	cancel()
+	if err != nil {
+		zap.L().Debug("failed to connect to team network sandbox", zap.String("name", name), zap.Uint16("port", dst.Port()), zap.Error(err))
+
+		return
+	}
+	defer upstream.Close()
+
+	joinConns(conn, upstream)
+}
+
+// track adds the connection to the connections closed with the proxy, it returns false when the proxy is already closed.
+func (p *teamNetworkProxy) track(conn net.Conn) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return false
+	}
+
+	p.conns[conn] = struct{}{}
+
+	return true
+}
+
+func (p *teamNetworkProxy) untrack(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.conns, conn)
+}
+
+// Close stops the proxy and closes its connections, the slot can be reused by a sandbox from another team.
+func (p *teamNetworkProxy) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for conn := range p.conns {
+		conn.Close()
+	}
+
+	return p.listener.Close()
+}
+
+// joinConns copies the data between the connections until both directions are done, the half-close is propagated.
+func joinConns(a, b net.Conn) {
+	var wg sync.WaitGroup
+
+	pipe := func(dst, src net.Conn) {
+		defer wg.Done()
+
+		io.Copy(dst, src)
+
+		if cw, ok := dst.(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		} else {
+			dst.Close()
+		}
+	}
+
+	wg.Add(2)
+	go pipe(a, b)
+	go pipe(b, a)
+	wg.Wait()
+}
+
+// originalDestination returns the destination of the connection before it was redirected to the proxy.
+func originalDestination(conn net.Conn) (netip.AddrPort, error) {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return netip.AddrPort{}, fmt.Errorf("unexpected connection type %T", conn)
+	}
+
+	raw, err := tcpConn.SyscallConn()
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("failed to get raw connection: %w", err)
+	}
+
+	// The original destination is returned as sockaddr_in, the IPv6Mreq is only used as a buffer large enough for it.
+	var mreq *unix.IPv6Mreq
+	var sockErr error
+	err = raw.Control(func(fd uintptr) {
+		mreq, sockErr = unix.GetsockoptIPv6Mreq(int(fd), unix.SOL_IP, unix.SO_ORIGINAL_DST)
+	})
+	if err == nil {
+		err = sockErr
+	}
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("failed to get original destination: %w", err)
+	}
+
+	addr := netip.AddrFrom4([4]byte(mreq.Multiaddr[4:8]))
+	port := binary.BigEndian.Uint16(mreq.Multiaddr[2:4])
+
+	return netip.AddrPortFrom(addr, port), nil
+}
+
+func broadcastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Addr().As4()
+	hostBits := 32 - prefix.Bits()
+	value := binary.BigEndian.Uint32(addr[:]) | (1<<hostBits - 1)
+	binary.BigEndian.PutUint32(addr[:], value)
+
+	return netip.AddrFrom4(addr)
+}
+
+func getTeamNetworkCIDR() netip.Prefix {
+	cidr := env.GetEnv("SANDBOXES_TEAM_NETWORK_CIDR", defaultTeamNetworkCIDR)
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil || !prefix.Addr().Is4() {
+		log.Fatalf("Failed to parse team network CIDR %s: %v", cidr, err)
+	}
+
+	log.Printf("Using team network cidr %s", cidr)
+
+	return prefix.Masked()
+}
@@ -0,0 +1,123 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+)
+
+// TeamNetworkResolver resolves the sandboxes of the team networks with the API DNS server
+// and tunnels the connections to them through the orchestrator proxy of the node running the sandbox.
+type TeamNetworkResolver struct {
+	dnsServer string
+	proxyPort uint
+	// secret signs the team network headers of the tunnels, it's shared by the orchestrators.
+	secret []byte
+	client *dns.Client
+}
+
+// teamNetworkPeer is the sandbox of the team network resolved by the API DNS server.
+type teamNetworkPeer struct {
+	sandboxID string
+	nodeIP    string
+}
+
+// NewTeamNetworkResolver creates the resolver querying the API DNS server,
+// the orchestrator proxies of all nodes are expected to listen on the proxy port and share the secret.
+func NewTeamNetworkResolver(dnsServer string, proxyPort uint, secret []byte) *TeamNetworkResolver {
+	return &TeamNetworkResolver{
+		dnsServer: dnsServer,
+		proxyPort: proxyPort,
+		secret:    secret,
+		client:    &dns.Client{Timeout: dnsTimeout},
+	}
+}
+
+// resolve returns the sandbox with the name in the team network, nil when there is no such sandbox.
+// The API DNS server answers with the address of the node running the sandbox and the sandbox ID in the additional TXT record.
+func (r *TeamNetworkResolver) resolve(ctx context.Context, network proxy.TeamNetwork, name string) (*teamNetworkPeer, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(network.QueryName(name), dns.TypeA)
+
+	res, _, err := r.client.ExchangeContext(ctx, msg, r.dnsServer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query API DNS server: %w", err)
+	}
+
+	switch res.Rcode {
+	case dns.RcodeSuccess:
+	case dns.RcodeNameError:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("API DNS server failed with %s", dns.RcodeToString[res.Rcode])
+	}
+
+	peer := &teamNetworkPeer{}
+	for _, rr := range res.Answer {
+		if a, ok := rr.(*dns.A); ok {
+			peer.nodeIP = a.A.String()
+		}
+	}
+
+	for _, rr := range res.Extra {
+		if txt, ok := rr.(*dns.TXT); ok {
+			peer.sandboxID = strings.Join(txt.Txt, "")
+		}
+	}
+
+	if peer.nodeIP == "" || peer.sandboxID == "" {
+		return nil, errors.New("API DNS server answer is missing the node address or the sandbox ID")
+	}
+
+	return peer, nil
+}
+
+// dial connects to the port of the sandbox with the name in the team network.
+func (r *TeamNetworkResolver) dial(ctx context.Context, network proxy.TeamNetwork, name string, port uint16) (net.Conn, error) {
+	peer, err := r.resolve(ctx, network, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if peer == nil {
+		return nil, fmt.Errorf("sandbox '%s' is not in the team network", name)
+	}
+
+	header := make(http.Header)
+	proxy.SetRouteHeaders(header, peer.sandboxID, uint64(port))
+	err = proxy.SetTeamNetworkHeaders(header, network, peer.sandboxID, uint64(port), r.secret)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(peer.nodeIP, strconv.FormatUint(uint64(r.proxyPort), 10)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to orchestrator proxy: %w", err)
+	}
+
+	// The tunnel handshake doesn't take the context, so the deadline is set on the connection.
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	tunnel, err := proxy.NewTunnelConn(conn, peer.sandboxID, proxy.TunnelProtocolTCP, header)
+	if err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	conn.SetDeadline(time.Time{})
+
+	return tunnel, nil
+}
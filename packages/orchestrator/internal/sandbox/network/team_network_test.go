@@ -0,0 +1,47 @@
+package network
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamNetworkProxy_Address(t *testing.T) {
+	p := &teamNetworkProxy{
+		addrs: make(map[string]netip.Addr),
+		names: make(map[netip.Addr]string),
+		last:  teamNetworkCIDR.Addr(),
+	}
+
+	db, err := p.address("db")
+	require.NoError(t, err)
+	assert.True(t, teamNetworkCIDR.Contains(db))
+
+	app, err := p.address("app")
+	require.NoError(t, err)
+	assert.NotEqual(t, db, app)
+
+	again, err := p.address("db")
+	require.NoError(t, err)
+	assert.Equal(t, db, again, "the name should keep its address")
+	assert.Equal(t, "db", p.names[db])
+}
+
+func TestTeamNetworkProxy_AddressExhausted(t *testing.T) {
+	p := &teamNetworkProxy{
+		addrs: make(map[string]netip.Addr),
+		names: make(map[netip.Addr]string),
+		// The last usable address is already assigned.
+		last: broadcastAddr(teamNetworkCIDR).Prev(),
+	}
+
+	_, err := p.address("db")
+	require.Error(t, err)
+}
+
+func TestBroadcastAddr(t *testing.T) {
+	assert.Equal(t, netip.MustParseAddr("10.13.255.255"), broadcastAddr(netip.MustParsePrefix("10.13.0.0/16")))
+	assert.Equal(t, netip.MustParseAddr("192.168.1.7"), broadcastAddr(netip.MustParsePrefix("192.168.1.0/29")))
+}
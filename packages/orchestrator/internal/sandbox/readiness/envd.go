@@ -0,0 +1,66 @@
+package readiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process/processconnect"
+)
+
+// EnvdCommand runs the command probes directly through the envd of the running sandbox.
+// The access token is required when the sandbox is secured.
+func EnvdCommand(host string, accessToken *string, cmdMetadata metadata.Context) CommandRunner {
+	address := fmt.Sprintf("http://%s", net.JoinHostPort(host, strconv.FormatInt(consts.DefaultEnvdServerPort, 10)))
+	processC := processconnect.NewProcessClient(http.DefaultClient, address)
+
+	return func(ctx context.Context, command string) error {
+		req := connect.NewRequest(&process.StartRequest{
+			Process: &process.ProcessConfig{
+				Cmd:  "/bin/bash",
+				Cwd:  cmdMetadata.WorkDir,
+				Args: []string{"-l", "-c", command},
+				Envs: cmdMetadata.EnvVars,
+			},
+		})
+
+		grpc.SetUserHeader(req.Header(), cmdMetadata.User)
+		if accessToken != nil {
+			req.Header().Set("X-Access-Token", *accessToken)
+		}
+
+		stream, err := processC.Start(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error starting process: %w", err)
+		}
+		defer stream.Close()
+
+		for stream.Receive() {
+			end := stream.Msg().GetEvent().GetEnd()
+			if end == nil {
+				continue
+			}
+
+			if end.GetExitCode() != 0 {
+				return errors.New(end.GetStatus())
+			}
+
+			return nil
+		}
+
+		if err := stream.Err(); err != nil {
+			return fmt.Errorf("command failed: %w", err)
+		}
+
+		return errors.New("command ended without the exit status")
+	}
+}
@@ -0,0 +1,191 @@
+package readiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+const (
+	DefaultInterval = 2 * time.Second
+	DefaultTimeout  = 5 * time.Second
+	DefaultRetries  = 30
+)
+
+var ErrNotReady = errors.New("ready probe failed")
+
+var httpClient = http.Client{
+	// The redirects are not followed, the probe checks the status of the first response.
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// CommandRunner runs the command of the command probe in the sandbox, it fails when the command exits with a non-zero code.
+type CommandRunner func(ctx context.Context, command string) error
+
+// FromProto converts the probe from the build request and fills in the defaults, it returns nil when no probe is set.
+func FromProto(probe *templatemanager.ReadyProbe) *metadata.ReadyProbe {
+	if probe == nil {
+		return nil
+	}
+
+	result := &metadata.ReadyProbe{
+		Command:  probe.GetCommand(),
+		Interval: DefaultInterval,
+		Timeout:  DefaultTimeout,
+		Retries:  DefaultRetries,
+	}
+
+	if httpProbe := probe.GetHttp(); httpProbe != nil {
+		result.HTTP = &metadata.HTTPProbe{
+			Port:           uint16(httpProbe.GetPort()),
+			Path:           httpProbe.GetPath(),
+			ExpectedStatus: int(httpProbe.GetExpectedStatus()),
+		}
+	}
+
+	if tcpProbe := probe.GetTcp(); tcpProbe != nil {
+		result.TCP = &metadata.TCPProbe{
+			Port: uint16(tcpProbe.GetPort()),
+		}
+	}
+
+	if probe.IntervalSeconds != nil {
+		result.Interval = time.Duration(probe.GetIntervalSeconds()) * time.Second
+	}
+
+	if probe.TimeoutSeconds != nil {
+		result.Timeout = time.Duration(probe.GetTimeoutSeconds()) * time.Second
+	}
+
+	if probe.Retries != nil {
+		result.Retries = int(probe.GetRetries())
+	}
+
+	return result
+}
+
+// Type returns the name of the check of the probe.
+func Type(probe metadata.ReadyProbe) string {
+	switch {
+	case probe.HTTP != nil:
+		return "http"
+	case probe.TCP != nil:
+		return "tcp"
+	default:
+		return "command"
+	}
+}
+
+// Check runs a single check of the probe against the sandbox reachable on the host.
+func Check(ctx context.Context, probe metadata.ReadyProbe, host string, runCommand CommandRunner) error {
+	if probe.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, probe.Timeout)
+		defer cancel()
+	}
+
+	switch {
+	case probe.HTTP != nil:
+		return checkHTTP(ctx, probe.HTTP, host)
+	case probe.TCP != nil:
+		return checkTCP(ctx, probe.TCP, host)
+	default:
+		return runCommand(ctx, probe.Command)
+	}
+}
+
+// Wait runs the checks of the probe in the interval until one of them passes.
+// It fails when the first check and all the retries after it fail, the onFailure is called for every failed check.
+// The number of the run checks is returned.
+func Wait(
+	ctx context.Context,
+	probe metadata.ReadyProbe,
+	host string,
+	runCommand CommandRunner,
+	onFailure func(attempt int, err error),
+) (int, error) {
+	for attempt := 1; ; attempt++ {
+		err := Check(ctx, probe, host, runCommand)
+		if err == nil {
+			return attempt, nil
+		}
+
+		if ctx.Err() != nil {
+			return attempt, fmt.Errorf("%w: %w", ErrNotReady, context.Cause(ctx))
+		}
+
+		if onFailure != nil {
+			onFailure(attempt, err)
+		}
+
+		if attempt > probe.Retries {
+			return attempt, fmt.Errorf("%w after %d attempts: %w", ErrNotReady, attempt, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempt, fmt.Errorf("%w: %w", ErrNotReady, context.Cause(ctx))
+		case <-time.After(probe.Interval):
+		}
+	}
+}
+
+func checkHTTP(ctx context.Context, probe *metadata.HTTPProbe, host string) error {
+	path := probe.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	address := fmt.Sprintf("http://%s%s", net.JoinHostPort(host, strconv.Itoa(int(probe.Port))), path)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Drain the response body to reuse the connection
+		io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+	}()
+
+	if probe.ExpectedStatus != 0 {
+		if response.StatusCode != probe.ExpectedStatus {
+			return fmt.Errorf("unexpected status code: %d, expected %d", response.StatusCode, probe.ExpectedStatus)
+		}
+
+		return nil
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func checkTCP(ctx context.Context, probe *metadata.TCPProbe, host string) error {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(int(probe.Port))))
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,118 @@
+package readiness
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+func serverPort(t *testing.T, address string) uint16 {
+	t.Helper()
+
+	_, port, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return uint16(p)
+}
+
+func TestCheck_HTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	port := serverPort(t, server.Listener.Addr().String())
+
+	probe := metadata.ReadyProbe{HTTP: &metadata.HTTPProbe{Port: port, Path: "health"}, Timeout: time.Second}
+	require.NoError(t, Check(t.Context(), probe, "127.0.0.1", nil))
+
+	probe.HTTP.ExpectedStatus = http.StatusOK
+	require.EqualError(t, Check(t.Context(), probe, "127.0.0.1", nil), "unexpected status code: 204, expected 200")
+
+	probe = metadata.ReadyProbe{HTTP: &metadata.HTTPProbe{Port: port, Path: "/"}, Timeout: time.Second}
+	require.EqualError(t, Check(t.Context(), probe, "127.0.0.1", nil), "unexpected status code: 503")
+}
+
+func TestCheck_TCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	port := serverPort(t, listener.Addr().String())
+
+	probe := metadata.ReadyProbe{TCP: &metadata.TCPProbe{Port: port}, Timeout: time.Second}
+	require.NoError(t, Check(t.Context(), probe, "127.0.0.1", nil))
+
+	require.NoError(t, listener.Close())
+	require.Error(t, Check(t.Context(), probe, "127.0.0.1", nil))
+}
+
+func TestWait_Retries(t *testing.T) {
+	probe := metadata.ReadyProbe{Command: "check", Retries: 2}
+
+	var commands []string
+	failing := func(_ context.Context, command string) error {
+		commands = append(commands, command)
+
+		return errors.New("not ready")
+	}
+
+	var failures []int
+	attempts, err := Wait(t.Context(), probe, "127.0.0.1", failing, func(attempt int, _ error) {
+		failures = append(failures, attempt)
+	})
+	require.ErrorIs(t, err, ErrNotReady)
+	assert.Equal(t, 3, attempts, "the first check and all the retries are run")
+	assert.Equal(t, []int{1, 2, 3}, failures)
+	assert.Equal(t, []string{"check", "check", "check"}, commands)
+
+	calls := 0
+	eventuallyReady := func(context.Context, string) error {
+		calls++
+		if calls < 2 {
+			return errors.New("not ready")
+		}
+
+		return nil
+	}
+
+	attempts, err = Wait(t.Context(), probe, "127.0.0.1", eventuallyReady, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestFromProto_Defaults(t *testing.T) {
+	assert.Nil(t, FromProto(nil))
+
+	retries := uint32(0)
+	probe := FromProto(&templatemanager.ReadyProbe{
+		Check:   &templatemanager.ReadyProbe_Tcp{Tcp: &templatemanager.ReadyProbeTCP{Port: 8080}},
+		Retries: &retries,
+	})
+
+	assert.Equal(t, &metadata.ReadyProbe{
+		TCP:      &metadata.TCPProbe{Port: 8080},
+		Interval: DefaultInterval,
+		Timeout:  DefaultTimeout,
+		Retries:  0,
+	}, probe)
+}
@@ -92,6 +92,20 @@ func (o *DirectProvider) ExportDiff(
 	return m, nil
 }
 
+func (o *DirectProvider) SnapshotDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error) {
+	_, childSpan := tracer.Start(ctx, "direct-provider-snapshot")
+	defer childSpan.End()
+
+	o.cache.MarkAllAsDirty()
+
+	m, err := o.cache.ExportToDiff(out)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting cache: %w", err)
+	}
+
+	return m, nil
+}
+
 func (o *DirectProvider) Close(_ context.Context) error {
 	o.finishedOperations <- struct{}{}
 
@@ -109,6 +109,26 @@ func (o *NBDProvider) ExportDiff(
 	return m, nil
 }
 
+func (o *NBDProvider) SnapshotDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error) {
+	ctx, childSpan := tracer.Start(ctx, "cow-snapshot")
+	defer childSpan.End()
+
+	// The writes of the paused VM can still be in the device buffers.
+	err := o.flush(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error flushing cow device: %w", err)
+	}
+
+	m, err := o.overlay.ExportCache(out)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting cache: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "cache exported")
+
+	return m, nil
+}
+
 func (o *NBDProvider) Close(ctx context.Context) error {
 	childCtx, childSpan := tracer.Start(ctx, "cow-close")
 	defer childSpan.End()
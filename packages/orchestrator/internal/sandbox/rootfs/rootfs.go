@@ -12,4 +12,6 @@ type Provider interface {
 	Close(ctx context.Context) error
 	Path() (string, error)
 	ExportDiff(ctx context.Context, out io.Writer, closeSandbox func(context.Context) error) (*header.DiffMetadata, error)
+	// SnapshotDiff exports the diff without closing the device, the sandbox must be paused while it's exported.
+	SnapshotDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error)
 }
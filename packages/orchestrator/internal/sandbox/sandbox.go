@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +30,7 @@ import (
 	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -58,6 +61,12 @@ type Config struct {
 	HugePages       bool
 
 	AllowInternetAccess *bool
+	// Egress restricts the traffic leaving the sandbox, nil when there is no egress policy.
+	Egress *network.EgressPolicy
+	// NetworkLimits are the rate limits of the sandbox network traffic.
+	NetworkLimits network.RateLimits
+	// TeamNetwork is the team network the sandbox can reach, nil when the sandbox isn't in a team network.
+	TeamNetwork *proxy.TeamNetwork
 
 	Envd EnvdMetadata
 }
@@ -113,6 +122,15 @@ type Sandbox struct {
 	// It was used to store the config to allow API restarts
 	APIStoredConfig *orchestrator.SandboxConfig
 
+	// portPolicy is read by the proxy for every request, so it can be replaced without locking.
+	portPolicy atomic.Pointer[proxy.PortPolicy]
+
+	// networkMu guards the network configuration that can be updated while the sandbox is running.
+	networkMu sync.RWMutex
+
+	// snapshotMu prevents pausing the sandbox while a snapshot of the running sandbox is created.
+	snapshotMu sync.Mutex
+
 	exit *utils.ErrorOnce
 }
 
@@ -135,6 +153,8 @@ type Factory struct {
 	featureFlags *featureflags.Client
 
 	defaultAllowInternetAccess bool
+	// teamNetworkResolver connects the sandboxes to their team networks, nil when the team networks are not supported.
+	teamNetworkResolver *network.TeamNetworkResolver
 }
 
 func NewFactory(
@@ -142,15 +162,33 @@ func NewFactory(
 	devicePool *nbd.DevicePool,
 	featureFlags *featureflags.Client,
 	defaultAllowInternetAccess bool,
+	teamNetworkResolver *network.TeamNetworkResolver,
 ) *Factory {
 	return &Factory{
 		networkPool:                networkPool,
 		devicePool:                 devicePool,
 		featureFlags:               featureFlags,
 		defaultAllowInternetAccess: defaultAllowInternetAccess,
+		teamNetworkResolver:        teamNetworkResolver,
 	}
 }
 
+// teamNetwork returns the team network configuration of the sandbox slot, nil when the sandbox isn't in a team network.
+func (f *Factory) teamNetwork(config Config) (*network.TeamNetwork, error) {
+	if config.TeamNetwork == nil {
+		return nil, nil
+	}
+
+	if f.teamNetworkResolver == nil {
+		return nil, errors.New("team network is not supported")
+	}
+
+	return &network.TeamNetwork{
+		Network:  *config.TeamNetwork,
+		Resolver: f.teamNetworkResolver,
+	}, nil
+}
+
 // CreateSandbox creates the sandbox.
 // IMPORTANT: You must Close() the sandbox after you are done with it.
 func (f *Factory) CreateSandbox(
@@ -185,8 +223,14 @@ func (f *Factory) CreateSandbox(
 	if config.AllowInternetAccess != nil {
 		allowInternet = *config.AllowInternetAccess
 	}
+	config.AllowInternetAccess = &allowInternet
+
+	teamNetwork, err := f.teamNetwork(config)
+	if err != nil {
+		return nil, err
+	}
 
-	ipsCh := getNetworkSlotAsync(ctx, f.networkPool, cleanup, allowInternet)
+	ipsCh := getNetworkSlotAsync(ctx, f.networkPool, cleanup, allowInternet, config.Egress, teamNetwork, config.NetworkLimits)
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -374,8 +418,14 @@ func (f *Factory) ResumeSandbox(
 	if config.AllowInternetAccess != nil {
 		allowInternet = *config.AllowInternetAccess
 	}
+	config.AllowInternetAccess = &allowInternet
+
+	teamNetwork, err := f.teamNetwork(config)
+	if err != nil {
+		return nil, err
+	}
 
-	ipsCh := getNetworkSlotAsync(ctx, f.networkPool, cleanup, allowInternet)
+	ipsCh := getNetworkSlotAsync(ctx, f.networkPool, cleanup, allowInternet, config.Egress, teamNetwork, config.NetworkLimits)
 	defer func() {
 		// Ensure the slot is received from chan before ResumeSandbox returns so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -650,6 +700,44 @@ func (s *Sandbox) FirecrackerVersions() fc.FirecrackerVersions {
 	return s.process.Versions
 }
 
+// UpdateNetwork replaces the internet access setting and the egress policy of the running sandbox.
+// When the egress policy is nil, the sandbox falls back to its internet access setting.
+func (s *Sandbox) UpdateNetwork(ctx context.Context, allowInternet bool, egress *network.EgressPolicy) error {
+	ctx, span := tracer.Start(ctx, "sandbox-update-network")
+	defer span.End()
+
+	s.networkMu.Lock()
+	defer s.networkMu.Unlock()
+
+	err := s.Slot.UpdateInternet(ctx, allowInternet, egress)
+	if err != nil {
+		return fmt.Errorf("failed to update sandbox internet access: %w", err)
+	}
+
+	s.Config.AllowInternetAccess = &allowInternet
+	s.Config.Egress = egress
+
+	return nil
+}
+
+// Network returns the current internet access and egress policy of the sandbox, they can be changed by UpdateNetwork.
+func (s *Sandbox) Network() (allowInternet bool, egress *network.EgressPolicy) {
+	s.networkMu.RLock()
+	defer s.networkMu.RUnlock()
+
+	return s.Config.AllowInternetAccess == nil || *s.Config.AllowInternetAccess, s.Config.Egress
+}
+
+// PortPolicy returns the access rules of the sandbox ports, nil means all ports are public.
+func (s *Sandbox) PortPolicy() *proxy.PortPolicy {
+	return s.portPolicy.Load()
+}
+
+// SetPortPolicy replaces the access rules of the sandbox ports, it applies to the following requests.
+func (s *Sandbox) SetPortPolicy(policy *proxy.PortPolicy) {
+	s.portPolicy.Store(policy)
+}
+
 func (s *Sandbox) Pause(
 	ctx context.Context,
 	m metadata.Template,
@@ -657,6 +745,9 @@ func (s *Sandbox) Pause(
 	ctx, span := tracer.Start(ctx, "sandbox-snapshot")
 	defer span.End()
 
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
 	snapshotTemplateFiles, err := m.Template.CacheFiles()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get template files: %w", err)
@@ -761,6 +852,136 @@ func (s *Sandbox) Pause(
 	}, nil
 }
 
+// Snapshot creates the snapshot of the sandbox that keeps running after it, the VM is paused only while the snapshot is created.
+// Only the pages loaded by the sandbox are stored in the snapshot, the other pages are mapped to the original memfile.
+func (s *Sandbox) Snapshot(
+	ctx context.Context,
+	m metadata.Template,
+) (_ *Snapshot, e error) {
+	ctx, span := tracer.Start(ctx, "sandbox-live-snapshot")
+	defer span.End()
+
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	select {
+	case <-s.exit.Done():
+		return nil, errors.New("sandbox is not running")
+	default:
+	}
+
+	snapshotTemplateFiles, err := m.Template.CacheFiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template files: %w", err)
+	}
+
+	buildID, err := uuid.Parse(snapshotTemplateFiles.BuildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	originalMemfile, err := s.Template.Memfile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original memfile: %w", err)
+	}
+	originalRootfs, err := s.Template.Rootfs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original rootfs: %w", err)
+	}
+
+	if err := s.process.Pause(ctx); err != nil {
+		return nil, fmt.Errorf("failed to pause VM: %w", err)
+	}
+
+	paused := true
+	// The VM is resumed also when the snapshot fails, so the sandbox isn't lost.
+	defer func() {
+		if !paused {
+			return
+		}
+
+		err := s.process.Unpause(ctx)
+		if err != nil {
+			e = errors.Join(e, fmt.Errorf("failed to resume VM: %w", err))
+		}
+	}()
+
+	// The dirty pages have to be copied before the snapshot is created, the memory dump loads all the pages.
+	dirtyPages := s.memory.Dirty()
+
+	snapfile := template.NewLocalFileLink(snapshotTemplateFiles.CacheSnapfilePath())
+
+	memfile, err := storage.AcquireTmpMemfile(ctx, buildID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire memfile snapshot: %w", err)
+	}
+	// Close the file even if an error occurs
+	defer memfile.Close()
+
+	err = s.process.CreateSnapshot(
+		ctx,
+		snapfile.Path(),
+		memfile.Path(),
+	)
+	// The pages loaded by the memory dump weren't changed, so they don't have to be stored in the next snapshots.
+	s.memory.ResetDirty(dirtyPages)
+	if err != nil {
+		return nil, fmt.Errorf("error creating snapshot: %w", err)
+	}
+
+	rootfsDiff, rootfsDiffHeader, err := pauseProcessRootfs(
+		ctx,
+		buildID,
+		originalRootfs.Header(),
+		&RootfsSnapshotDiffCreator{
+			rootfs: s.rootfs,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while post processing: %w", err)
+	}
+
+	// The memory is already stored in the file, so the VM doesn't have to be paused while it's processed.
+	paused = false
+
+	err = s.process.Unpause(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume VM: %w", err)
+	}
+
+	memfileDiff, memfileDiffHeader, err := pauseProcessMemory(
+		ctx,
+		buildID,
+		originalMemfile.Header(),
+		&MemoryDiffCreator{
+			memfile:    memfile,
+			dirtyPages: dirtyPages,
+			blockSize:  originalMemfile.BlockSize(),
+			doneHook: func(ctx context.Context) error {
+				return memfile.Close()
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while post processing: %w", err)
+	}
+
+	metadataFileLink := template.NewLocalFileLink(snapshotTemplateFiles.CacheMetadataPath())
+	err = m.ToFile(metadataFileLink.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		Snapfile:          snapfile,
+		Metafile:          metadataFileLink,
+		MemfileDiff:       memfileDiff,
+		MemfileDiffHeader: memfileDiffHeader,
+		RootfsDiff:        rootfsDiff,
+		RootfsDiffHeader:  rootfsDiffHeader,
+	}, nil
+}
+
 func pauseProcessMemory(
 	ctx context.Context,
 	buildId uuid.UUID,
@@ -887,6 +1108,9 @@ func getNetworkSlotAsync(
 	networkPool *network.Pool,
 	cleanup *Cleanup,
 	allowInternet bool,
+	egress *network.EgressPolicy,
+	teamNetwork *network.TeamNetwork,
+	limits network.RateLimits,
 ) chan networkSlotRes {
 	ctx, span := tracer.Start(ctx, "get-network-slot")
 	defer span.End()
@@ -896,7 +1120,7 @@ func getNetworkSlotAsync(
 	go func() {
 		defer close(r)
 
-		ips, err := networkPool.Get(ctx, allowInternet)
+		ips, err := networkPool.Get(ctx, allowInternet, egress, teamNetwork, limits)
 		if err != nil {
 			r <- networkSlotRes{nil, fmt.Errorf("failed to get network slot: %w", err)}
 			return
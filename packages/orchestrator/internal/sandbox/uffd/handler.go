@@ -190,3 +190,7 @@ func (u *Uffd) Disable() error {
 func (u *Uffd) Dirty() *bitset.BitSet {
 	return u.memfile.Dirty()
 }
+
+func (u *Uffd) ResetDirty(dirty *bitset.BitSet) {
+	u.memfile.ResetDirty(dirty)
+}
@@ -11,6 +11,7 @@ import (
 type MemoryBackend interface {
 	Disable() error
 	Dirty() *bitset.BitSet
+	ResetDirty(dirty *bitset.BitSet)
 
 	Start(ctx context.Context, sandboxId string) error
 	Stop() error
@@ -39,9 +39,11 @@ func (m *NoopMemory) Disable() error {
 }
 
 func (m *NoopMemory) Dirty() *bitset.BitSet {
-	return m.dirty
+	return m.dirty.Clone()
 }
 
+func (m *NoopMemory) ResetDirty(*bitset.BitSet) {}
+
 func (m *NoopMemory) Start(ctx context.Context, sandboxId string) error {
 	return nil
 }
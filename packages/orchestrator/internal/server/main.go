@@ -10,6 +10,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/events"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/grpcserver"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
@@ -38,6 +39,7 @@ type server struct {
 	persistence       storage.StorageProvider
 	featureFlags      *featureflags.Client
 	sbxEventsService  events.EventsService[event.SandboxEvent]
+	networkUsage      *metrics.NetworkUsageReporter
 	startingSandboxes *semaphore.Weighted
 }
 
@@ -62,6 +64,7 @@ type ServiceConfig struct {
 	Persistence      storage.StorageProvider
 	FeatureFlags     *featureflags.Client
 	SbxEventsService events.EventsService[event.SandboxEvent]
+	NetworkUsage     *metrics.NetworkUsageReporter
 }
 
 func New(cfg ServiceConfig) *Service {
@@ -81,6 +84,7 @@ func New(cfg ServiceConfig) *Service {
 		persistence:       cfg.Persistence,
 		featureFlags:      cfg.FeatureFlags,
 		sbxEventsService:  cfg.SbxEventsService,
+		networkUsage:      cfg.NetworkUsage,
 		startingSandboxes: semaphore.NewWeighted(maxStartingInstancesPerNode),
 	}
 
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+)
+
+// egressPolicy converts the egress configuration of the sandbox to the policy enforced in the sandbox network.
+// It returns nil when the sandbox has no egress policy.
+func egressPolicy(config *orchestrator.SandboxNetworkConfig) (*network.EgressPolicy, error) {
+	egress := config.GetEgress()
+	if egress == nil {
+		return nil, nil
+	}
+
+	return network.NewEgressPolicy(egress.GetAllowedCidrs(), egress.GetAllowedDomains(), egress.GetAllowedPorts())
+}
+
+// rateLimits converts the rate limits of the sandbox network traffic, missing limits mean the traffic is not limited.
+func rateLimits(limits *orchestrator.SandboxNetworkLimits) network.RateLimits {
+	return network.RateLimits{
+		IngressBytesPerSecond:   limits.GetIngressBytesPerSecond(),
+		EgressBytesPerSecond:    limits.GetEgressBytesPerSecond(),
+		IngressPacketsPerSecond: limits.GetIngressPacketsPerSecond(),
+		EgressPacketsPerSecond:  limits.GetEgressPacketsPerSecond(),
+	}
+}
+
+// teamNetwork converts the team network configuration of the sandbox, it returns nil when the sandbox isn't in a team network.
+func teamNetwork(config *orchestrator.SandboxNetworkConfig, teamID string) *proxy.TeamNetwork {
+	team := config.GetTeamNetwork()
+	if team == nil {
+		return nil
+	}
+
+	return &proxy.TeamNetwork{
+		TeamID: teamID,
+		Group:  team.GetGroup(),
+	}
+}
+
+// portPolicy converts the port access rules of the sandbox, it returns nil when all ports are public and there are no credentials for the tunnels.
+func portPolicy(config *orchestrator.SandboxNetworkIngressConfig, accessToken *string) *proxy.PortPolicy {
+	// The credentials are kept even without rules, the tunnels require them.
+	if len(config.GetPorts()) == 0 && accessToken == nil && len(config.GetApiKeyHashes()) == 0 {
+		return nil
+	}
+
+	policy := &proxy.PortPolicy{
+		Ports:        make(map[uint64]proxy.PortVisibility, len(config.GetPorts())),
+		APIKeyHashes: config.GetApiKeyHashes(),
+	}
+
+	if accessToken != nil {
+		policy.AccessTokenHash = proxy.HashAccessToken(*accessToken)
+	}
+
+	for _, rule := range config.GetPorts() {
+		switch rule.GetVisibility() {
+		case orchestrator.SandboxPortVisibility_PortPublic:
+			policy.Ports[uint64(rule.GetPort())] = proxy.PortVisibilityPublic
+		case orchestrator.SandboxPortVisibility_PortAccessToken:
+			policy.Ports[uint64(rule.GetPort())] = proxy.PortVisibilityAccessToken
+		case orchestrator.SandboxPortVisibility_PortApiKey:
+			policy.Ports[uint64(rule.GetPort())] = proxy.PortVisibilityAPIKey
+		default:
+			// Unknown rules block the port, so a newer API can't expose a port by mistake.
+			policy.Ports[uint64(rule.GetPort())] = proxy.PortVisibilityBlocked
+		}
+	}
+
+	return policy
+}
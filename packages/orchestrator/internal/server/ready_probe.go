@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/readiness"
+	"github.com/e2b-dev/infra/packages/shared/pkg/events/event"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+)
+
+// runReadyProbe evaluates the ready probe of the sandbox template after the sandbox is started or resumed.
+// The probe failure is reported as a lifecycle event of the sandbox, the sandbox itself keeps running.
+func (s *server) runReadyProbe(ctx context.Context, sbx *sandbox.Sandbox, trigger clickhouse.SandboxEventLabel) {
+	meta, err := sbx.Template.Metadata()
+	if err != nil {
+		sbxlogger.I(sbx).Error("failed to get template metadata for the ready probe", zap.Error(err))
+
+		return
+	}
+
+	if meta.Start == nil || meta.Start.ReadyProbe == nil {
+		return
+	}
+
+	probe := *meta.Start.ReadyProbe
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Stop the probe when the sandbox exits, the failed checks of the stopped sandbox are not reported.
+	go func() {
+		sbx.Wait(ctx)
+		cancel()
+	}()
+
+	host := sbx.Slot.HostIPString()
+	runCommand := readiness.EnvdCommand(host, sbx.Config.Envd.AccessToken, meta.Start.Context)
+
+	attempts, err := readiness.Wait(ctx, probe, host, runCommand, nil)
+	if err == nil {
+		sbxlogger.I(sbx).Debug("sandbox is ready", zap.Int("attempts", attempts))
+
+		return
+	}
+
+	// The sandbox exited before it was ready
+	if ctx.Err() != nil {
+		return
+	}
+
+	sbxlogger.I(sbx).Warn("sandbox ready probe failed", zap.String("probe_type", readiness.Type(probe)), zap.Int("attempts", attempts), zap.Error(err))
+
+	teamID, buildId, eventData := s.prepareSandboxEventData(sbx)
+	eventData["probe_type"] = readiness.Type(probe)
+	eventData["trigger"] = string(trigger)
+	eventData["attempts"] = attempts
+	eventData["error"] = err.Error()
+
+	s.sbxEventsService.HandleEvent(context.WithoutCancel(ctx), event.SandboxEvent{
+		Timestamp:          time.Now().UTC(),
+		SandboxID:          sbx.Runtime.SandboxID,
+		SandboxExecutionID: sbx.Runtime.ExecutionID,
+		SandboxTemplateID:  sbx.Config.BaseTemplateID,
+		SandboxBuildID:     buildId,
+		SandboxTeamID:      teamID,
+		EventCategory:      string(clickhouse.SandboxEventCategoryLifecycle),
+		EventLabel:         string(clickhouse.SandboxEventLabelReadyProbeFailed),
+		EventData:          eventData,
+	})
+}
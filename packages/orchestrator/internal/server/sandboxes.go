@@ -24,6 +24,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -67,6 +68,11 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			Build(),
 	)
 
+	egress, err := egressPolicy(req.GetSandbox().GetNetwork())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sandbox network configuration: %s", err)
+	}
+
 	maxRunningSandboxesPerNode, err := s.featureFlags.IntFlag(ctx, featureflags.MaxSandboxesPerNode)
 	if err != nil {
 		zap.L().Error("Failed to get MaxSandboxesPerNode flag", zap.Error(err))
@@ -111,6 +117,9 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			HugePages:       req.GetSandbox().GetHugePages(),
 
 			AllowInternetAccess: req.GetSandbox().AllowInternetAccess,
+			Egress:              egress,
+			NetworkLimits:       rateLimits(req.GetSandbox().GetNetworkLimits()),
+			TeamNetwork:         teamNetwork(req.GetSandbox().GetNetwork(), req.GetSandbox().GetTeamId()),
 
 			Envd: sandbox.EnvdMetadata{
 				Version:     req.GetSandbox().GetEnvdVersion(),
@@ -135,7 +144,10 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		return nil, status.Errorf(codes.Internal, "failed to create sandbox: %s", err)
 	}
 
+	sbx.SetPortPolicy(portPolicy(req.GetSandbox().GetNetwork().GetIngress(), req.GetSandbox().EnvdAccessToken))
+
 	s.sandboxes.Insert(req.GetSandbox().GetSandboxId(), sbx)
+	s.networkUsage.Add(sbx)
 	go func() {
 		ctx, childSpan := tracer.Start(context.WithoutCancel(ctx), "sandbox-create-stop", trace.WithNewRoot())
 		defer childSpan.End()
@@ -145,6 +157,9 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			sbxlogger.I(sbx).Error("failed to wait for sandbox, cleaning up", zap.Error(waitErr))
 		}
 
+		// The slot traffic counters are reset when the slot is returned to the pool during the cleanup.
+		s.networkUsage.Remove(sbx)
+
 		cleanupErr := sbx.Close(ctx)
 		if cleanupErr != nil {
 			sbxlogger.I(sbx).Error("failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
@@ -190,6 +205,8 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		EventData:          eventData,
 	})
 
+	go s.runReadyProbe(context.WithoutCancel(ctx), sbx, label)
+
 	return &orchestrator.SandboxCreateResponse{
 		ClientId: s.info.ClientId,
 	}, nil
@@ -211,10 +228,64 @@ func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 		return nil, status.Error(codes.NotFound, "sandbox not found")
 	}
 
-	sbx.EndAt = req.GetEndTime().AsTime()
-
 	teamID, buildId, eventData := s.prepareSandboxEventData(sbx)
-	eventData["set_timeout"] = req.GetEndTime().AsTime().Format(time.RFC3339)
+
+	if req.Network != nil || req.AllowInternetAccess != nil {
+		allowInternet, egress := sbx.Network()
+		if req.AllowInternetAccess != nil {
+			allowInternet = req.GetAllowInternetAccess()
+		}
+
+		if req.Network != nil {
+			var err error
+			egress, err = egressPolicy(req.GetNetwork())
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid sandbox network configuration: %s", err)
+			}
+		}
+
+		err := sbx.UpdateNetwork(ctx, allowInternet, egress)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "failed to update sandbox network", err)
+
+			return nil, status.Errorf(codes.Internal, "failed to update sandbox network: %s", err)
+		}
+
+		if sbx.APIStoredConfig != nil {
+			sbx.APIStoredConfig.AllowInternetAccess = &allowInternet
+			if req.Network != nil {
+				sbx.APIStoredConfig.Network = req.GetNetwork()
+			}
+		}
+
+		eventData["allow_internet_access"] = allowInternet
+		eventData["egress_policy"] = egress != nil
+	}
+
+	// The network configuration contains the port rules too, so they are replaced with it.
+	if req.Ingress != nil || req.Network != nil {
+		ingress := req.GetIngress()
+		if ingress == nil {
+			ingress = req.GetNetwork().GetIngress()
+		}
+
+		sbx.SetPortPolicy(portPolicy(ingress, sbx.Config.Envd.AccessToken))
+
+		if sbx.APIStoredConfig != nil && req.Ingress != nil {
+			if sbx.APIStoredConfig.Network == nil {
+				sbx.APIStoredConfig.Network = &orchestrator.SandboxNetworkConfig{}
+			}
+
+			sbx.APIStoredConfig.Network.Ingress = ingress
+		}
+
+		eventData["port_rules"] = len(ingress.GetPorts())
+	}
+
+	if req.GetEndTime() != nil {
+		sbx.EndAt = req.GetEndTime().AsTime()
+		eventData["set_timeout"] = req.GetEndTime().AsTime().Format(time.RFC3339)
+	}
 
 	go s.sbxEventsService.HandleEvent(context.WithoutCancel(ctx), event.SandboxEvent{
 		Timestamp:          time.Now().UTC(),
@@ -358,22 +429,181 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 		}()
 	}(context.WithoutCancel(ctx))
 
+	err := s.snapshotSandbox(ctx, sbx, in.GetBuildId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, buildId, eventData := s.prepareSandboxEventData(sbx)
+
+	go s.sbxEventsService.HandleEvent(context.WithoutCancel(ctx), event.SandboxEvent{
+		Timestamp:          time.Now().UTC(),
+		SandboxID:          sbx.Runtime.SandboxID,
+		SandboxExecutionID: sbx.Runtime.ExecutionID,
+		SandboxTemplateID:  sbx.Config.BaseTemplateID,
+		SandboxBuildID:     buildId,
+		SandboxTeamID:      teamID,
+		EventCategory:      string(clickhouse.SandboxEventCategoryLifecycle),
+		EventLabel:         string(clickhouse.SandboxEventLabelPause),
+		EventData:          eventData,
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) Fork(ctx context.Context, in *orchestrator.SandboxForkRequest) (*orchestrator.SandboxForkResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-fork")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.GetSandboxId()),
+		attribute.String("client.id", s.info.ClientId),
+		attribute.Int("fork.count", len(in.GetSandboxes())),
+	)
+
+	// setup launch darkly
+	ctx = featureflags.SetContext(
+		ctx,
+		ldcontext.NewBuilder(in.GetSandboxId()).
+			Kind(featureflags.SandboxKind).
+			SetString(featureflags.SandboxTemplateAttribute, in.GetTemplateId()).
+			Build(),
+	)
+
+	s.pauseMu.Lock()
+	sbx, ok := s.sandboxes.Get(in.GetSandboxId())
+	s.pauseMu.Unlock()
+
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	err := snapshotForkSource(
+		s.sandboxes,
+		sbx,
+		in.GetKeepSource(),
+		func() error {
+			return s.snapshotSandbox(ctx, sbx, in.GetBuildId(), true)
+		},
+		func() {
+			go func(ctx context.Context) {
+				ctx, childSpan := tracer.Start(ctx, "sandbox-fork-stop", trace.WithNewRoot())
+				defer childSpan.End()
+
+				err := sbx.Stop(ctx)
+				if err != nil {
+					sbxlogger.I(sbx).Error("error stopping sandbox after fork snapshot", logger.WithSandboxID(in.GetSandboxId()), zap.Error(err))
+				}
+			}(context.WithoutCancel(ctx))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, buildId, eventData := s.prepareSandboxEventData(sbx)
+	eventData["fork_count"] = len(in.GetSandboxes())
+
+	go s.sbxEventsService.HandleEvent(context.WithoutCancel(ctx), event.SandboxEvent{
+		Timestamp:          time.Now().UTC(),
+		SandboxID:          sbx.Runtime.SandboxID,
+		SandboxExecutionID: sbx.Runtime.ExecutionID,
+		SandboxTemplateID:  sbx.Config.BaseTemplateID,
+		SandboxBuildID:     buildId,
+		SandboxTeamID:      teamID,
+		EventCategory:      string(clickhouse.SandboxEventCategoryLifecycle),
+		EventLabel:         string(clickhouse.SandboxEventLabelFork),
+		EventData:          eventData,
+	})
+
+	// All the copies are resumed from the same cached snapshot, so they share its header mappings and diffs.
+	// The sandboxes are started one by one to not exhaust the starting sandboxes limit on the node.
+	sandboxIDs := make([]string, 0, len(in.GetSandboxes()))
+	var errs []error
+	for _, config := range in.GetSandboxes() {
+		config.BuildId = in.GetBuildId()
+		config.Snapshot = true
+
+		_, err := s.Create(ctx, &orchestrator.SandboxCreateRequest{
+			Sandbox:   config,
+			StartTime: in.GetStartTime(),
+			EndTime:   in.GetEndTime(),
+		})
+		if err != nil {
+			zap.L().Error("failed to resume forked sandbox", logger.WithSandboxID(config.GetSandboxId()), zap.String("source_sandbox_id", in.GetSandboxId()), zap.Error(err))
+			errs = append(errs, fmt.Errorf("sandbox '%s': %w", config.GetSandboxId(), err))
+
+			continue
+		}
+
+		sandboxIDs = append(sandboxIDs, config.GetSandboxId())
+	}
+
+	if len(sandboxIDs) == 0 && len(errs) > 0 {
+		return nil, status.Errorf(codes.Internal, "error resuming forked sandboxes: %s", errors.Join(errs...))
+	}
+
+	return &orchestrator.SandboxForkResponse{
+		ClientId:   s.info.ClientId,
+		SandboxIds: sandboxIDs,
+	}, nil
+}
+
+// snapshotForkSource snapshots the source of the fork, the source is removed and stopped only after the snapshot succeeds
+// and only when it isn't kept, so a failed fork leaves the source running.
+func snapshotForkSource(
+	sandboxes *smap.Map[*sandbox.Sandbox],
+	sbx *sandbox.Sandbox,
+	keepSource bool,
+	snapshot func() error,
+	stop func(),
+) error {
+	err := snapshot()
+	if err != nil {
+		return err
+	}
+
+	if keepSource {
+		return nil
+	}
+
+	// The sandbox could have been removed by the kill in the meantime.
+	sandboxes.RemoveCb(sbx.Runtime.SandboxID, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+		return exists && v == sbx
+	})
+
+	stop()
+
+	return nil
+}
+
+// snapshotSandbox snapshots the sandbox, stores the snapshot in the template cache under the build ID
+// and uploads it to the storage in the background.
+// The running sandbox is only briefly paused when keepRunning is set, otherwise it can't be used after the snapshot.
+func (s *server) snapshotSandbox(ctx context.Context, sbx *sandbox.Sandbox, buildID string, keepRunning bool) error {
 	meta, err := sbx.Template.Metadata()
 	if err != nil {
-		return nil, fmt.Errorf("no metadata found in template: %w", err)
+		return fmt.Errorf("no metadata found in template: %w", err)
 	}
 
 	fcVersions := sbx.FirecrackerVersions()
 	meta = meta.SameVersionTemplate(storage.TemplateFiles{
-		BuildID:            in.GetBuildId(),
+		BuildID:            buildID,
 		KernelVersion:      fcVersions.KernelVersion,
 		FirecrackerVersion: fcVersions.FirecrackerVersion,
 	})
-	snapshot, err := sbx.Pause(ctx, meta)
+	snapshotFn := sbx.Pause
+	if keepRunning {
+		snapshotFn = sbx.Snapshot
+	}
+
+	snapshot, err := snapshotFn(ctx, meta)
 	if err != nil {
-		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(in.GetSandboxId()))
+		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(sbx.Runtime.SandboxID))
 
-		return nil, status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", in.GetSandboxId(), err)
+		return status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", sbx.Runtime.SandboxID, err)
 	}
 
 	err = s.templateCache.AddSnapshot(
@@ -391,7 +621,7 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, "error adding snapshot to template cache", err)
 
-		return nil, status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
+		return status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
 	}
 
 	telemetry.ReportEvent(ctx, "added snapshot to template cache")
@@ -405,21 +635,7 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 		}
 	}(context.WithoutCancel(ctx))
 
-	teamID, buildId, eventData := s.prepareSandboxEventData(sbx)
-
-	go s.sbxEventsService.HandleEvent(context.WithoutCancel(ctx), event.SandboxEvent{
-		Timestamp:          time.Now().UTC(),
-		SandboxID:          sbx.Runtime.SandboxID,
-		SandboxExecutionID: sbx.Runtime.ExecutionID,
-		SandboxTemplateID:  sbx.Config.BaseTemplateID,
-		SandboxBuildID:     buildId,
-		SandboxTeamID:      teamID,
-		EventCategory:      string(clickhouse.SandboxEventCategoryLifecycle),
-		EventLabel:         string(clickhouse.SandboxEventLabelPause),
-		EventData:          eventData,
-	})
-
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 // Extracts common data needed for sandbox events
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -85,3 +86,92 @@ func Test_server_List(t *testing.T) {
 		})
 	}
 }
+
+func Test_snapshotForkSource(t *testing.T) {
+	errSnapshot := errors.New("snapshot failed")
+
+	tests := []struct {
+		name        string
+		keepSource  bool
+		snapshotErr error
+		wantRunning bool
+		wantStopped bool
+	}{
+		{
+			name:        "should keep the source running",
+			keepSource:  true,
+			wantRunning: true,
+		},
+		{
+			name:        "should stop the source after the snapshot",
+			keepSource:  false,
+			wantStopped: true,
+		},
+		{
+			name:        "should keep the source running when the snapshot fails",
+			keepSource:  false,
+			snapshotErr: errSnapshot,
+			wantRunning: true,
+		},
+		{
+			name:        "should keep the kept source running when the snapshot fails",
+			keepSource:  true,
+			snapshotErr: errSnapshot,
+			wantRunning: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sandboxes := smap.New[*sandbox.Sandbox]()
+			sbx := &sandbox.Sandbox{
+				Metadata: &sandbox.Metadata{
+					Runtime: sandbox.RuntimeMetadata{
+						SandboxID: id.Generate(),
+					},
+				},
+			}
+			sandboxes.Insert(sbx.Runtime.SandboxID, sbx)
+
+			stopped := false
+			err := snapshotForkSource(
+				sandboxes,
+				sbx,
+				tt.keepSource,
+				func() error { return tt.snapshotErr },
+				func() { stopped = true },
+			)
+			if !errors.Is(err, tt.snapshotErr) {
+				t.Errorf("snapshotForkSource() error = %v, want %v", err, tt.snapshotErr)
+			}
+
+			_, running := sandboxes.Get(sbx.Runtime.SandboxID)
+			if running != tt.wantRunning {
+				t.Errorf("snapshotForkSource() running = %v, want %v", running, tt.wantRunning)
+			}
+
+			if stopped != tt.wantStopped {
+				t.Errorf("snapshotForkSource() stopped = %v, want %v", stopped, tt.wantStopped)
+			}
+		})
+	}
+}
+
+func Test_snapshotForkSource_RemovedInMeantime(t *testing.T) {
+	sandboxes := smap.New[*sandbox.Sandbox]()
+	sandboxID := id.Generate()
+
+	sbx := &sandbox.Sandbox{Metadata: &sandbox.Metadata{Runtime: sandbox.RuntimeMetadata{SandboxID: sandboxID}}}
+	// A new execution of the same sandbox was started while the snapshot was created.
+	other := &sandbox.Sandbox{Metadata: &sandbox.Metadata{Runtime: sandbox.RuntimeMetadata{SandboxID: sandboxID}}}
+	sandboxes.Insert(sandboxID, other)
+
+	err := snapshotForkSource(sandboxes, sbx, false, func() error { return nil }, func() {})
+	if err != nil {
+		t.Fatalf("snapshotForkSource() error = %v", err)
+	}
+
+	got, ok := sandboxes.Get(sandboxID)
+	if !ok || got != other {
+		t.Errorf("snapshotForkSource() removed the other sandbox execution")
+	}
+}
@@ -1,6 +1,8 @@
 package buildcontext
 
 import (
+	"fmt"
+
 	"golang.org/x/sync/errgroup"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
@@ -14,4 +16,17 @@ type BuildContext struct {
 	EnvdVersion    string
 	CacheScope     string
 	IsV1Build      bool
+	// Stage is the name of the stage of the multi-stage build, empty when building the template itself.
+	Stage string
+	// GitCommitSHA is the commit the git source was resolved to, empty when the template isn't built from a git repository.
+	GitCommitSHA string
+}
+
+// StagePrefix prefixes the phase with the name of the stage being built.
+func (bc BuildContext) StagePrefix(prefix string) string {
+	if bc.Stage == "" {
+		return prefix
+	}
+
+	return fmt.Sprintf("stage %s %s", bc.Stage, prefix)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -19,17 +21,20 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/commands"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/envd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/gitsource"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/layer"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/base"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/finalize"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/steps"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/writer"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/constants"
 	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/dockerhub"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
@@ -52,6 +57,7 @@ type Builder struct {
 	sandboxes           *smap.Map[*sandbox.Sandbox]
 	templateCache       *sbxtemplate.Cache
 	metrics             *metrics.BuildMetrics
+	nodeID              string
 }
 
 func NewBuilder(
@@ -65,6 +71,7 @@ func NewBuilder(
 	sandboxes *smap.Map[*sandbox.Sandbox],
 	templateCache *sbxtemplate.Cache,
 	buildMetrics *metrics.BuildMetrics,
+	nodeID string,
 ) *Builder {
 	return &Builder{
 		logger:              logger,
@@ -77,12 +84,15 @@ func NewBuilder(
 		sandboxes:           sandboxes,
 		templateCache:       templateCache,
 		metrics:             buildMetrics,
+		nodeID:              nodeID,
 	}
 }
 
 type Result struct {
 	EnvdVersion  string
 	RootfsSizeMB int64
+	// GitCommitSHA is the commit of the git source the template was built from.
+	GitCommitSHA string
 }
 
 // Build builds the template, uploads it to storage and returns the result metadata.
@@ -99,6 +109,7 @@ type Result struct {
 //
 // 8. Snapshot
 // 9. Upload template (and all not yet uploaded layers)
+// 10. Save the provenance record and the SBOM of the template
 func (b *Builder) Build(ctx context.Context, template storage.TemplateFiles, config config.TemplateConfig, logsCore zapcore.Core) (r *Result, e error) {
 	ctx, childSpan := tracer.Start(ctx, "build")
 	defer childSpan.End()
@@ -124,13 +135,24 @@ func (b *Builder) Build(ctx context.Context, template storage.TemplateFiles, con
 	// Validate template, update force layers if needed
 	config = forceSteps(config)
 
-	isV1Build := config.FromImage == "" && config.FromTemplate == nil
+	isV1Build := config.FromImage == "" && config.FromTemplate == nil && config.FromGit == nil
+
+	// The build secrets and the repository credentials must never be printed to the build logs
+	redacted := slices.Collect(maps.Values(config.Secrets))
+	if password := gitsource.URLPassword(config.FromGit.GetUrl()); password != "" {
+		redacted = append(redacted, password)
+	}
+	logsCore = writer.NewRedactor(logsCore, redacted)
 
 	logger := zap.New(logsCore)
 	defer func() {
-		if e != nil {
+		switch {
+		case errors.Is(e, context.Canceled):
+			// The build sandbox is already torn down when the cancelled build returns
+			logger.Warn("Build was cancelled")
+		case e != nil:
 			logger.Error(fmt.Sprintf("Build failed: %v", e))
-		} else {
+		default:
 			logger.Info(fmt.Sprintf("Build finished, took %s",
 				time.Since(startTime).Truncate(time.Second).String()))
 		}
@@ -208,6 +230,30 @@ func runBuild(
 		index,
 	)
 
+	stageLayers := stages.New(
+		bc,
+		builder.proxy,
+		builder.sandboxFactory,
+		layerExecutor,
+	)
+
+	commandExecutor := commands.NewCommandExecutor(
+		bc,
+		builder.buildStorage,
+		builder.proxy,
+		stageLayers,
+	)
+
+	bc, err := runGitSource(ctx, userLogger, bc, builder, layerExecutor, commandExecutor, index, stageLayers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = runStages(ctx, userLogger, bc, builder, layerExecutor, commandExecutor, index, stageLayers)
+	if err != nil {
+		return nil, err
+	}
+
 	baseBuilder := base.New(
 		bc,
 		builder.logger,
@@ -221,12 +267,6 @@ func runBuild(
 		builder.sandboxFactory,
 	)
 
-	commandExecutor := commands.NewCommandExecutor(
-		bc,
-		builder.buildStorage,
-		builder.proxy,
-	)
-
 	stepBuilders := steps.CreateStepPhases(
 		bc,
 		builder.sandboxFactory,
@@ -236,6 +276,7 @@ func runBuild(
 		commandExecutor,
 		index,
 		builder.metrics,
+		stageLayers,
 	)
 
 	postProcessingBuilder := finalize.New(
@@ -253,7 +294,8 @@ func runBuild(
 	builders = append(builders, stepBuilders...)
 	builders = append(builders, postProcessingBuilder)
 
-	lastLayerResult, err := phases.Run(ctx, userLogger, bc, builder.metrics, builders)
+	recorder := &stepRecorder{}
+	lastLayerResult, err := phases.Run(ctx, userLogger, bc, builder.metrics, recorder.wrap(builders))
 	if err != nil {
 		return nil, err
 	}
@@ -273,12 +315,103 @@ func runBuild(
 	}
 	zap.L().Info("rootfs size", zap.Uint64("size", rootfsSize))
 
+	err = writeProvenance(ctx, bc, builder, lastLayerResult, recorder.steps)
+	if err != nil {
+		return nil, fmt.Errorf("error writing build provenance: %w", err)
+	}
+
+	// The template is usable without the SBOM, so the build doesn't fail when the packages can't be listed
+	err = writeSBOM(ctx, userLogger, bc, builder, layerExecutor, lastLayerResult)
+	if errors.Is(err, context.Canceled) {
+		return nil, err
+	}
+	if err != nil {
+		userLogger.Warn(fmt.Sprintf("Failed to generate SBOM: %v", err))
+	}
+
 	return &Result{
 		EnvdVersion:  bc.EnvdVersion,
 		RootfsSizeMB: int64(rootfsSize >> constants.ToMBShift),
+		GitCommitSHA: bc.GitCommitSHA,
 	}, nil
 }
 
+// runStages builds the stages of the multi-stage build in order, so the later stages and the template steps can copy files from them.
+// Each stage is cached the same way as the template layers.
+func runStages(
+	ctx context.Context,
+	userLogger *zap.Logger,
+	bc buildcontext.BuildContext,
+	builder *Builder,
+	layerExecutor *layer.LayerExecutor,
+	commandExecutor *commands.CommandExecutor,
+	index cache.Index,
+	stageLayers *stages.Stages,
+) error {
+	for _, stage := range bc.Config.Stages {
+		if stage.GetName() == "" {
+			return errors.New("stage name must not be empty")
+		}
+
+		stageContext := bc
+		stageContext.Stage = stage.GetName()
+		stageContext.Config = stageConfig(bc.Config, stage)
+
+		baseBuilder := base.New(
+			stageContext,
+			builder.logger,
+			builder.proxy,
+			builder.templateStorage,
+			builder.artifactRegistry,
+			builder.dockerhubRepository,
+			layerExecutor,
+			index,
+			builder.metrics,
+			builder.sandboxFactory,
+		)
+
+		stepBuilders := steps.CreateStepPhases(
+			stageContext,
+			builder.sandboxFactory,
+			builder.logger,
+			builder.proxy,
+			layerExecutor,
+			commandExecutor,
+			index,
+			builder.metrics,
+			stageLayers,
+		)
+
+		builders := []phases.BuilderPhase{
+			baseBuilder,
+		}
+		builders = append(builders, stepBuilders...)
+
+		stageLayer, err := phases.Run(ctx, userLogger, stageContext, builder.metrics, builders)
+		if err != nil {
+			return err
+		}
+
+		err = stageLayers.Add(stage.GetName(), stageLayer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stageConfig returns the configuration of the stage, the resources and the registry authentication are shared with the template.
+func stageConfig(template config.TemplateConfig, stage *templatemanager.TemplateStage) config.TemplateConfig {
+	stageTemplate := template
+	stageTemplate.FromImage = stage.GetFromImage()
+	stageTemplate.FromTemplate = stage.GetFromTemplate()
+	stageTemplate.Steps = stage.GetSteps()
+	stageTemplate.Stages = nil
+
+	return forceSteps(stageTemplate)
+}
+
 // forceSteps sets force for all steps after the first encounter.
 func forceSteps(template config.TemplateConfig) config.TemplateConfig {
 	shouldRebuild := template.Force != nil && *template.Force
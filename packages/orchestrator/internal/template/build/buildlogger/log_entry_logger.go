@@ -22,11 +22,15 @@ type ZapEntry struct {
 type LogEntryLogger struct {
 	mu    sync.Mutex
 	lines []*template_manager.TemplateBuildLogEntry
+	// changed is closed and replaced when new lines are written, so the log streams can wait for them
+	changed chan struct{}
+	closed  bool
 }
 
 func NewLogEntryLogger() *LogEntryLogger {
 	return &LogEntryLogger{
-		lines: make([]*template_manager.TemplateBuildLogEntry, 0),
+		lines:   make([]*template_manager.TemplateBuildLogEntry, 0),
+		changed: make(chan struct{}),
 	}
 }
 
@@ -34,6 +38,14 @@ func (b *LogEntryLogger) Write(p []byte) (n int, err error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	written := len(b.lines)
+	defer func() {
+		if len(b.lines) > written && !b.closed {
+			close(b.changed)
+			b.changed = make(chan struct{})
+		}
+	}()
+
 	for line := range bytes.SplitSeq(p, []byte("\n")) {
 		if len(line) > 0 {
 			fields, err := logs.FlatJsonLogLineParser(string(line))
@@ -100,6 +112,34 @@ func (b *LogEntryLogger) Lines() []*template_manager.TemplateBuildLogEntry {
 	return copied
 }
 
+// Close marks the logs as complete when the build has returned, no more lines are written after that.
+func (b *LogEntryLogger) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.closed = true
+	close(b.changed)
+}
+
+// LinesFrom returns the lines starting at the index, a channel that is closed when more lines are written,
+// and whether the logs are complete.
+func (b *LogEntryLogger) LinesFrom(index int) ([]*template_manager.TemplateBuildLogEntry, <-chan struct{}, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if index >= len(b.lines) {
+		return nil, b.changed, b.closed
+	}
+
+	copied := make([]*template_manager.TemplateBuildLogEntry, len(b.lines)-index)
+	copy(copied, b.lines[index:])
+	return copied, b.changed, b.closed
+}
+
 func epochToTime(epoch float64) time.Time {
 	// split into integer seconds and fractional part
 	sec := int64(epoch)
@@ -0,0 +1,42 @@
+package buildlogger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogEntryLogger_LinesFrom(t *testing.T) {
+	logger := NewLogEntryLogger()
+
+	lines, changed, complete := logger.LinesFrom(0)
+	assert.Empty(t, lines)
+	assert.False(t, complete)
+
+	_, err := logger.Write([]byte(`{"ts":1700000000.5,"msg":"first","level":"info"}` + "\n" + `{"ts":1700000001,"msg":"second","level":"warn"}` + "\n"))
+	require.NoError(t, err)
+
+	select {
+	case <-changed:
+	default:
+		t.Fatal("expected the changed channel to be closed after write")
+	}
+
+	lines, changed, complete = logger.LinesFrom(1)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "second", lines[0].GetMessage())
+	assert.False(t, complete)
+
+	logger.Close()
+
+	select {
+	case <-changed:
+	default:
+		t.Fatal("expected the changed channel to be closed after close")
+	}
+
+	lines, _, complete = logger.LinesFrom(2)
+	assert.Empty(t, lines)
+	assert.True(t, complete)
+}
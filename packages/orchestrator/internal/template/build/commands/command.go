@@ -21,3 +21,8 @@ type Command interface {
 		cmdMetadata metadata.Context,
 	) (metadata.Context, error)
 }
+
+// StageFiles provides the files of the already built stages of the multi-stage build.
+type StageFiles interface {
+	ExportFiles(ctx context.Context, userLogger *zap.Logger, stage string, sourcePath string, targetPath string) error
+}
@@ -11,6 +11,7 @@ import (
 	txtTemplate "text/template"
 
 	"github.com/bmatcuk/doublestar/v4"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
@@ -24,6 +25,7 @@ import (
 type Copy struct {
 	FilesStorage storage.StorageProvider
 	CacheScope   string
+	Stages       StageFiles
 }
 
 var _ Command = (*Copy)(nil)
@@ -76,7 +78,8 @@ fi
 
 // Execute implements the Copy command.
 // It works in the following steps:
-// 1) Downloads the layer tar file from the storage to the local filesystem
+// 1) Downloads the layer tar file from the storage to the local filesystem,
+// or exports the source path from a sandbox of the stage when the step copies from a stage
 // 2) Copies the file to the sandbox's /tmp directory
 // 3) Extracts it (still in the /tmp directory)
 // 4) Moves the extracted files to the target path in the sandbox
@@ -101,25 +104,6 @@ func (c *Copy) Execute(
 		return metadata.Context{}, fmt.Errorf("%s requires a local path and a container path argument", cmdType)
 	}
 
-	if step.FilesHash == nil || step.GetFilesHash() == "" {
-		return metadata.Context{}, fmt.Errorf("%s requires files hash to be set", cmdType)
-	}
-
-	// 1) Download the layer tar file from the storage to the local filesystem
-	obj, err := c.FilesStorage.OpenObject(ctx, paths.GetLayerFilesCachePath(c.CacheScope, step.GetFilesHash()))
-	if err != nil {
-		return metadata.Context{}, fmt.Errorf("failed to open files object from storage: %w", err)
-	}
-
-	pr, pw := io.Pipe()
-	// Start writing tar data to the pipe writer in a goroutine
-	go func() {
-		defer pw.Close()
-		if _, err := obj.WriteTo(ctx, pw); err != nil {
-			pw.CloseWithError(err)
-		}
-	}()
-
 	tmpFile, err := os.CreateTemp("", "layer-file-*.tar")
 	if err != nil {
 		return metadata.Context{}, fmt.Errorf("failed to create temporary file for layer tar: %w", err)
@@ -127,21 +111,44 @@ func (c *Copy) Execute(
 	defer os.Remove(tmpFile.Name())
 	defer tmpFile.Close()
 
-	_, err = io.Copy(tmpFile, pr)
-	if err != nil {
-		return metadata.Context{}, fmt.Errorf("failed to copy layer tar data to temporary file: %w", err)
+	// The name of the archive in the sandbox
+	var archiveID string
+	if fromStage := step.GetFromStage(); fromStage != "" {
+		if c.Stages == nil {
+			return metadata.Context{}, fmt.Errorf("%s from the stage %s requires a multi-stage build", cmdType, fromStage)
+		}
+
+		// 1) Export the files from the stage to the local filesystem
+		err = c.Stages.ExportFiles(ctx, logger, fromStage, args[0], tmpFile.Name())
+		if err != nil {
+			return metadata.Context{}, fmt.Errorf("failed to export files from stage: %w", err)
+		}
+
+		archiveID = fmt.Sprintf("stage-%s", uuid.NewString())
+	} else {
+		if step.FilesHash == nil || step.GetFilesHash() == "" {
+			return metadata.Context{}, fmt.Errorf("%s requires files hash to be set", cmdType)
+		}
+
+		// 1) Download the layer tar file from the storage to the local filesystem
+		err = c.downloadLayerFiles(ctx, step.GetFilesHash(), tmpFile)
+		if err != nil {
+			return metadata.Context{}, err
+		}
+
+		archiveID = step.GetFilesHash()
 	}
 
 	// The file is automatically cleaned up by the sandbox restart in the last step.
 	// This is happening because the /tmp is mounted as a tmpfs and deleted on restart.
-	sbxTargetPath := filepath.Join("/tmp", fmt.Sprintf("%s.tar", step.GetFilesHash()))
+	sbxTargetPath := filepath.Join("/tmp", fmt.Sprintf("%s.tar", archiveID))
 	// 2) Copy the tar file to the sandbox
 	err = sandboxtools.CopyFile(ctx, proxy, sandboxID, cmdMetadata.User, tmpFile.Name(), sbxTargetPath)
 	if err != nil {
 		return metadata.Context{}, fmt.Errorf("failed to copy layer tar data to sandbox: %w", err)
 	}
 
-	sbxUnpackPath := filepath.Join("/tmp", step.GetFilesHash())
+	sbxUnpackPath := filepath.Join("/tmp", archiveID)
 
 	// 3) Extract the tar file in the sandbox's /tmp directory
 	err = sandboxtools.RunCommand(
@@ -220,6 +227,29 @@ func (c *Copy) Execute(
 	return cmdMetadata, nil
 }
 
+func (c *Copy) downloadLayerFiles(ctx context.Context, filesHash string, file *os.File) error {
+	obj, err := c.FilesStorage.OpenObject(ctx, paths.GetLayerFilesCachePath(c.CacheScope, filesHash))
+	if err != nil {
+		return fmt.Errorf("failed to open files object from storage: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	// Start writing tar data to the pipe writer in a goroutine
+	go func() {
+		defer pw.Close()
+		if _, err := obj.WriteTo(ctx, pw); err != nil {
+			pw.CloseWithError(err)
+		}
+	}()
+
+	_, err = io.Copy(file, pr)
+	if err != nil {
+		return fmt.Errorf("failed to copy layer tar data to temporary file: %w", err)
+	}
+
+	return nil
+}
+
 func ensureTrailingSlash(s string) string {
 	if strings.HasSuffix(s, "/") {
 		return s
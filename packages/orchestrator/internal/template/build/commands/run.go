@@ -3,6 +3,10 @@ package commands
 import (
 	"context"
 	"fmt"
+	"maps"
+	"os"
+	"path"
+	"strings"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -11,9 +15,16 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
 	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
-type Run struct{}
+// defaultSecretsDir is the directory of the secret files mounted without the target path
+const defaultSecretsDir = "/run/secrets"
+
+type Run struct {
+	// Secrets are the build secrets by their ID, only the secrets mounted by the step are exposed to it.
+	Secrets map[string]string
+}
 
 var _ Command = (*Run)(nil)
 
@@ -39,8 +50,14 @@ func (r *Run) Execute(
 		cmdMetadata.User = args[1]
 	}
 
+	cmdMetadata, cleanup, err := r.mountSecrets(ctx, proxy, sandboxID, step.GetSecrets(), cmdMetadata)
+	defer cleanup()
+	if err != nil {
+		return metadata.Context{}, err
+	}
+
 	cmd := args[0]
-	err := sandboxtools.RunCommandWithLogger(
+	err = sandboxtools.RunCommandWithLogger(
 		ctx,
 		proxy,
 		logger,
@@ -56,3 +73,141 @@ func (r *Run) Execute(
 
 	return originalMetadata, nil
 }
+
+// mountSecrets exposes the secrets to the step as the environment variables or files.
+// The environment variables are set only for the command, the returned cleanup removes the secret files before the layer is saved.
+func (r *Run) mountSecrets(
+	ctx context.Context,
+	proxy *proxy.SandboxProxy,
+	sandboxID string,
+	mounts []*templatemanager.TemplateSecretMount,
+	cmdMetadata metadata.Context,
+) (metadata.Context, func(), error) {
+	var files []string
+	cleanup := func() {
+		if len(files) == 0 {
+			return
+		}
+
+		err := sandboxtools.RunCommand(
+			context.WithoutCancel(ctx),
+			proxy,
+			sandboxID,
+			"rm -f -- "+strings.Join(utils.Map(files, quote), " "),
+			metadata.Context{User: "root"},
+		)
+		if err != nil {
+			zap.L().Error("failed to remove secret files", zap.Error(err), zap.String("sandbox_id", sandboxID))
+		}
+	}
+
+	if len(mounts) == 0 {
+		return cmdMetadata, cleanup, nil
+	}
+
+	envVars := maps.Clone(cmdMetadata.EnvVars)
+	if envVars == nil {
+		envVars = make(map[string]string)
+	}
+
+	for _, mount := range mounts {
+		value, ok := r.Secrets[mount.GetId()]
+		if !ok {
+			return metadata.Context{}, cleanup, fmt.Errorf("secret %q is not provided in the build request", mount.GetId())
+		}
+
+		if mount.GetEnv() != "" {
+			envVars[mount.GetEnv()] = value
+		}
+
+		// The secret is mounted as a file also when the target is set explicitly
+		if mount.GetEnv() != "" && mount.GetTarget() == "" {
+			continue
+		}
+
+		target := mount.GetTarget()
+		if target == "" {
+			target = path.Join(defaultSecretsDir, mount.GetId())
+		}
+
+		// The existing files are refused, the cleanup would remove them from the template otherwise
+		exists, err := fileExists(ctx, proxy, sandboxID, target)
+		if err != nil {
+			return metadata.Context{}, cleanup, fmt.Errorf("failed to check the target of secret %q: %w", mount.GetId(), err)
+		}
+
+		if exists {
+			return metadata.Context{}, cleanup, fmt.Errorf("target %q of secret %q already exists", target, mount.GetId())
+		}
+
+		files = append(files, target)
+		err = WriteSecretFile(ctx, proxy, sandboxID, cmdMetadata.User, target, value)
+		if err != nil {
+			return metadata.Context{}, cleanup, fmt.Errorf("failed to mount secret %q: %w", mount.GetId(), err)
+		}
+	}
+
+	cmdMetadata.EnvVars = envVars
+
+	return cmdMetadata, cleanup, nil
+}
+
+func WriteSecretFile(
+	ctx context.Context,
+	proxy *proxy.SandboxProxy,
+	sandboxID string,
+	user string,
+	target string,
+	value string,
+) error {
+	tmpFile, err := os.CreateTemp("", "build-secret-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	_, err = tmpFile.WriteString(value)
+	if err != nil {
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	// The file is written as root, so the secrets can be mounted also to the directories not writable by the user
+	err = sandboxtools.CopyFile(ctx, proxy, sandboxID, "root", tmpFile.Name(), target)
+	if err != nil {
+		return err
+	}
+
+	return sandboxtools.RunCommand(
+		ctx,
+		proxy,
+		sandboxID,
+		fmt.Sprintf("chown -- %s %s && chmod 400 -- %s", quote(user), quote(target), quote(target)),
+		metadata.Context{User: "root"},
+	)
+}
+
+// fileExists checks whether anything, including a dangling symlink, exists at the path in the sandbox.
+func fileExists(ctx context.Context, proxy *proxy.SandboxProxy, sandboxID string, filePath string) (bool, error) {
+	var stdout strings.Builder
+	err := sandboxtools.RunCommandWithOutput(
+		ctx,
+		proxy,
+		sandboxID,
+		fmt.Sprintf("if [ -e %s ] || [ -L %s ]; then echo exists; fi", quote(filePath), quote(filePath)),
+		metadata.Context{User: "root"},
+		func(out, _ string) {
+			stdout.WriteString(out)
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(stdout.String()) == "exists", nil
+}
+
+// quote quotes the argument for the shell, so it's passed to the command as is.
+func quote(arg string) string {
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
@@ -36,12 +36,18 @@ type TemplateConfig struct {
 	// Command to run to check if the template is ready.
 	ReadyCmd string
 
+	// ReadyProbe is the structured readiness check of the template, it's used instead of the ready command when set.
+	ReadyProbe *templatemanager.ReadyProbe
+
 	// FromImage is the base image to use for building the template.
 	FromImage string
 
 	// FromTemplate is the base template to use for building the template.
 	FromTemplate *templatemanager.FromTemplateConfig
 
+	// FromGit is the git repository cloned in the build sandbox, the COPY steps without the uploaded files copy from it.
+	FromGit *templatemanager.FromGitConfig
+
 	// RegistryAuthProvider provides authentication for pulling the FromImage.
 	RegistryAuthProvider auth.RegistryAuthProvider
 
@@ -50,6 +56,12 @@ type TemplateConfig struct {
 
 	// Steps to build the template.
 	Steps []*templatemanager.TemplateStep
+
+	// Stages are the named stages built before the template, their files can be copied by the COPY steps.
+	Stages []*templatemanager.TemplateStage
+
+	// Secrets are the build secrets by their ID, they are never saved to the template or printed to the build logs.
+	Secrets map[string]string
 }
 
 func MemfilePageSize(hugePages bool) int64 {
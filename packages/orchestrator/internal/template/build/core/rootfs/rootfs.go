@@ -57,6 +57,12 @@ func (mw *MultiWriter) Write(p []byte) (int, error) {
 	return len(p), nil
 }
 
+// SourceImage is the image the rootfs was created from.
+type SourceImage struct {
+	Config containerregistry.Config
+	Digest string
+}
+
 func New(
 	artifactRegistry artifactsregistry.ArtifactsRegistry,
 	dockerhubRepository dockerhub.RemoteRepository,
@@ -77,7 +83,7 @@ func (r *Rootfs) CreateExt4Filesystem(
 	rootfsPath string,
 	provisionScript string,
 	provisionLogPrefix string,
-) (c containerregistry.Config, e error) {
+) (s SourceImage, e error) {
 	childCtx, childSpan := tracer.Start(ctx, "create-ext4-file")
 	defer childSpan.End()
 
@@ -97,30 +103,36 @@ func (r *Rootfs) CreateExt4Filesystem(
 		img, err = oci.GetImage(childCtx, r.artifactRegistry, r.template.TemplateID, r.metadata.BuildID)
 	}
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error requesting docker image: %w", err)
+		return SourceImage{}, fmt.Errorf("error requesting docker image: %w", err)
+	}
+
+	// The digest is taken before the system layers are appended, so it identifies the requested image
+	digest, err := img.Digest()
+	if err != nil {
+		return SourceImage{}, fmt.Errorf("error getting image digest: %w", err)
 	}
 
 	imageSize, err := oci.GetImageSize(img)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error getting image size: %w", err)
+		return SourceImage{}, fmt.Errorf("error getting image size: %w", err)
 	}
 	logger.Info(fmt.Sprintf("Base Docker image size: %s", humanize.Bytes(uint64(imageSize))))
 
 	logger.Debug("Setting up system files")
 	layers, err := additionalOCILayers(childCtx, r.template, provisionScript, provisionLogPrefix)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error populating filesystem: %w", err)
+		return SourceImage{}, fmt.Errorf("error populating filesystem: %w", err)
 	}
 	img, err = mutate.AppendLayers(img, layers...)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error appending layers: %w", err)
+		return SourceImage{}, fmt.Errorf("error appending layers: %w", err)
 	}
 	telemetry.ReportEvent(childCtx, "set up filesystem")
 
 	logger.Info("Creating file system and pulling Docker image")
 	ext4Size, err := oci.ToExt4(ctx, logger, img, rootfsPath, maxRootfsSize, r.template.RootfsBlockSize())
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error converting oci to ext4: %w", err)
+		return SourceImage{}, fmt.Errorf("error converting oci to ext4: %w", err)
 	}
 	telemetry.ReportEvent(childCtx, "created rootfs ext4 file")
 
@@ -128,13 +140,13 @@ func (r *Rootfs) CreateExt4Filesystem(
 	// Make rootfs writable, be default it's readonly
 	err = filesystem.MakeWritable(ctx, rootfsPath)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error making rootfs file writable: %w", err)
+		return SourceImage{}, fmt.Errorf("error making rootfs file writable: %w", err)
 	}
 
 	// Resize rootfs
 	rootfsFreeSpace, err := filesystem.GetFreeSpace(ctx, rootfsPath, r.template.RootfsBlockSize())
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error getting free space: %w", err)
+		return SourceImage{}, fmt.Errorf("error getting free space: %w", err)
 	}
 	// We need to remove the remaining free space from the ext4 file size
 	// This is a residual space that could not be shrunk when creating the filesystem,
@@ -148,7 +160,7 @@ func (r *Rootfs) CreateExt4Filesystem(
 	if diskAdd > 0 {
 		_, err := filesystem.Enlarge(ctx, rootfsPath, diskAdd)
 		if err != nil {
-			return containerregistry.Config{}, fmt.Errorf("error enlarging rootfs: %w", err)
+			return SourceImage{}, fmt.Errorf("error enlarging rootfs: %w", err)
 		}
 	}
 
@@ -159,15 +171,18 @@ func (r *Rootfs) CreateExt4Filesystem(
 		zap.Error(err),
 	)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error checking ext4 filesystem integrity: %w", err)
+		return SourceImage{}, fmt.Errorf("error checking ext4 filesystem integrity: %w", err)
 	}
 
 	config, err := img.ConfigFile()
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error getting image config file: %w", err)
+		return SourceImage{}, fmt.Errorf("error getting image config file: %w", err)
 	}
 
-	return config.Config, nil
+	return SourceImage{
+		Config: config.Config,
+		Digest: digest.String(),
+	}, nil
 }
 
 func additionalOCILayers(
@@ -0,0 +1,302 @@
+package build
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/commands"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/gitsource"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/layer"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/base"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/steps"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	"github.com/e2b-dev/infra/packages/shared/pkg/dockerfile"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+const gitResolveTimeout = 10 * time.Minute
+
+// runGitSource checks out the git repository into a stage before the other stages are built,
+// the COPY steps without the uploaded files are changed to copy from the checkout.
+// The ref is resolved to the commit first, so the checkout is cached by the commit and not by the ref.
+// The returned build context has the configuration compiled from the repository Dockerfile, if it's used.
+func runGitSource(
+	ctx context.Context,
+	userLogger *zap.Logger,
+	bc buildcontext.BuildContext,
+	builder *Builder,
+	layerExecutor *layer.LayerExecutor,
+	commandExecutor *commands.CommandExecutor,
+	index cache.Index,
+	stageLayers *stages.Stages,
+) (buildcontext.BuildContext, error) {
+	gitConfig := bc.Config.FromGit
+	if gitConfig == nil {
+		return bc, nil
+	}
+
+	if gitConfig.GetUrl() == "" {
+		return bc, errors.New("git repository URL must not be empty")
+	}
+
+	err := validateStageNames(bc.Config.Stages)
+	if err != nil {
+		return bc, err
+	}
+
+	stageContext := bc
+	stageContext.Stage = gitsource.StageName
+	stageContext.Config = stageConfig(bc.Config, &templatemanager.TemplateStage{
+		Name:   gitsource.StageName,
+		Source: &templatemanager.TemplateStage_FromImage{FromImage: gitsource.Image},
+	})
+	// The image is public, the registry credentials are only for the template image
+	stageContext.Config.RegistryAuthProvider = nil
+
+	baseBuilder := base.New(
+		stageContext,
+		builder.logger,
+		builder.proxy,
+		builder.templateStorage,
+		builder.artifactRegistry,
+		builder.dockerhubRepository,
+		layerExecutor,
+		index,
+		builder.metrics,
+		builder.sandboxFactory,
+	)
+
+	baseLayer, err := phases.Run(ctx, userLogger, stageContext, builder.metrics, []phases.BuilderPhase{baseBuilder})
+	if err != nil {
+		return bc, err
+	}
+
+	commitSHA := gitConfig.GetRef()
+	if !gitsource.IsCommitSHA(commitSHA) {
+		commitSHA, err = resolveGitCommit(ctx, stageContext, builder, layerExecutor, baseLayer)
+		if err != nil {
+			return bc, err
+		}
+	}
+
+	userLogger.Info(fmt.Sprintf("Using commit %s of %s", commitSHA, gitsource.RedactedURL(gitConfig.GetUrl())))
+
+	stageContext.Config.Steps = []*templatemanager.TemplateStep{gitsource.CloneStep(gitConfig, commitSHA)}
+	stageContext.Config = forceSteps(stageContext.Config)
+
+	stepBuilders := steps.CreateStepPhases(
+		stageContext,
+		builder.sandboxFactory,
+		builder.logger,
+		builder.proxy,
+		layerExecutor,
+		commandExecutor,
+		index,
+		builder.metrics,
+		stageLayers,
+	)
+
+	stageLayer, err := phases.RunFrom(ctx, userLogger, stageContext, builder.metrics, baseLayer, stepBuilders)
+	if err != nil {
+		return bc, err
+	}
+
+	err = stageLayers.Add(gitsource.StageName, stageLayer)
+	if err != nil {
+		return bc, err
+	}
+
+	if dockerfilePath := gitsource.DockerfilePath(gitConfig); dockerfilePath != "" {
+		content, err := exportGitDockerfile(ctx, userLogger, stageLayers, dockerfilePath)
+		if err != nil {
+			return bc, err
+		}
+
+		bc.Config, err = applyGitDockerfile(bc.Config, content)
+		if err != nil {
+			return bc, err
+		}
+	}
+
+	bc.Config.Steps = gitsource.RewriteCopySteps(gitConfig, bc.Config.Steps)
+	bc.Config.Stages = gitsource.RewriteStages(gitConfig, bc.Config.Stages)
+	bc.Config = forceSteps(bc.Config)
+	bc.GitCommitSHA = commitSHA
+
+	return bc, nil
+}
+
+// resolveGitCommit lists the remote refs in a sandbox of the stage, the changes of the sandbox are discarded.
+func resolveGitCommit(
+	ctx context.Context,
+	bc buildcontext.BuildContext,
+	builder *Builder,
+	layerExecutor *layer.LayerExecutor,
+	baseLayer phases.LayerResult,
+) (string, error) {
+	ctx, span := tracer.Start(ctx, "resolve git commit")
+	defer span.End()
+
+	gitConfig := bc.Config.FromGit
+
+	sbxConfig := sandbox.Config{
+		Vcpu:      bc.Config.VCpuCount,
+		RamMB:     bc.Config.MemoryMB,
+		HugePages: bc.Config.HugePages,
+
+		Envd: sandbox.EnvdMetadata{
+			Version: bc.EnvdVersion,
+		},
+	}
+
+	sandboxCreator := layer.NewCreateSandbox(
+		sbxConfig,
+		builder.sandboxFactory,
+		gitResolveTimeout,
+		fc.FirecrackerVersions{
+			KernelVersion:      bc.Template.KernelVersion,
+			FirecrackerVersion: bc.Template.FirecrackerVersion,
+		},
+	)
+
+	var stdout, stderr bytes.Buffer
+	err := layerExecutor.RunInSandbox(
+		ctx,
+		layer.NewCacheSourceTemplateProvider(baseLayer.Metadata.Template),
+		sandboxCreator,
+		func(ctx context.Context, sbx *sandbox.Sandbox) error {
+			if secret := gitConfig.GetDeployKeySecret(); secret != "" {
+				deployKey, ok := bc.Config.Secrets[secret]
+				if !ok {
+					return fmt.Errorf("secret %q is not provided in the build request", secret)
+				}
+
+				err := commands.WriteSecretFile(ctx, builder.proxy, sbx.Runtime.SandboxID, "root", gitsource.DeployKeyPath, deployKey)
+				if err != nil {
+					return fmt.Errorf("failed to mount the deploy key: %w", err)
+				}
+			}
+
+			return sandboxtools.RunCommandWithOutput(
+				ctx,
+				builder.proxy,
+				sbx.Runtime.SandboxID,
+				gitsource.ResolveCommand(gitConfig),
+				metadata.Context{User: "root"},
+				func(out, errOut string) {
+					stdout.WriteString(out)
+					stderr.WriteString(errOut)
+				},
+			)
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the refs of the git repository: %w: %s", err, stderr.String())
+	}
+
+	return gitsource.ParseCommit(stdout.String(), gitConfig.GetRef())
+}
+
+// exportGitDockerfile reads the Dockerfile from the checkout in the stage.
+func exportGitDockerfile(
+	ctx context.Context,
+	userLogger *zap.Logger,
+	stageLayers *stages.Stages,
+	dockerfilePath string,
+) (string, error) {
+	tmpFile, err := os.CreateTemp("", "git-dockerfile-*.tar.gz")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file for the Dockerfile: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	err = stageLayers.ExportFiles(ctx, userLogger, gitsource.StageName, dockerfilePath, tmpFile.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to export the Dockerfile from the git repository: %w", err)
+	}
+
+	archive, err := os.Open(tmpFile.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to open the exported Dockerfile: %w", err)
+	}
+	defer archive.Close()
+
+	content, err := gitsource.ReadArchivedFile(archive)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the Dockerfile %s: %w", dockerfilePath, err)
+	}
+
+	return content, nil
+}
+
+// applyGitDockerfile replaces the source and the steps of the template with the ones compiled from the Dockerfile.
+// The start command and the ready probe of the request take precedence over the Dockerfile ones.
+func applyGitDockerfile(cfg config.TemplateConfig, content string) (config.TemplateConfig, error) {
+	template, err := dockerfile.Parse(content, nil)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to parse the Dockerfile: %w", err)
+	}
+
+	err = validateStageNames(template.Stages)
+	if err != nil {
+		return cfg, err
+	}
+
+	// The stage names not defined in the Dockerfile are the aliases of the templates, which are resolved only by the API
+	stageNames := map[string]bool{gitsource.StageName: true}
+	for _, stage := range template.Stages {
+		stageNames[stage.GetName()] = true
+	}
+
+	allSteps := slices.Clone(template.Steps)
+	for _, stage := range template.Stages {
+		allSteps = append(allSteps, stage.GetSteps()...)
+	}
+
+	for _, step := range allSteps {
+		if fromStage := step.GetFromStage(); fromStage != "" && !stageNames[fromStage] {
+			return cfg, fmt.Errorf("COPY --from=%s is not supported in the Dockerfile from the git repository, only the stages can be copied from", fromStage)
+		}
+	}
+
+	cfg.FromImage = template.FromImage
+	cfg.FromTemplate = nil
+	cfg.Steps = template.Steps
+	cfg.Stages = template.Stages
+
+	if template.StartCmd != nil && cfg.StartCmd == "" {
+		cfg.StartCmd = *template.StartCmd
+	}
+
+	if template.ReadyProbe != nil && cfg.ReadyCmd == "" && cfg.ReadyProbe == nil {
+		cfg.ReadyProbe = template.ReadyProbe
+	}
+
+	return cfg, nil
+}
+
+func validateStageNames(stages []*templatemanager.TemplateStage) error {
+	for _, stage := range stages {
+		if stage.GetName() == gitsource.StageName {
+			return fmt.Errorf("stage name %q is reserved for the git repository", gitsource.StageName)
+		}
+	}
+
+	return nil
+}
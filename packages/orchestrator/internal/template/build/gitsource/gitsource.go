@@ -0,0 +1,248 @@
+package gitsource
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+const (
+	// StageName is the name of the stage with the checkout of the repository, the user stages can't use it.
+	StageName = "git-source"
+
+	// Image is the base image of the stage, it has git and ssh installed.
+	Image = "buildpack-deps:bookworm-scm"
+
+	// CheckoutDir is the directory of the checkout in the stage.
+	CheckoutDir = "/git-source"
+
+	// DeployKeyPath is where the deploy key secret is mounted when cloning the repository.
+	DeployKeyPath = "/run/secrets/git-deploy-key"
+
+	// maxDockerfileSize limits the Dockerfile read from the repository.
+	maxDockerfileSize = 1 << 20
+)
+
+var commitSHARegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// IsCommitSHA reports whether the ref is a full commit SHA, which doesn't have to be resolved.
+func IsCommitSHA(ref string) bool {
+	return commitSHARegex.MatchString(ref)
+}
+
+// ContextPath returns the directory of the build context in the stage, the subdirectory can't escape the checkout.
+func ContextPath(cfg *templatemanager.FromGitConfig) string {
+	return path.Join(CheckoutDir, path.Clean("/"+cfg.GetSubdirectory()))
+}
+
+// DockerfilePath returns the path of the Dockerfile in the stage, empty when the Dockerfile isn't used.
+func DockerfilePath(cfg *templatemanager.FromGitConfig) string {
+	if cfg.GetDockerfilePath() == "" {
+		return ""
+	}
+
+	return path.Join(ContextPath(cfg), path.Clean("/"+cfg.GetDockerfilePath()))
+}
+
+// RedactedURL returns the repository URL without the password, so it can be logged and saved.
+func RedactedURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+
+	return u.Redacted()
+}
+
+// URLPassword returns the password or token in the repository URL, empty when there is none.
+func URLPassword(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return ""
+	}
+
+	password, _ := u.User.Password()
+
+	return password
+}
+
+// ResolveCommand lists the remote refs matching the ref, the default branch is listed when the ref is not set.
+func ResolveCommand(cfg *templatemanager.FromGitConfig) string {
+	ref := cfg.GetRef()
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	// The peeled commit of the annotated tag is listed only when asked for explicitly
+	return fmt.Sprintf("%sgit ls-remote -- %s %s %s", gitEnv(cfg), quote(cfg.GetUrl()), quote(ref), quote(ref+"^{}"))
+}
+
+// ParseCommit returns the commit of the ref from the git ls-remote output.
+// The ref is matched the same way as by git checkout, the branches take precedence over the tags.
+func ParseCommit(output string, ref string) (string, error) {
+	refs := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !IsCommitSHA(fields[0]) {
+			continue
+		}
+
+		refs[fields[1]] = fields[0]
+	}
+
+	candidates := []string{"HEAD"}
+	if ref != "" {
+		candidates = []string{
+			ref,
+			"refs/heads/" + ref,
+			"refs/tags/" + ref + "^{}",
+			"refs/tags/" + ref,
+			ref + "^{}",
+		}
+	}
+
+	for _, candidate := range candidates {
+		if sha, ok := refs[candidate]; ok {
+			return sha, nil
+		}
+	}
+
+	if ref == "" {
+		return "", errors.New("the repository has no default branch")
+	}
+
+	return "", fmt.Errorf("ref %q not found in the repository, commits must be referenced by the full SHA", ref)
+}
+
+// CloneStep returns the step checking out the commit to the checkout directory.
+// The commit is part of the step, so the checkout is cached by the commit and not by the ref.
+func CloneStep(cfg *templatemanager.FromGitConfig, commitSHA string) *templatemanager.TemplateStep {
+	script := strings.Join([]string{
+		"set -e",
+		strings.TrimSuffix(gitEnv(cfg), "\n"),
+		fmt.Sprintf("rm -rf %s", quote(CheckoutDir)),
+		fmt.Sprintf("git init -q %s", quote(CheckoutDir)),
+		fmt.Sprintf("cd %s", quote(CheckoutDir)),
+		fmt.Sprintf("git remote add origin %s", quote(cfg.GetUrl())),
+		// Not all servers allow fetching a commit directly, the whole history is fetched then
+		fmt.Sprintf("git fetch -q --depth 1 origin %s || git fetch -q origin", commitSHA),
+		fmt.Sprintf("git -c advice.detachedHead=false checkout -q %s", commitSHA),
+		"git submodule update -q --init --recursive --depth 1",
+		"find . -name .git -prune -exec rm -rf {} +",
+	}, "\n")
+
+	step := &templatemanager.TemplateStep{
+		Type: "RUN",
+		Args: []string{script, "root"},
+	}
+
+	if secret := cfg.GetDeployKeySecret(); secret != "" {
+		step.Secrets = []*templatemanager.TemplateSecretMount{
+			{Id: secret, Target: proto.String(DeployKeyPath)},
+		}
+	}
+
+	return step
+}
+
+// RewriteCopySteps points the COPY and ADD steps without the uploaded files to the build context in the stage.
+// The steps are cloned, so the original configuration is left intact.
+func RewriteCopySteps(cfg *templatemanager.FromGitConfig, steps []*templatemanager.TemplateStep) []*templatemanager.TemplateStep {
+	result := make([]*templatemanager.TemplateStep, 0, len(steps))
+	for _, step := range steps {
+		if !isContextCopy(step) {
+			result = append(result, step)
+
+			continue
+		}
+
+		rewritten := proto.Clone(step).(*templatemanager.TemplateStep)
+		rewritten.FromStage = proto.String(StageName)
+		rewritten.Args[0] = path.Join(ContextPath(cfg), path.Clean("/"+rewritten.Args[0]))
+
+		result = append(result, rewritten)
+	}
+
+	return result
+}
+
+// RewriteStages points the COPY and ADD steps of the stages to the build context in the stage.
+func RewriteStages(cfg *templatemanager.FromGitConfig, stages []*templatemanager.TemplateStage) []*templatemanager.TemplateStage {
+	result := make([]*templatemanager.TemplateStage, 0, len(stages))
+	for _, stage := range stages {
+		rewritten := proto.Clone(stage).(*templatemanager.TemplateStage)
+		rewritten.Steps = RewriteCopySteps(cfg, stage.GetSteps())
+
+		result = append(result, rewritten)
+	}
+
+	return result
+}
+
+// ReadArchivedFile returns the content of the single file in the gzipped tar exported from the stage.
+func ReadArchivedFile(r io.Reader) (string, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return "", errors.New("the file is not a regular file")
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read the archive: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if hdr.Size > maxDockerfileSize {
+			return "", fmt.Errorf("the file is larger than %d bytes", maxDockerfileSize)
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the file: %w", err)
+		}
+
+		return string(content), nil
+	}
+}
+
+func isContextCopy(step *templatemanager.TemplateStep) bool {
+	stepType := strings.ToUpper(step.GetType())
+	if stepType != "COPY" && stepType != "ADD" {
+		return false
+	}
+
+	return step.GetFilesHash() == "" && step.GetFromStage() == "" && len(step.GetArgs()) >= 2
+}
+
+// gitEnv returns the environment of the git commands, git never prompts for the credentials as there is no terminal.
+func gitEnv(cfg *templatemanager.FromGitConfig) string {
+	env := "export GIT_TERMINAL_PROMPT=0\n"
+	if cfg.GetDeployKeySecret() != "" {
+		env += fmt.Sprintf("export GIT_SSH_COMMAND=%s\n", quote(fmt.Sprintf("ssh -i %s -o IdentitiesOnly=yes -o StrictHostKeyChecking=accept-new", DeployKeyPath)))
+	}
+
+	return env
+}
+
+func quote(arg string) string {
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
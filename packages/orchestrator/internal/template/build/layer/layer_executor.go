@@ -121,6 +121,38 @@ func (lb *LayerExecutor) BuildLayer(
 	return meta, nil
 }
 
+// RunInSandbox runs the function in a sandbox created from the source template,
+// the sandbox is closed afterwards and its changes are discarded.
+func (lb *LayerExecutor) RunInSandbox(
+	ctx context.Context,
+	sourceTemplate SourceTemplateProvider,
+	sandboxCreator SandboxCreator,
+	fn func(ctx context.Context, sbx *sandbox.Sandbox) error,
+) error {
+	ctx, childSpan := tracer.Start(ctx, "run-in-temporary-sandbox")
+	defer childSpan.End()
+
+	localTemplate, err := sourceTemplate.Get(ctx, lb.templateCache)
+	if err != nil {
+		return fmt.Errorf("get template snapshot: %w", err)
+	}
+
+	sbx, err := sandboxCreator.Sandbox(ctx, lb, localTemplate)
+	if err != nil {
+		return err
+	}
+	defer sbx.Close(ctx)
+
+	// Add to proxy so we can call envd commands
+	lb.sandboxes.Insert(sbx.Runtime.SandboxID, sbx)
+	defer func() {
+		lb.sandboxes.Remove(sbx.Runtime.SandboxID)
+		lb.proxy.RemoveFromPool(sbx.Runtime.ExecutionID)
+	}()
+
+	return fn(ctx, sbx)
+}
+
 // updateEnvdInSandbox updates the envd binary in the sandbox to the latest version.
 func (lb *LayerExecutor) updateEnvdInSandbox(
 	ctx context.Context,
@@ -96,7 +96,7 @@ func New(
 }
 
 func (bb *BaseBuilder) Prefix() string {
-	return "base"
+	return bb.StagePrefix("base")
 }
 
 func (bb *BaseBuilder) String(ctx context.Context) (string, error) {
@@ -174,7 +174,7 @@ func (bb *BaseBuilder) buildLayerFromOCI(
 	// Created here to be able to pass it to CreateSandbox for populating COW cache
 	rootfsPath := filepath.Join(templateBuildDir, rootfsBuildFileName)
 
-	rootfs, memfile, envsImg, err := constructLayerFilesFromOCI(
+	rootfs, memfile, sourceImage, err := constructLayerFilesFromOCI(
 		ctx,
 		userLogger,
 		bb.BuildContext,
@@ -189,7 +189,8 @@ func (bb *BaseBuilder) buildLayerFromOCI(
 	}
 
 	// Env variables from the Docker image
-	baseMetadata.Context.EnvVars = oci.ParseEnvs(envsImg.Env)
+	baseMetadata.Context.EnvVars = oci.ParseEnvs(sourceImage.Config.Env)
+	baseMetadata.FromImageDigest = &sourceImage.Digest
 
 	cacheFiles, err := baseMetadata.Template.CacheFiles()
 	if err != nil {
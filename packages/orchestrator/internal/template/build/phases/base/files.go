@@ -5,7 +5,6 @@ import (
 	_ "embed"
 	"fmt"
 
-	containerregistry "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
@@ -28,7 +27,7 @@ func constructLayerFilesFromOCI(
 	dockerhubRepository dockerhub.RemoteRepository,
 	templateBuildDir string,
 	rootfsPath string,
-) (r *block.Local, m block.ReadonlyDevice, c containerregistry.Config, e error) {
+) (r *block.Local, m block.ReadonlyDevice, s rootfs.SourceImage, e error) {
 	childCtx, childSpan := tracer.Start(ctx, "template-build")
 	defer childSpan.End()
 
@@ -44,21 +43,21 @@ func constructLayerFilesFromOCI(
 		ResultPath: provisionScriptResultPath,
 	})
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("error getting provision script: %w", err)
+		return nil, nil, s, fmt.Errorf("error getting provision script: %w", err)
 	}
-	imgConfig, err := rtfs.CreateExt4Filesystem(childCtx, userLogger, rootfsPath, provisionScript, provisionLogPrefix)
+	sourceImage, err := rtfs.CreateExt4Filesystem(childCtx, userLogger, rootfsPath, provisionScript, provisionLogPrefix)
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("error creating ext4 filesystem: %w", err)
+		return nil, nil, s, fmt.Errorf("error creating ext4 filesystem: %w", err)
 	}
 
 	buildIDParsed, err := uuid.Parse(baseBuildID)
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("failed to parse build id: %w", err)
+		return nil, nil, s, fmt.Errorf("failed to parse build id: %w", err)
 	}
 
 	rootfs, err := block.NewLocal(rootfsPath, buildContext.Config.RootfsBlockSize(), buildIDParsed)
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("error reading rootfs blocks: %w", err)
+		return nil, nil, s, fmt.Errorf("error reading rootfs blocks: %w", err)
 	}
 
 	// Create empty memfile
@@ -68,8 +67,8 @@ func constructLayerFilesFromOCI(
 		buildIDParsed,
 	)
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("error creating memfile: %w", err)
+		return nil, nil, s, fmt.Errorf("error creating memfile: %w", err)
 	}
 
-	return rootfs, memfile, imgConfig, nil
+	return rootfs, memfile, sourceImage, nil
 }
@@ -16,7 +16,9 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/readiness"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/gitsource"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/layer"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
@@ -84,13 +86,22 @@ func (ppb *PostProcessingBuilder) Layer(
 ) (phases.LayerResult, error) {
 	result := sourceLayer.Metadata
 
-	// If the start/ready commands are set,
+	// If the start/ready commands or the ready probe are set,
 	// use them instead of start metadata from the template it is built from.
-	if ppb.Config.StartCmd != "" || ppb.Config.ReadyCmd != "" {
+	if ppb.Config.StartCmd != "" || ppb.Config.ReadyCmd != "" || ppb.Config.ReadyProbe != nil {
 		result.Start = &metadata.Start{
-			StartCmd: ppb.Config.StartCmd,
-			ReadyCmd: ppb.Config.ReadyCmd,
-			Context:  result.Context,
+			StartCmd:   ppb.Config.StartCmd,
+			ReadyCmd:   ppb.Config.ReadyCmd,
+			ReadyProbe: readiness.FromProto(ppb.Config.ReadyProbe),
+			Context:    result.Context,
+		}
+	}
+
+	if ppb.Config.FromGit != nil {
+		result.FromGit = &metadata.FromGit{
+			URL:       gitsource.RedactedURL(ppb.Config.FromGit.GetUrl()),
+			Ref:       ppb.Config.FromGit.GetRef(),
+			CommitSHA: ppb.GitCommitSHA,
 		}
 	}
 
@@ -239,24 +250,37 @@ func (ppb *PostProcessingBuilder) postProcessingFn(userLogger *zap.Logger) layer
 			close(startCmdConfirm)
 		}
 
-		// Ready command
-		readyCmd := meta.Start.ReadyCmd
-		if readyCmd == "" {
-			if meta.Start.StartCmd == "" {
-				readyCmd = "sleep 0"
-			} else {
-				readyCmd = GetDefaultReadyCommand(ppb.Config.TemplateID)
+		// Ready probe or command
+		if meta.Start.ReadyProbe != nil {
+			err = ppb.runReadyProbe(
+				commandsCtx,
+				userLogger,
+				sbx,
+				*meta.Start.ReadyProbe,
+				meta.Start.Context,
+			)
+			if err != nil {
+				return metadata.Template{}, phases.NewPhaseBuildError(ppb, fmt.Errorf("ready probe failed: %w", err))
+			}
+		} else {
+			readyCmd := meta.Start.ReadyCmd
+			if readyCmd == "" {
+				if meta.Start.StartCmd == "" {
+					readyCmd = "sleep 0"
+				} else {
+					readyCmd = GetDefaultReadyCommand(ppb.Config.TemplateID)
+				}
+			}
+			err = ppb.runReadyCommand(
+				commandsCtx,
+				userLogger,
+				sbx.Runtime.SandboxID,
+				readyCmd,
+				meta.Start.Context,
+			)
+			if err != nil {
+				return metadata.Template{}, phases.NewPhaseBuildError(ppb, fmt.Errorf("ready command failed: %w", err))
 			}
-		}
-		err = ppb.runReadyCommand(
-			commandsCtx,
-			userLogger,
-			sbx.Runtime.SandboxID,
-			readyCmd,
-			meta.Start.Context,
-		)
-		if err != nil {
-			return metadata.Template{}, phases.NewPhaseBuildError(ppb, fmt.Errorf("ready command failed: %w", err))
 		}
 
 		// Wait for the start command to start executing.
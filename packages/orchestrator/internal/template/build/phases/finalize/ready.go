@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/readiness"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
 )
@@ -72,6 +77,66 @@ func (ppb *PostProcessingBuilder) runReadyCommand(
 	}
 }
 
+func (ppb *PostProcessingBuilder) runReadyProbe(
+	ctx context.Context,
+	userLogger *zap.Logger,
+	sbx *sandbox.Sandbox,
+	probe metadata.ReadyProbe,
+	cmdMetadata metadata.Context,
+) error {
+	ctx, span := tracer.Start(ctx, "run-ready-probe", trace.WithAttributes(
+		attribute.String("probe.type", readiness.Type(probe)),
+	))
+	defer span.End()
+
+	userLogger.Info("Waiting for template to be ready")
+
+	userLogger.Info(fmt.Sprintf("[ready probe]: %s", describeProbe(probe)))
+
+	ctx, cancel := context.WithTimeout(ctx, readyCommandTimeout)
+	defer cancel()
+
+	runCommand := func(ctx context.Context, command string) error {
+		return sandboxtools.RunCommandWithLogger(
+			ctx,
+			ppb.proxy,
+			userLogger,
+			zapcore.InfoLevel,
+			"ready",
+			sbx.Runtime.SandboxID,
+			command,
+			cmdMetadata,
+		)
+	}
+
+	_, err := readiness.Wait(ctx, probe, sbx.Slot.HostIPString(), runCommand, func(attempt int, err error) {
+		userLogger.Info(fmt.Sprintf("Template is not ready (attempt %d of %d): %v", attempt, probe.Retries+1, err))
+	})
+	if err != nil {
+		return err
+	}
+
+	userLogger.Info("Template is ready")
+
+	return nil
+}
+
+func describeProbe(probe metadata.ReadyProbe) string {
+	switch {
+	case probe.HTTP != nil:
+		status := "2xx"
+		if probe.HTTP.ExpectedStatus != 0 {
+			status = strconv.Itoa(probe.HTTP.ExpectedStatus)
+		}
+
+		return fmt.Sprintf("HTTP GET :%d%s expecting %s", probe.HTTP.Port, probe.HTTP.Path, status)
+	case probe.TCP != nil:
+		return fmt.Sprintf("TCP :%d", probe.TCP.Port)
+	default:
+		return probe.Command
+	}
+}
+
 func GetDefaultReadyCommand(templateID string) string {
 	// HACK: This is a temporary fix for a customer that needs a bigger time to start the command.
 	// TODO: Remove this after we can add customizable wait time for building templates.
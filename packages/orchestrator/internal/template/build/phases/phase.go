@@ -61,6 +61,18 @@ func Run(
 	bc buildcontext.BuildContext,
 	metrics *metrics.BuildMetrics,
 	builders []BuilderPhase,
+) (LayerResult, error) {
+	return RunFrom(ctx, userLogger, bc, metrics, LayerResult{}, builders)
+}
+
+// RunFrom runs the phases on top of the already built source layer.
+func RunFrom(
+	ctx context.Context,
+	userLogger *zap.Logger,
+	bc buildcontext.BuildContext,
+	metrics *metrics.BuildMetrics,
+	sourceLayer LayerResult,
+	builders []BuilderPhase,
 ) (LayerResult, error) {
 	ctx, span := tracer.Start(ctx, "run phases", trace.WithAttributes(
 		attribute.Int("builders", len(builders)),
@@ -69,8 +81,6 @@ func Run(
 	))
 	defer span.End()
 
-	sourceLayer := LayerResult{}
-
 	for _, builder := range builders {
 		meta := builder.Metadata()
 
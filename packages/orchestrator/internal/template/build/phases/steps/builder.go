@@ -21,6 +21,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
 	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
@@ -45,6 +46,7 @@ type StepBuilder struct {
 	commandExecutor *commands.CommandExecutor
 	index           cache.Index
 	metrics         *metrics.BuildMetrics
+	stages          *stages.Stages
 }
 
 func New(
@@ -56,6 +58,7 @@ func New(
 	commandExecutor *commands.CommandExecutor,
 	index cache.Index,
 	metrics *metrics.BuildMetrics,
+	stages *stages.Stages,
 	step *templatemanager.TemplateStep,
 	stepNumber int,
 ) *StepBuilder {
@@ -73,15 +76,21 @@ func New(
 		commandExecutor: commandExecutor,
 		index:           index,
 		metrics:         metrics,
+		stages:          stages,
 	}
 }
 
 func (sb *StepBuilder) Prefix() string {
-	return fmt.Sprintf("builder %d/%d", sb.stepNumber, len(sb.Config.Steps))
+	return sb.StagePrefix(fmt.Sprintf("builder %d/%d", sb.stepNumber, len(sb.Config.Steps)))
 }
 
 func (sb *StepBuilder) String(ctx context.Context) (string, error) {
-	return fmt.Sprintf("%s %s", strings.ToUpper(sb.step.GetType()), strings.Join(sb.step.GetArgs(), " ")), nil
+	args := sb.step.GetArgs()
+	if fromStage := sb.step.GetFromStage(); fromStage != "" {
+		args = append([]string{"--from=" + fromStage}, args...)
+	}
+
+	return fmt.Sprintf("%s %s", strings.ToUpper(sb.step.GetType()), strings.Join(args, " ")), nil
 }
 
 func (sb *StepBuilder) Metadata() phases.PhaseMeta {
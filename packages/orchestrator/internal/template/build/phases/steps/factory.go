@@ -10,6 +10,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/layer"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
 )
 
@@ -22,6 +23,7 @@ func CreateStepPhases(
 	commandExecutor *commands.CommandExecutor,
 	index cache.Index,
 	metrics *metrics.BuildMetrics,
+	stages *stages.Stages,
 ) []phases.BuilderPhase {
 	steps := make([]phases.BuilderPhase, 0, len(bc.Config.Steps))
 
@@ -36,6 +38,7 @@ func CreateStepPhases(
 				commandExecutor,
 				index,
 				metrics,
+				stages,
 				step,
 				i+1, // stepNumber starts from 1
 			),
@@ -1,6 +1,7 @@
 package steps
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
@@ -8,11 +9,27 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
+// Hash of the step layer, the build secrets are excluded, so the layers are still cached when the secrets are rotated.
 func (sb *StepBuilder) Hash(sourceLayer phases.LayerResult) (string, error) {
-	return cache.HashKeys(
-		sourceLayer.Hash,
+	keys := []string{
 		sb.step.GetType(),
 		strings.Join(sb.step.GetArgs(), " "),
 		utils.Sprintp(sb.step.FilesHash), //nolint:protogetter // we need the nil check too
-	), nil
+	}
+
+	// The files copied from a stage change together with the last layer of the stage
+	if fromStage := sb.step.GetFromStage(); fromStage != "" {
+		if sb.stages == nil {
+			return "", fmt.Errorf("stage %q is not defined before it's used", fromStage)
+		}
+
+		stageHash, err := sb.stages.Hash(fromStage)
+		if err != nil {
+			return "", err
+		}
+
+		keys = append(keys, fmt.Sprintf("stage:%s", stageHash))
+	}
+
+	return cache.HashKeys(sourceLayer.Hash, keys...), nil
 }
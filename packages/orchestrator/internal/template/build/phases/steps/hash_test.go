@@ -0,0 +1,58 @@
+package steps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+func newTestStepBuilder(stageLayers *stages.Stages, step *templatemanager.TemplateStep) *StepBuilder {
+	return New(buildcontext.BuildContext{}, nil, nil, nil, nil, nil, nil, nil, stageLayers, step, 1)
+}
+
+func TestHash_WithoutStage(t *testing.T) {
+	filesHash := "files"
+	step := &templatemanager.TemplateStep{Type: "COPY", Args: []string{"src", "/app"}, FilesHash: &filesHash}
+
+	hash, err := newTestStepBuilder(nil, step).Hash(phases.LayerResult{Hash: "source"})
+	require.NoError(t, err)
+
+	// The hashes of the steps without a stage must stay the same, so the existing layers are still cached
+	assert.Equal(t, cache.HashKeys("source", "COPY", "src /app", "files"), hash)
+}
+
+func TestHash_FromStage(t *testing.T) {
+	stageLayers := stages.New(buildcontext.BuildContext{}, nil, nil, nil)
+	require.NoError(t, stageLayers.Add("builder", phases.LayerResult{Hash: "builder-hash"}))
+	require.NoError(t, stageLayers.Add("other", phases.LayerResult{Hash: "other-hash"}))
+
+	fromStage := "builder"
+	step := &templatemanager.TemplateStep{Type: "COPY", Args: []string{"/out", "/app"}, FromStage: &fromStage}
+	hash, err := newTestStepBuilder(stageLayers, step).Hash(phases.LayerResult{Hash: "source"})
+	require.NoError(t, err)
+
+	otherStage := "other"
+	otherStep := &templatemanager.TemplateStep{Type: "COPY", Args: []string{"/out", "/app"}, FromStage: &otherStage}
+	otherHash, err := newTestStepBuilder(stageLayers, otherStep).Hash(phases.LayerResult{Hash: "source"})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, hash, otherHash, "the hash must change with the stage layer")
+
+	missingStage := "missing"
+	missingStep := &templatemanager.TemplateStep{Type: "COPY", Args: []string{"/out", "/app"}, FromStage: &missingStage}
+	_, err = newTestStepBuilder(stageLayers, missingStep).Hash(phases.LayerResult{Hash: "source"})
+	require.EqualError(t, err, `stage "missing" is not defined before it's used`)
+}
+
+func TestStages_AddDuplicate(t *testing.T) {
+	stageLayers := stages.New(buildcontext.BuildContext{}, nil, nil, nil)
+	require.NoError(t, stageLayers.Add("builder", phases.LayerResult{Hash: "a"}))
+	require.EqualError(t, stageLayers.Add("builder", phases.LayerResult{Hash: "b"}), `stage "builder" is defined more than once`)
+}
@@ -0,0 +1,260 @@
+package build
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/layer"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sbom"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+)
+
+const sbomTimeout = 10 * time.Minute
+
+// provenance records how the template build was produced.
+type provenance struct {
+	BuildID    string            `json:"build_id"`
+	TemplateID string            `json:"template_id"`
+	BuiltAt    time.Time         `json:"built_at"`
+	Source     provenanceSource  `json:"source"`
+	Steps      []provenanceStep  `json:"steps"`
+	Builder    provenanceBuilder `json:"builder"`
+}
+
+type provenanceSource struct {
+	FromImage       string                 `json:"from_image,omitempty"`
+	FromImageDigest string                 `json:"from_image_digest,omitempty"`
+	FromTemplate    *metadata.FromTemplate `json:"from_template,omitempty"`
+	FromGit         *metadata.FromGit      `json:"from_git,omitempty"`
+}
+
+type provenanceStep struct {
+	Phase      string `json:"phase"`
+	StepType   string `json:"step_type"`
+	StepNumber *int   `json:"step_number,omitempty"`
+	Source     string `json:"source"`
+	Hash       string `json:"hash"`
+	Cached     bool   `json:"cached"`
+}
+
+type provenanceBuilder struct {
+	NodeID             string `json:"node_id"`
+	EnvdVersion        string `json:"envd_version"`
+	KernelVersion      string `json:"kernel_version"`
+	FirecrackerVersion string `json:"firecracker_version"`
+}
+
+// stepRecorder records the layers of the template phases, whether they are built or taken from the cache.
+type stepRecorder struct {
+	steps []provenanceStep
+}
+
+func (r *stepRecorder) wrap(builders []phases.BuilderPhase) []phases.BuilderPhase {
+	wrapped := make([]phases.BuilderPhase, 0, len(builders))
+	for _, builder := range builders {
+		wrapped = append(wrapped, &recordedPhase{BuilderPhase: builder, recorder: r})
+	}
+
+	return wrapped
+}
+
+type recordedPhase struct {
+	phases.BuilderPhase
+
+	recorder *stepRecorder
+}
+
+func (p *recordedPhase) Layer(ctx context.Context, sourceLayer phases.LayerResult, hash string) (phases.LayerResult, error) {
+	currentLayer, err := p.BuilderPhase.Layer(ctx, sourceLayer, hash)
+	if err != nil {
+		return phases.LayerResult{}, err
+	}
+
+	source, err := p.String(ctx)
+	if err != nil {
+		return phases.LayerResult{}, fmt.Errorf("getting source: %w", err)
+	}
+
+	meta := p.Metadata()
+	p.recorder.steps = append(p.recorder.steps, provenanceStep{
+		Phase:      string(meta.Phase),
+		StepType:   meta.StepType,
+		StepNumber: meta.StepNumber,
+		Source:     source,
+		Hash:       currentLayer.Hash,
+		Cached:     currentLayer.Cached,
+	})
+
+	return currentLayer, nil
+}
+
+// writeProvenance saves the provenance record of the finished build next to the build files.
+func writeProvenance(
+	ctx context.Context,
+	bc buildcontext.BuildContext,
+	builder *Builder,
+	lastLayer phases.LayerResult,
+	steps []provenanceStep,
+) error {
+	ctx, span := tracer.Start(ctx, "write provenance")
+	defer span.End()
+
+	meta := lastLayer.Metadata
+	record := provenance{
+		BuildID:    bc.Template.BuildID,
+		TemplateID: bc.Config.TemplateID,
+		BuiltAt:    time.Now().UTC(),
+		Source: provenanceSource{
+			FromTemplate: meta.FromTemplate,
+			FromGit:      meta.FromGit,
+		},
+		Steps: steps,
+		Builder: provenanceBuilder{
+			NodeID:             builder.nodeID,
+			EnvdVersion:        bc.EnvdVersion,
+			KernelVersion:      bc.Template.KernelVersion,
+			FirecrackerVersion: bc.Template.FirecrackerVersion,
+		},
+	}
+	if meta.FromImage != nil {
+		record.Source.FromImage = *meta.FromImage
+	}
+	if meta.FromImageDigest != nil {
+		record.Source.FromImageDigest = *meta.FromImageDigest
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to serialize provenance: %w", err)
+	}
+
+	return writeBuildFile(ctx, builder, bc.Template.StorageProvenancePath(), data)
+}
+
+// writeSBOM lists the packages installed in the final template and saves the SBOM next to the build files.
+// The package databases are collected in a sandbox of the template, the changes of the sandbox are discarded.
+func writeSBOM(
+	ctx context.Context,
+	userLogger *zap.Logger,
+	bc buildcontext.BuildContext,
+	builder *Builder,
+	layerExecutor *layer.LayerExecutor,
+	lastLayer phases.LayerResult,
+) error {
+	ctx, span := tracer.Start(ctx, "write sbom")
+	defer span.End()
+
+	userLogger.Info("Generating SBOM")
+
+	tmpFile, err := os.CreateTemp("", "sbom-*.tar.gz")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for the package databases: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	sbxConfig := sandbox.Config{
+		Vcpu:      bc.Config.VCpuCount,
+		RamMB:     bc.Config.MemoryMB,
+		HugePages: bc.Config.HugePages,
+
+		Envd: sandbox.EnvdMetadata{
+			Version: bc.EnvdVersion,
+		},
+	}
+
+	sandboxCreator := layer.NewCreateSandbox(
+		sbxConfig,
+		builder.sandboxFactory,
+		sbomTimeout,
+		fc.FirecrackerVersions{
+			KernelVersion:      bc.Template.KernelVersion,
+			FirecrackerVersion: bc.Template.FirecrackerVersion,
+		},
+	)
+
+	err = layerExecutor.RunInSandbox(
+		ctx,
+		layer.NewCacheSourceTemplateProvider(lastLayer.Metadata.Template),
+		sandboxCreator,
+		func(ctx context.Context, sbx *sandbox.Sandbox) error {
+			archivePath := filepath.Join("/tmp", fmt.Sprintf("sbom-%s.tar.gz", uuid.NewString()))
+
+			err := sandboxtools.RunCommand(
+				ctx,
+				builder.proxy,
+				sbx.Runtime.SandboxID,
+				sbom.CollectCommand(archivePath),
+				metadata.Context{User: "root"},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to collect package databases: %w", err)
+			}
+
+			err = sandboxtools.DownloadFile(ctx, builder.proxy, sbx.Runtime.SandboxID, "root", archivePath, tmpFile.Name())
+			if err != nil {
+				return fmt.Errorf("failed to download package databases: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	archive, err := os.Open(tmpFile.Name())
+	if err != nil {
+		return fmt.Errorf("failed to open the package databases: %w", err)
+	}
+	defer archive.Close()
+
+	inventory, err := sbom.Parse(archive)
+	if err != nil {
+		return fmt.Errorf("failed to read package databases: %w", err)
+	}
+
+	data, err := sbom.CycloneDX(sbom.Subject{
+		TemplateID: bc.Config.TemplateID,
+		BuildID:    bc.Template.BuildID,
+		CreatedAt:  time.Now(),
+	}, inventory)
+	if err != nil {
+		return err
+	}
+
+	err = writeBuildFile(ctx, builder, bc.Template.StorageSBOMPath(), data)
+	if err != nil {
+		return err
+	}
+
+	userLogger.Info(fmt.Sprintf("SBOM lists %d packages", len(inventory.Packages)))
+
+	return nil
+}
+
+func writeBuildFile(ctx context.Context, builder *Builder, path string, data []byte) error {
+	object, err := builder.templateStorage.OpenObject(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	_, err = object.Write(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -122,3 +122,63 @@ func CopyFile(
 
 	return nil
 }
+
+func DownloadFile(
+	ctx context.Context,
+	proxy *proxy.SandboxProxy,
+	sandboxID string,
+	user string,
+	sourcePath string,
+	targetPath string,
+) error {
+	ctx, span := tracer.Start(ctx, "download-file")
+	defer span.End()
+
+	proxyHost := fmt.Sprintf("http://localhost%s", proxy.GetAddr())
+	params := url.Values{}
+	params.Add("path", sourcePath)
+	params.Add("username", user)
+
+	telemetry.ReportEvent(ctx, "download_file",
+		attribute.String("source.path", sourcePath),
+		attribute.String("target.path", targetPath),
+		attribute.String("proxy.host", proxyHost),
+		attribute.String("sandbox.id", sandboxID),
+	)
+	downloadURL := fmt.Sprintf("%s/files?%s", proxyHost, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	err = grpc.SetSandboxHeader(req.Header, proxyHost, sandboxID)
+	if err != nil {
+		return fmt.Errorf("failed to set request header: %w", err)
+	}
+	req.Host = req.Header.Get("Host")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+
+		return fmt.Errorf("failed to download file (%d): %s", resp.StatusCode, string(body))
+	}
+
+	file, err := os.Create(targetPath)
+	if err != nil {
+		return fmt.Errorf("failed to create target file: %w", err)
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	cycloneDXFormat  = "CycloneDX"
+	cycloneDXVersion = "1.5"
+
+	toolName = "e2b-template-builder"
+)
+
+type cycloneDXDocument struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     cycloneDXTools     `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	Type    string `json:"type"`
+	BOMRef  string `json:"bom-ref,omitempty"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+// Subject is the template build the SBOM describes.
+type Subject struct {
+	TemplateID string
+	BuildID    string
+	CreatedAt  time.Time
+}
+
+// CycloneDX returns the inventory as the CycloneDX JSON document.
+func CycloneDX(subject Subject, inventory Inventory) ([]byte, error) {
+	components := make([]cycloneDXComponent, 0, len(inventory.Packages)+1)
+	if inventory.OS != nil && inventory.OS.ID != "" {
+		components = append(components, cycloneDXComponent{
+			Type:    "operating-system",
+			BOMRef:  "os:" + inventory.OS.ID,
+			Name:    inventory.OS.ID,
+			Version: inventory.OS.Version,
+		})
+	}
+
+	for _, pkg := range inventory.Packages {
+		purl := pkg.PURL(inventory.OS)
+		components = append(components, cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  purl,
+			Name:    pkg.Name,
+			Version: pkg.Version,
+			PURL:    purl,
+		})
+	}
+
+	document := cycloneDXDocument{
+		BOMFormat:    cycloneDXFormat,
+		SpecVersion:  cycloneDXVersion,
+		SerialNumber: "urn:uuid:" + uuid.NewString(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: subject.CreatedAt.UTC().Format(time.RFC3339),
+			Tools: cycloneDXTools{
+				Components: []cycloneDXComponent{{Type: "application", Name: toolName}},
+			},
+			Component: cycloneDXComponent{
+				Type:    "container",
+				BOMRef:  subject.BuildID,
+				Name:    subject.TemplateID,
+				Version: subject.BuildID,
+			},
+		},
+		Components: components,
+	}
+
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize SBOM: %w", err)
+	}
+
+	return data, nil
+}
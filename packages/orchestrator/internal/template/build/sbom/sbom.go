@@ -0,0 +1,373 @@
+package sbom
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+)
+
+const (
+	dpkgStatusPath = "var/lib/dpkg/status"
+	apkDBPath      = "lib/apk/db/installed"
+
+	// maxDatabaseSize limits the size of a single package database read from the archive.
+	maxDatabaseSize = 64 << 20
+)
+
+var osReleasePaths = []string{"etc/os-release", "usr/lib/os-release"}
+
+type PackageType string
+
+const (
+	PackageTypeDeb  PackageType = "deb"
+	PackageTypeApk  PackageType = "apk"
+	PackageTypePyPI PackageType = "pypi"
+	PackageTypeNpm  PackageType = "npm"
+)
+
+type Package struct {
+	Type    PackageType
+	Name    string
+	Version string
+	// Arch is the architecture of the system packages, empty for the language packages.
+	Arch string
+}
+
+// OS is the distribution of the template filesystem.
+type OS struct {
+	ID      string
+	Version string
+}
+
+// Inventory is the software found in the template filesystem.
+type Inventory struct {
+	OS       *OS
+	Packages []Package
+}
+
+// CollectCommand archives the package databases of the template filesystem to the archive path.
+// Only the root filesystem is searched, the missing databases are skipped.
+func CollectCommand(archivePath string) string {
+	return fmt.Sprintf(`find / -xdev \( -path /%s -o -path /%s -o -path /%s -o -path /%s -o -path '*.dist-info/METADATA' -o -path '*.egg-info/PKG-INFO' -o -path '*/node_modules/*/package.json' \) -type f -print 2>/dev/null | tar -czf "%s" -T -`,
+		dpkgStatusPath, apkDBPath, osReleasePaths[0], osReleasePaths[1], archivePath,
+	)
+}
+
+// Parse reads the package databases from the gzipped tar archive created by the CollectCommand.
+// The packages are deduplicated and sorted by their package URL.
+func Parse(archive io.Reader) (Inventory, error) {
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return Inventory{}, fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer gz.Close()
+
+	var inventory Inventory
+	osReleases := make(map[string]*OS)
+	packages := make(map[Package]struct{})
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Inventory{}, fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := strings.TrimPrefix(path.Clean("/"+hdr.Name), "/")
+		content := io.LimitReader(tr, maxDatabaseSize)
+
+		var found []Package
+		switch {
+		case name == dpkgStatusPath:
+			found, err = parseDpkgStatus(content)
+		case name == apkDBPath:
+			found, err = parseApkDB(content)
+		case slices.Contains(osReleasePaths, name):
+			osReleases[name], err = parseOSRelease(content)
+		case strings.HasSuffix(name, ".dist-info/METADATA"), strings.HasSuffix(name, ".egg-info/PKG-INFO"):
+			found, err = parsePythonMetadata(content)
+		case isNpmPackageManifest(name):
+			found, err = parseNpmManifest(content)
+		}
+		if err != nil {
+			return Inventory{}, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+
+		for _, pkg := range found {
+			packages[pkg] = struct{}{}
+		}
+	}
+
+	// The /etc/os-release takes precedence according to os-release(5)
+	for _, p := range osReleasePaths {
+		if release, ok := osReleases[p]; ok {
+			inventory.OS = release
+
+			break
+		}
+	}
+
+	inventory.Packages = slices.SortedFunc(maps.Keys(packages), func(a, b Package) int {
+		return strings.Compare(a.PURL(inventory.OS), b.PURL(inventory.OS))
+	})
+
+	return inventory, nil
+}
+
+// PURL returns the package URL of the package, the distribution is used as the namespace of the system packages.
+func (p Package) PURL(system *OS) string {
+	var namespace string
+	name := p.Name
+
+	switch p.Type {
+	case PackageTypeDeb:
+		namespace = "debian"
+		if system != nil && system.ID != "" {
+			namespace = system.ID
+		}
+	case PackageTypeApk:
+		namespace = "alpine"
+	case PackageTypePyPI:
+		// Python package names are case-insensitive and don't distinguish the separators
+		name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	case PackageTypeNpm:
+		if scope, pkg, ok := strings.Cut(name, "/"); ok {
+			namespace = scope
+			name = pkg
+		}
+	}
+
+	purl := "pkg:" + string(p.Type) + "/"
+	if namespace != "" {
+		purl += escapePURL(namespace) + "/"
+	}
+	purl += escapePURL(name) + "@" + escapePURL(p.Version)
+
+	if p.Arch != "" {
+		purl += "?arch=" + url.QueryEscape(p.Arch)
+	}
+
+	return purl
+}
+
+// escapePURL escapes the segment of the package URL, the "@" separates the version so it must be escaped too.
+func escapePURL(segment string) string {
+	return strings.ReplaceAll(url.PathEscape(segment), "@", "%40")
+}
+
+// parseDpkgStatus parses the installed packages from the dpkg status file.
+func parseDpkgStatus(r io.Reader) ([]Package, error) {
+	var packages []Package
+
+	err := readParagraphs(r, func(fields map[string]string) {
+		// Removed packages with the configuration files left stay in the status file
+		if !strings.HasSuffix(fields["Status"], " installed") || fields["Package"] == "" {
+			return
+		}
+
+		packages = append(packages, Package{
+			Type:    PackageTypeDeb,
+			Name:    fields["Package"],
+			Version: fields["Version"],
+			Arch:    fields["Architecture"],
+		})
+	})
+
+	return packages, err
+}
+
+// parseApkDB parses the installed packages from the apk database, each package is a paragraph of single letter keys.
+func parseApkDB(r io.Reader) ([]Package, error) {
+	var packages []Package
+
+	scanner := newScanner(r)
+	var pkg Package
+	flush := func() {
+		if pkg.Name != "" {
+			pkg.Type = PackageTypeApk
+			packages = append(packages, pkg)
+		}
+		pkg = Package{}
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "P":
+			pkg.Name = value
+		case "V":
+			pkg.Version = value
+		case "A":
+			pkg.Arch = value
+		}
+	}
+	flush()
+
+	return packages, scanner.Err()
+}
+
+// parseOSRelease parses the distribution from the os-release file.
+func parseOSRelease(r io.Reader) (*OS, error) {
+	release := &OS{}
+
+	scanner := newScanner(r)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+
+		value = strings.Trim(value, `"'`)
+		switch key {
+		case "ID":
+			release.ID = value
+		case "VERSION_ID":
+			release.Version = value
+		}
+	}
+
+	return release, scanner.Err()
+}
+
+// parsePythonMetadata parses the package from the core metadata of the installed distribution.
+func parsePythonMetadata(r io.Reader) ([]Package, error) {
+	pkg := Package{Type: PackageTypePyPI}
+
+	scanner := newScanner(r)
+	// Only the header is needed, the description follows the first empty line
+	for scanner.Scan() && scanner.Text() != "" {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "Name":
+			pkg.Name = strings.TrimSpace(value)
+		case "Version":
+			pkg.Version = strings.TrimSpace(value)
+		}
+	}
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg.Name == "" || pkg.Version == "" {
+		return nil, nil
+	}
+
+	return []Package{pkg}, nil
+}
+
+// isNpmPackageManifest reports if the file is the manifest of a package installed directly in the node_modules directory.
+// The other package.json files inside the installed packages don't describe a package.
+func isNpmPackageManifest(name string) bool {
+	dir, file := path.Split(name)
+	if file != "package.json" {
+		return false
+	}
+
+	parts := strings.Split(strings.TrimSuffix(dir, "/"), "/")
+	switch {
+	case len(parts) >= 2 && parts[len(parts)-2] == "node_modules":
+		return !strings.HasPrefix(parts[len(parts)-1], "@")
+	case len(parts) >= 3 && parts[len(parts)-3] == "node_modules":
+		return strings.HasPrefix(parts[len(parts)-2], "@")
+	default:
+		return false
+	}
+}
+
+// parseNpmManifest parses the package from the package.json of the installed package.
+func parseNpmManifest(r io.Reader) ([]Package, error) {
+	var manifest struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	err := json.NewDecoder(r).Decode(&manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.Name == "" || manifest.Version == "" {
+		return nil, nil
+	}
+
+	return []Package{{
+		Type:    PackageTypeNpm,
+		Name:    manifest.Name,
+		Version: manifest.Version,
+	}}, nil
+}
+
+// readParagraphs reads the RFC 822 style paragraphs separated by the empty lines, the continuation lines are skipped.
+func readParagraphs(r io.Reader, fn func(fields map[string]string)) error {
+	scanner := newScanner(r)
+	fields := make(map[string]string)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if len(fields) > 0 {
+				fn(fields)
+				fields = make(map[string]string)
+			}
+
+			continue
+		}
+
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		fields[key] = strings.TrimSpace(value)
+	}
+
+	if len(fields) > 0 {
+		fn(fields)
+	}
+
+	return scanner.Err()
+}
+
+func newScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	// The descriptions of the packages can have long lines
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	return scanner
+}
@@ -0,0 +1,142 @@
+package sbom
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dpkgStatus = `Package: curl
+Status: install ok installed
+Priority: optional
+Architecture: amd64
+Version: 7.88.1-10+deb12u5
+Description: command line tool for transferring data with URL syntax
+ curl is a command line tool for transferring data with URL syntax.
+ Package: not-a-package
+
+Package: vim
+Status: deinstall ok config-files
+Architecture: amd64
+Version: 2:9.0.1378-2
+
+Package: tzdata
+Status: install ok installed
+Architecture: all
+Version: 2024a-0+deb12u1
+`
+
+const apkDB = `C:Q1abc=
+P:musl
+V:1.2.4-r2
+A:x86_64
+T:the musl c library
+
+C:Q1def=
+P:busybox
+V:1.36.1-r15
+A:x86_64
+`
+
+const pythonMetadata = `Metadata-Version: 2.1
+Name: Typing_Extensions
+Version: 4.12.2
+Summary: Backported and Experimental Type Hints for Python 3.8+
+
+Name: not-the-package
+`
+
+func archive(t *testing.T, files map[string]string) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	return &buf
+}
+
+func purls(inventory Inventory) []string {
+	result := make([]string, 0, len(inventory.Packages))
+	for _, pkg := range inventory.Packages {
+		result = append(result, pkg.PURL(inventory.OS))
+	}
+
+	return result
+}
+
+func TestParse_Debian(t *testing.T) {
+	inventory, err := Parse(archive(t, map[string]string{
+		"etc/os-release":      "PRETTY_NAME=\"Debian GNU/Linux 12 (bookworm)\"\nID=debian\nVERSION_ID=\"12\"\n",
+		"usr/lib/os-release":  "ID=other\n",
+		"var/lib/dpkg/status": dpkgStatus,
+		"usr/lib/python3/dist-packages/typing_extensions-4.12.2.dist-info/METADATA": pythonMetadata,
+		"usr/lib/python3/dist-packages/typing_extensions-4.12.2.dist-info/RECORD":   "Name: ignored\n",
+		"app/node_modules/left-pad/package.json":                                    `{"name": "left-pad", "version": "1.3.0"}`,
+		"app/node_modules/@types/node/package.json":                                 `{"name": "@types/node", "version": "20.11.5"}`,
+		"app/node_modules/@types/node/ts5.6/package.json":                           `{"name": "ignored", "version": "1.0.0"}`,
+		"app/node_modules/left-pad/lib/package.json":                                `{"type": "module"}`,
+		"app/sub/node_modules/left-pad/package.json":                                `{"name": "left-pad", "version": "1.3.0"}`,
+	}))
+	require.NoError(t, err)
+
+	require.NotNil(t, inventory.OS)
+	assert.Equal(t, OS{ID: "debian", Version: "12"}, *inventory.OS)
+
+	assert.Equal(t, []string{
+		"pkg:deb/debian/curl@7.88.1-10+deb12u5?arch=amd64",
+		"pkg:deb/debian/tzdata@2024a-0+deb12u1?arch=all",
+		"pkg:npm/%40types/node@20.11.5",
+		"pkg:npm/left-pad@1.3.0",
+		"pkg:pypi/typing-extensions@4.12.2",
+	}, purls(inventory))
+}
+
+func TestParse_Alpine(t *testing.T) {
+	inventory, err := Parse(archive(t, map[string]string{
+		"etc/os-release":       "ID=alpine\nVERSION_ID=3.19.1\n",
+		"lib/apk/db/installed": apkDB,
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"pkg:apk/alpine/busybox@1.36.1-r15?arch=x86_64",
+		"pkg:apk/alpine/musl@1.2.4-r2?arch=x86_64",
+	}, purls(inventory))
+}
+
+func TestCycloneDX(t *testing.T) {
+	inventory := Inventory{
+		OS:       &OS{ID: "debian", Version: "12"},
+		Packages: []Package{{Type: PackageTypeDeb, Name: "curl", Version: "7.88.1", Arch: "amd64"}},
+	}
+
+	data, err := CycloneDX(Subject{TemplateID: "template", BuildID: "build", CreatedAt: time.Unix(0, 0)}, inventory)
+	require.NoError(t, err)
+
+	var document cycloneDXDocument
+	require.NoError(t, json.Unmarshal(data, &document))
+
+	assert.Equal(t, "CycloneDX", document.BOMFormat)
+	assert.Equal(t, "1.5", document.SpecVersion)
+	assert.Equal(t, "1970-01-01T00:00:00Z", document.Metadata.Timestamp)
+	assert.Equal(t, "template", document.Metadata.Component.Name)
+	require.Len(t, document.Components, 2)
+	assert.Equal(t, "operating-system", document.Components[0].Type)
+	assert.Equal(t, "pkg:deb/debian/curl@7.88.1?arch=amd64", document.Components[1].PURL)
+}
@@ -0,0 +1,154 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/layer"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+)
+
+const exportTimeout = 30 * time.Minute
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages")
+
+// Stages holds the layers of the already built stages of the multi-stage build.
+type Stages struct {
+	buildcontext.BuildContext
+
+	proxy          *proxy.SandboxProxy
+	sandboxFactory *sandbox.Factory
+	layerExecutor  *layer.LayerExecutor
+
+	layers map[string]phases.LayerResult
+}
+
+func New(
+	buildContext buildcontext.BuildContext,
+	proxy *proxy.SandboxProxy,
+	sandboxFactory *sandbox.Factory,
+	layerExecutor *layer.LayerExecutor,
+) *Stages {
+	return &Stages{
+		BuildContext: buildContext,
+
+		proxy:          proxy,
+		sandboxFactory: sandboxFactory,
+		layerExecutor:  layerExecutor,
+
+		layers: make(map[string]phases.LayerResult),
+	}
+}
+
+// Add saves the last layer of the built stage.
+func (s *Stages) Add(name string, layerResult phases.LayerResult) error {
+	if _, ok := s.layers[name]; ok {
+		return fmt.Errorf("stage %q is defined more than once", name)
+	}
+
+	s.layers[name] = layerResult
+
+	return nil
+}
+
+// Hash returns the hash of the last layer of the stage, the stage must be built already.
+func (s *Stages) Hash(name string) (string, error) {
+	layerResult, ok := s.layers[name]
+	if !ok {
+		return "", fmt.Errorf("stage %q is not defined before it's used", name)
+	}
+
+	return layerResult.Hash, nil
+}
+
+// ExportFiles archives the source path in a sandbox of the stage and downloads the gzipped tar to the target path.
+// The archive has the source path relative to the root, so the files can be moved the same way as the uploaded files.
+func (s *Stages) ExportFiles(
+	ctx context.Context,
+	userLogger *zap.Logger,
+	name string,
+	sourcePath string,
+	targetPath string,
+) error {
+	ctx, span := tracer.Start(ctx, "export stage files", trace.WithAttributes(
+		attribute.String("stage", name),
+		attribute.String("source.path", sourcePath),
+	))
+	defer span.End()
+
+	layerResult, ok := s.layers[name]
+	if !ok {
+		return fmt.Errorf("stage %q is not defined before it's used", name)
+	}
+
+	relativePath := strings.TrimPrefix(path.Clean("/"+sourcePath), "/")
+	if relativePath == "" {
+		return fmt.Errorf("copying the whole filesystem of stage %s is not supported", name)
+	}
+
+	userLogger.Debug(fmt.Sprintf("Exporting %s from stage %s", sourcePath, name))
+
+	sbxConfig := sandbox.Config{
+		Vcpu:      s.Config.VCpuCount,
+		RamMB:     s.Config.MemoryMB,
+		HugePages: s.Config.HugePages,
+
+		Envd: sandbox.EnvdMetadata{
+			Version: s.EnvdVersion,
+		},
+	}
+
+	// The stage layer may be cached from a build with a different configuration, so a new sandbox is always created.
+	sandboxCreator := layer.NewCreateSandbox(
+		sbxConfig,
+		s.sandboxFactory,
+		exportTimeout,
+		fc.FirecrackerVersions{
+			KernelVersion:      s.Template.KernelVersion,
+			FirecrackerVersion: s.Template.FirecrackerVersion,
+		},
+	)
+
+	return s.layerExecutor.RunInSandbox(
+		ctx,
+		layer.NewCacheSourceTemplateProvider(layerResult.Metadata.Template),
+		sandboxCreator,
+		func(ctx context.Context, sbx *sandbox.Sandbox) error {
+			archivePath := filepath.Join("/tmp", fmt.Sprintf("stage-%s.tar.gz", uuid.NewString()))
+
+			err := sandboxtools.RunCommand(
+				ctx,
+				s.proxy,
+				sbx.Runtime.SandboxID,
+				fmt.Sprintf(`tar -czf "%s" -C / "%s"`, archivePath, relativePath),
+				metadata.Context{User: "root"},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to archive %s in stage %s: %w", sourcePath, name, err)
+			}
+
+			err = sandboxtools.DownloadFile(ctx, s.proxy, sbx.Runtime.SandboxID, "root", archivePath, targetPath)
+			if err != nil {
+				return fmt.Errorf("failed to download files from stage %s: %w", name, err)
+			}
+
+			return nil
+		},
+	)
+}
@@ -0,0 +1,85 @@
+package writer
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const redactedValue = "***"
+
+// redactor replaces the secret values in the log messages and string fields
+type redactor struct {
+	zapcore.Core
+	replacer *strings.Replacer
+}
+
+// NewRedactor wraps the core to replace all occurrences of the secrets in the logs with "***".
+func NewRedactor(core zapcore.Core, secrets []string) zapcore.Core {
+	values := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		if secret != "" {
+			values = append(values, secret)
+		}
+	}
+
+	if len(values) == 0 {
+		return core
+	}
+
+	// The longer secrets are replaced first, so the secrets containing other secrets are fully redacted
+	slices.SortFunc(values, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	oldnew := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		oldnew = append(oldnew, value, redactedValue)
+	}
+
+	return &redactor{
+		Core:     core,
+		replacer: strings.NewReplacer(oldnew...),
+	}
+}
+
+func (r *redactor) With(fields []zapcore.Field) zapcore.Core {
+	return &redactor{
+		Core:     r.Core.With(r.redactFields(fields)),
+		replacer: r.replacer,
+	}
+}
+
+func (r *redactor) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if r.Enabled(entry.Level) {
+		return checked.AddCore(entry, r)
+	}
+
+	return checked
+}
+
+func (r *redactor) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = r.replacer.Replace(entry.Message)
+
+	return r.Core.Write(entry, r.redactFields(fields))
+}
+
+func (r *redactor) redactFields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		switch field.Type {
+		case zapcore.StringType:
+			field.String = r.replacer.Replace(field.String)
+		case zapcore.ErrorType:
+			if err, ok := field.Interface.(error); ok {
+				field.Interface = errors.New(r.replacer.Replace(err.Error()))
+			}
+		}
+
+		redacted[i] = field
+	}
+
+	return redacted
+}
@@ -0,0 +1,27 @@
+package writer
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRedactor(t *testing.T) {
+	var buf bytes.Buffer
+	core := NewRedactor(newTestCore(&buf), []string{"token", "", "secret-token"})
+
+	logger := zap.New(core).With(zap.String("npm", "token=token"))
+	logger.Info("using secret-token", zap.Error(errors.New("invalid token")))
+
+	assert.Equal(t, "INFO\tusing ***\t{\"npm\": \"***=***\", \"error\": \"invalid ***\"}\n", buf.String())
+}
+
+func TestRedactor_NoSecrets(t *testing.T) {
+	var buf bytes.Buffer
+	core := newTestCore(&buf)
+
+	assert.Equal(t, core, NewRedactor(core, nil))
+}
@@ -73,10 +73,24 @@ func (b *BuildInfo) SetFail(reason *template_manager.TemplateBuildStatusReason)
 	})
 }
 
+func (b *BuildInfo) SetCancelled(reason *template_manager.TemplateBuildStatusReason) {
+	_ = b.Result.SetValue(BuildInfoResult{
+		Status:   template_manager.TemplateBuildState_Cancelled,
+		Reason:   reason,
+		Metadata: nil,
+	})
+}
+
 func (b *BuildInfo) GetLogs() []*template_manager.TemplateBuildLogEntry {
 	return b.logs.Lines()
 }
 
+// GetLogsFrom returns the logs starting at the index, a channel that is closed when more logs are written,
+// and whether the logs are complete.
+func (b *BuildInfo) GetLogsFrom(index int) ([]*template_manager.TemplateBuildLogEntry, <-chan struct{}, bool) {
+	return b.logs.LinesFrom(index)
+}
+
 type BuildCache struct {
 	cache *ttlcache.Cache[string, *BuildInfo]
 }
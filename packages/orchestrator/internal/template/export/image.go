@@ -0,0 +1,167 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/containers/storage/pkg/archive"
+	containerregistry "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/filesystem"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/oci"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// excludedPaths are the paths of the rootfs managed by the kernel or only valid while the VM runs.
+var excludedPaths = []string{
+	"lost+found",
+	"proc/*",
+	"sys/*",
+	"dev/*",
+	"run/*",
+	"tmp/*",
+}
+
+// Image exports the template build as a single layer OCI image.
+// The rootfs is reconstructed in the work directory, the image layer is kept there too, so it must exist until the image is written.
+// The image config is set from the template metadata, the start command is the image command.
+// Mounting the rootfs requires root privileges.
+func Image(
+	ctx context.Context,
+	logger *zap.Logger,
+	persistence storage.StorageProvider,
+	buildID string,
+	workDir string,
+) (containerregistry.Image, error) {
+	ctx, span := tracer.Start(ctx, "export-image")
+	defer span.End()
+
+	meta, err := metadata.FromBuildID(ctx, persistence, buildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template metadata: %w", err)
+	}
+
+	rootfsPath := filepath.Join(workDir, "rootfs.ext4")
+	logger.Info("Downloading rootfs", zap.String("build_id", buildID))
+	err = DownloadRootfs(ctx, persistence, buildID, rootfsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(rootfsPath)
+
+	layerPath := filepath.Join(workDir, "layer.tar")
+	logger.Info("Archiving rootfs files")
+	err = archiveRootfs(ctx, rootfsPath, layerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	layer, err := tarball.LayerFromFile(layerPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image layer: %w", err)
+	}
+
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add image layer: %w", err)
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image config: %w", err)
+	}
+
+	cfg = cfg.DeepCopy()
+	cfg.OS = oci.DefaultPlatform.OS
+	cfg.Architecture = oci.DefaultPlatform.Architecture
+	cfg.Config = imageConfig(meta)
+
+	img, err = mutate.ConfigFile(img, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set image config: %w", err)
+	}
+
+	return img, nil
+}
+
+// archiveRootfs mounts the rootfs and writes its files to the tar archive.
+func archiveRootfs(ctx context.Context, rootfsPath string, layerPath string) (e error) {
+	mountPath, err := os.MkdirTemp("", "export-rootfs-mount")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary mount point: %w", err)
+	}
+	defer os.RemoveAll(mountPath)
+
+	err = filesystem.Mount(ctx, rootfsPath, mountPath)
+	if err != nil {
+		return fmt.Errorf("failed to mount rootfs: %w", err)
+	}
+	defer func() {
+		unmountErr := filesystem.Unmount(context.WithoutCancel(ctx), mountPath)
+		if unmountErr != nil && e == nil {
+			e = fmt.Errorf("failed to unmount rootfs: %w", unmountErr)
+		}
+	}()
+
+	files, err := archive.TarWithOptions(mountPath, &archive.TarOptions{
+		Compression:     archive.Uncompressed,
+		ExcludePatterns: excludedPaths,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive rootfs: %w", err)
+	}
+	defer files.Close()
+
+	layerFile, err := os.Create(layerPath)
+	if err != nil {
+		return fmt.Errorf("failed to create layer file: %w", err)
+	}
+	defer layerFile.Close()
+
+	_, err = io.Copy(layerFile, files)
+	if err != nil {
+		return fmt.Errorf("failed to write layer file: %w", err)
+	}
+
+	return nil
+}
+
+// imageConfig converts the template context and start command to the image config.
+func imageConfig(meta metadata.Template) containerregistry.Config {
+	cfg := containerregistry.Config{
+		User: meta.Context.User,
+	}
+
+	if meta.Context.WorkDir != nil {
+		cfg.WorkingDir = *meta.Context.WorkDir
+	}
+
+	// The variables are sorted, so the same template is always exported with the same digest
+	for _, key := range slices.Sorted(maps.Keys(meta.Context.EnvVars)) {
+		cfg.Env = append(cfg.Env, fmt.Sprintf("%s=%s", key, meta.Context.EnvVars[key]))
+	}
+
+	if meta.Start != nil && meta.Start.StartCmd != "" {
+		// The start command is run by a login shell in the sandbox too
+		cfg.Cmd = []string{"/bin/bash", "-l", "-c", meta.Start.StartCmd}
+	}
+
+	if meta.FromGit != nil {
+		cfg.Labels = map[string]string{
+			"org.opencontainers.image.source":   meta.FromGit.URL,
+			"org.opencontainers.image.revision": meta.FromGit.CommitSHA,
+		}
+	}
+
+	return cfg
+}
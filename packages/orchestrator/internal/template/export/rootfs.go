@@ -0,0 +1,161 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const (
+	// readChunkSize is the size of the reads from the diffs of the builds.
+	readChunkSize = storage.MemoryChunkSize
+	// maxParallelReads limits the concurrent reads from the storage.
+	maxParallelReads = 16
+)
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/internal/template/export")
+
+// DownloadRootfs reconstructs the rootfs of the build to the local file.
+// Each block is read from the diff of the build that last changed it according to the rootfs header,
+// the blocks not changed by any build are left empty.
+func DownloadRootfs(ctx context.Context, persistence storage.StorageProvider, buildID string, rootfsPath string) error {
+	ctx, span := tracer.Start(ctx, "download-rootfs")
+	defer span.End()
+
+	files := storage.TemplateFiles{BuildID: buildID}
+
+	var h *header.Header
+	headerObject, err := persistence.OpenObject(ctx, files.StorageRootfsHeaderPath())
+	if err == nil {
+		h, err = header.Deserialize(ctx, headerObject)
+	}
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		// The old templates without the header have the whole rootfs in the build
+		return downloadObject(ctx, persistence, files.StorageRootfsPath(), rootfsPath)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read rootfs header: %w", err)
+	}
+
+	f, err := os.OpenFile(rootfsPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create rootfs file: %w", err)
+	}
+	defer f.Close()
+
+	// The file is sparse, only the mapped blocks are written
+	err = f.Truncate(int64(h.Metadata.Size))
+	if err != nil {
+		return fmt.Errorf("failed to resize rootfs file: %w", err)
+	}
+
+	diffs := newDiffObjects(persistence)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxParallelReads)
+
+	for _, mapping := range h.Mapping {
+		if mapping.BuildId == uuid.Nil {
+			continue
+		}
+
+		for off := uint64(0); off < mapping.Length; off += readChunkSize {
+			length := min(readChunkSize, mapping.Length-off)
+
+			eg.Go(func() error {
+				diff, err := diffs.get(egCtx, mapping.BuildId)
+				if err != nil {
+					return err
+				}
+
+				return copyChunk(egCtx, diff, f, int64(mapping.BuildStorageOffset+off), int64(mapping.Offset+off), int64(length))
+			})
+		}
+	}
+
+	err = eg.Wait()
+	if err != nil {
+		return fmt.Errorf("failed to download rootfs blocks: %w", err)
+	}
+
+	return f.Sync()
+}
+
+func copyChunk(ctx context.Context, src storage.ReaderAtCtx, dst io.WriterAt, srcOffset, dstOffset, length int64) error {
+	buf := make([]byte, length)
+
+	n, err := src.ReadAt(ctx, buf, srcOffset)
+	if err != nil && (!errors.Is(err, io.EOF) || int64(n) < length) {
+		return fmt.Errorf("failed to read %d bytes at %d: %w", length, srcOffset, err)
+	}
+
+	_, err = dst.WriteAt(buf, dstOffset)
+	if err != nil {
+		return fmt.Errorf("failed to write %d bytes at %d: %w", length, dstOffset, err)
+	}
+
+	return nil
+}
+
+func downloadObject(ctx context.Context, persistence storage.StorageProvider, objectPath string, targetPath string) error {
+	object, err := persistence.OpenObject(ctx, objectPath)
+	if err != nil {
+		return fmt.Errorf("failed to open rootfs: %w", err)
+	}
+
+	f, err := os.OpenFile(targetPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create rootfs file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = object.WriteTo(ctx, f)
+	if err != nil {
+		return fmt.Errorf("failed to download rootfs: %w", err)
+	}
+
+	return f.Sync()
+}
+
+// diffObjects opens the rootfs diff of each build only once.
+type diffObjects struct {
+	persistence storage.StorageProvider
+
+	mu      sync.Mutex
+	objects map[uuid.UUID]storage.StorageObjectProvider
+}
+
+func newDiffObjects(persistence storage.StorageProvider) *diffObjects {
+	return &diffObjects{
+		persistence: persistence,
+		objects:     make(map[uuid.UUID]storage.StorageObjectProvider),
+	}
+}
+
+func (d *diffObjects) get(ctx context.Context, buildID uuid.UUID) (storage.StorageObjectProvider, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if object, ok := d.objects[buildID]; ok {
+		return object, nil
+	}
+
+	object, err := d.persistence.OpenObject(ctx, storage.TemplateFiles{BuildID: buildID.String()}.StorageRootfsPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rootfs diff of build %s: %w", buildID, err)
+	}
+
+	d.objects[buildID] = object
+
+	return object, nil
+}
@@ -0,0 +1,107 @@
+package export
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const blockSize = 4096
+
+func writeObject(t *testing.T, persistence storage.StorageProvider, path string, data []byte) {
+	t.Helper()
+
+	object, err := persistence.OpenObject(t.Context(), path)
+	require.NoError(t, err)
+
+	_, err = object.Write(t.Context(), data)
+	require.NoError(t, err)
+}
+
+func TestDownloadRootfs(t *testing.T) {
+	persistence, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	baseBuildID := uuid.New()
+	buildID := uuid.New()
+
+	base := bytes.Repeat([]byte{'a'}, 4*blockSize)
+	diff := bytes.Repeat([]byte{'b'}, 2*blockSize)
+
+	writeObject(t, persistence, storage.TemplateFiles{BuildID: baseBuildID.String()}.StorageRootfsPath(), base)
+	writeObject(t, persistence, storage.TemplateFiles{BuildID: buildID.String()}.StorageRootfsPath(), diff)
+
+	// The build changed the second and the fourth block of the base, the fifth block is empty
+	mappings := []*header.BuildMap{
+		{Offset: 0, Length: blockSize, BuildId: baseBuildID, BuildStorageOffset: 0},
+		{Offset: blockSize, Length: blockSize, BuildId: buildID, BuildStorageOffset: 0},
+		{Offset: 2 * blockSize, Length: blockSize, BuildId: baseBuildID, BuildStorageOffset: 2 * blockSize},
+		{Offset: 3 * blockSize, Length: blockSize, BuildId: buildID, BuildStorageOffset: blockSize},
+		{Offset: 4 * blockSize, Length: blockSize, BuildId: uuid.Nil, BuildStorageOffset: 0},
+	}
+
+	serialized, err := header.Serialize(header.NewTemplateMetadata(buildID, blockSize, 5*blockSize), mappings)
+	require.NoError(t, err)
+	writeObject(t, persistence, storage.TemplateFiles{BuildID: buildID.String()}.StorageRootfsHeaderPath(), serialized)
+
+	rootfsPath := filepath.Join(t.TempDir(), "rootfs.ext4")
+	err = DownloadRootfs(t.Context(), persistence, buildID.String(), rootfsPath)
+	require.NoError(t, err)
+
+	rootfs, err := os.ReadFile(rootfsPath)
+	require.NoError(t, err)
+
+	expected := bytes.Join([][]byte{
+		bytes.Repeat([]byte{'a'}, blockSize),
+		bytes.Repeat([]byte{'b'}, blockSize),
+		bytes.Repeat([]byte{'a'}, blockSize),
+		bytes.Repeat([]byte{'b'}, blockSize),
+		make([]byte, blockSize),
+	}, nil)
+	assert.Equal(t, expected, rootfs)
+}
+
+func TestDownloadRootfs_WithoutHeader(t *testing.T) {
+	persistence, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	buildID := uuid.New().String()
+	data := bytes.Repeat([]byte{'c'}, 2*blockSize)
+	writeObject(t, persistence, storage.TemplateFiles{BuildID: buildID}.StorageRootfsPath(), data)
+
+	rootfsPath := filepath.Join(t.TempDir(), "rootfs.ext4")
+	err = DownloadRootfs(t.Context(), persistence, buildID, rootfsPath)
+	require.NoError(t, err)
+
+	rootfs, err := os.ReadFile(rootfsPath)
+	require.NoError(t, err)
+	assert.Equal(t, data, rootfs)
+}
+
+func TestImageConfig(t *testing.T) {
+	workDir := "/home/user"
+
+	cfg := imageConfig(metadata.Template{
+		Context: metadata.Context{
+			User:    "user",
+			WorkDir: &workDir,
+			EnvVars: map[string]string{"B": "2", "A": "1"},
+		},
+		Start: &metadata.Start{StartCmd: "npm start"},
+	})
+
+	assert.Equal(t, "user", cfg.User)
+	assert.Equal(t, workDir, cfg.WorkingDir)
+	assert.Equal(t, []string{"A=1", "B=2"}, cfg.Env)
+	assert.Equal(t, []string{"/bin/bash", "-l", "-c", "npm start"}, cfg.Cmd)
+	assert.Empty(t, cfg.Labels)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"go.opentelemetry.io/otel"
 
@@ -37,19 +38,52 @@ type FromTemplate struct {
 	BuildID string `json:"build_id"`
 }
 
+// FromGit is the git repository the template files were copied from.
+type FromGit struct {
+	URL string `json:"url"`
+	Ref string `json:"ref,omitempty"`
+	// CommitSHA is the commit the ref was resolved to when the template was built.
+	CommitSHA string `json:"commit_sha"`
+}
+
+type HTTPProbe struct {
+	Port uint16 `json:"port"`
+	Path string `json:"path"`
+	// ExpectedStatus is the required response status, any 2xx status is accepted when it's zero.
+	ExpectedStatus int `json:"expected_status,omitempty"`
+}
+
+type TCPProbe struct {
+	Port uint16 `json:"port"`
+}
+
+// ReadyProbe is the readiness check of the template, exactly one of the checks is set.
+type ReadyProbe struct {
+	HTTP    *HTTPProbe `json:"http,omitempty"`
+	TCP     *TCPProbe  `json:"tcp,omitempty"`
+	Command string     `json:"command,omitempty"`
+
+	Interval time.Duration `json:"interval"`
+	Timeout  time.Duration `json:"timeout"`
+	Retries  int           `json:"retries"`
+}
+
 type Start struct {
-	StartCmd string  `json:"start_command"`
-	ReadyCmd string  `json:"ready_command"`
-	Context  Context `json:"context"`
+	StartCmd   string      `json:"start_command"`
+	ReadyCmd   string      `json:"ready_command"`
+	ReadyProbe *ReadyProbe `json:"ready_probe,omitempty"`
+	Context    Context     `json:"context"`
 }
 
 type Template struct {
-	Version      uint64                `json:"version"`
-	Template     storage.TemplateFiles `json:"template"`
-	Context      Context               `json:"context"`
-	Start        *Start                `json:"start,omitempty"`
-	FromImage    *string               `json:"from_image,omitempty"`
-	FromTemplate *FromTemplate         `json:"from_template,omitempty"`
+	Version         uint64                `json:"version"`
+	Template        storage.TemplateFiles `json:"template"`
+	Context         Context               `json:"context"`
+	Start           *Start                `json:"start,omitempty"`
+	FromImage       *string               `json:"from_image,omitempty"`
+	FromImageDigest *string               `json:"from_image_digest,omitempty"`
+	FromTemplate    *FromTemplate         `json:"from_template,omitempty"`
+	FromGit         *FromGit              `json:"from_git,omitempty"`
 }
 
 func V1TemplateVersion() Template {
@@ -73,23 +107,27 @@ func (t Template) BasedOn(
 
 func (t Template) NewVersionTemplate(files storage.TemplateFiles) Template {
 	return Template{
-		Version:      CurrentVersion,
-		Template:     files,
-		Context:      t.Context,
-		Start:        t.Start,
-		FromTemplate: t.FromTemplate,
-		FromImage:    t.FromImage,
+		Version:         CurrentVersion,
+		Template:        files,
+		Context:         t.Context,
+		Start:           t.Start,
+		FromTemplate:    t.FromTemplate,
+		FromImage:       t.FromImage,
+		FromImageDigest: t.FromImageDigest,
+		FromGit:         t.FromGit,
 	}
 }
 
 func (t Template) SameVersionTemplate(files storage.TemplateFiles) Template {
 	return Template{
-		Version:      t.Version,
-		Template:     files,
-		Context:      t.Context,
-		Start:        t.Start,
-		FromTemplate: t.FromTemplate,
-		FromImage:    t.FromImage,
+		Version:         t.Version,
+		Template:        files,
+		Context:         t.Context,
+		Start:           t.Start,
+		FromTemplate:    t.FromTemplate,
+		FromImage:       t.FromImage,
+		FromImageDigest: t.FromImageDigest,
+		FromGit:         t.FromGit,
 	}
 }
 
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/cache"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *ServerStore) TemplateBuildCancel(ctx context.Context, in *templatemanager.TemplateBuildCancelRequest) (*emptypb.Empty, error) {
+	_, childSpan := tracer.Start(ctx, "template-cancel-request")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithTemplateID(in.GetTemplateID()),
+		telemetry.WithBuildID(in.GetBuildID()),
+	)
+
+	if in.GetTemplateID() == "" || in.GetBuildID() == "" {
+		return nil, errors.New("template id and build id are required fields")
+	}
+
+	buildInfo, err := s.buildCache.Get(in.GetBuildID())
+	if err != nil {
+		return nil, fmt.Errorf("error while getting build info, maybe already expired: %w", err)
+	}
+
+	if !buildInfo.IsRunning() {
+		return nil, fmt.Errorf("build '%s' is not running", in.GetBuildID())
+	}
+
+	// Setting the result cancels the build context, the build sandbox is torn down when the build returns
+	zap.L().Info("Canceling template build", logger.WithTemplateID(in.GetTemplateID()), logger.WithBuildID(in.GetBuildID()))
+	telemetry.ReportEvent(ctx, "cancel template build")
+	buildInfo.SetCancelled(&templatemanager.TemplateBuildStatusReason{
+		Message: cache.CancelledBuildReason,
+	})
+
+	return nil, nil
+}
@@ -65,13 +65,17 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		MemoryMB:             int64(cfg.GetMemoryMB()),
 		StartCmd:             cfg.GetStartCommand(),
 		ReadyCmd:             cfg.GetReadyCommand(),
+		ReadyProbe:           cfg.GetReadyProbe(),
 		DiskSizeMB:           int64(cfg.GetDiskSizeMB()),
 		HugePages:            cfg.GetHugePages(),
 		FromImage:            cfg.GetFromImage(),
 		FromTemplate:         cfg.GetFromTemplate(),
+		FromGit:              cfg.GetFromGit(),
 		RegistryAuthProvider: authProvider,
 		Force:                cfg.Force,
 		Steps:                cfg.GetSteps(),
+		Stages:               cfg.GetStages(),
+		Secrets:              cfg.GetSecrets(),
 	}
 
 	logs := buildlogger.NewLogEntryLogger()
@@ -94,6 +98,9 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 	go func(ctx context.Context) {
 		defer s.wg.Done()
 
+		// No more logs are written when the build returns, the log streams are ended
+		defer logs.Close()
+
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
@@ -114,7 +121,7 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 				return
 			case <-buildInfo.Result.Done:
 				res, _ := buildInfo.Result.Result()
-				if res.Status == templatemanager.TemplateBuildState_Failed {
+				if res.Status == templatemanager.TemplateBuildState_Failed || res.Status == templatemanager.TemplateBuildState_Cancelled {
 					cancel()
 				}
 				return
@@ -128,10 +135,15 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 
 			buildInfo.SetFail(builderrors.UnwrapUserError(err))
 		} else {
-			buildInfo.SetSuccess(&templatemanager.TemplateBuildMetadata{
+			buildMetadata := &templatemanager.TemplateBuildMetadata{
 				RootfsSizeKey:  int32(res.RootfsSizeMB),
 				EnvdVersionKey: res.EnvdVersion,
-			})
+			}
+			if res.GitCommitSHA != "" {
+				buildMetadata.GitCommitSha = &res.GitCommitSHA
+			}
+
+			buildInfo.SetSuccess(buildMetadata)
 			telemetry.ReportEvent(ctx, "Environment built")
 		}
 	}(context.WithoutCancel(ctx))
@@ -110,6 +110,7 @@ func New(
 		sandboxes,
 		templateCache,
 		buildMetrics,
+		info.ClientId,
 	)
 
 	store := &ServerStore{
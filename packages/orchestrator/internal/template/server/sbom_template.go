@@ -0,0 +1,65 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// TemplateBuildSBOM reads the SBOM and the provenance record of the build from the template storage.
+// The SBOM is optional, as it's not generated when the packages of the template can't be listed.
+func (s *ServerStore) TemplateBuildSBOM(ctx context.Context, in *templatemanager.TemplateBuildSBOMRequest) (*templatemanager.TemplateBuildSBOMResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "template-sbom-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.GetTemplateID()),
+		telemetry.WithBuildID(in.GetBuildID()),
+	))
+	defer childSpan.End()
+
+	if in.GetTemplateID() == "" || in.GetBuildID() == "" {
+		return nil, errors.New("template id and build id are required fields")
+	}
+
+	files := storage.TemplateFiles{BuildID: in.GetBuildID()}
+
+	provenance, err := s.readBuildFile(ctx, files.StorageProvenancePath())
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, status.Errorf(codes.NotFound, "provenance of build '%s' not found", in.GetBuildID())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sbom, err := s.readBuildFile(ctx, files.StorageSBOMPath())
+	if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, err
+	}
+
+	return &templatemanager.TemplateBuildSBOMResponse{
+		Sbom:       sbom,
+		Provenance: provenance,
+	}, nil
+}
+
+func (s *ServerStore) readBuildFile(ctx context.Context, path string) ([]byte, error) {
+	object, err := s.templateStorage.OpenObject(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = object.WriteTo(ctx, &buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *ServerStore) TemplateBuildLogsStream(in *template_manager.TemplateStatusRequest, stream template_manager.TemplateService_TemplateBuildLogsStreamServer) error {
+	ctx, ctxSpan := tracer.Start(stream.Context(), "template-build-logs-stream-request")
+	defer ctxSpan.End()
+
+	ctxSpan.SetAttributes(
+		telemetry.WithTemplateID(in.GetTemplateID()),
+		telemetry.WithBuildID(in.GetBuildID()),
+	)
+
+	buildInfo, err := s.buildCache.Get(in.GetBuildID())
+	if err != nil {
+		return status.Error(codes.NotFound, errors.Wrap(err, "error while getting build info, maybe already expired").Error())
+	}
+
+	index := 0
+	logsCrawled := int32(0)
+	for {
+		entries, changed, complete := buildInfo.GetLogsFrom(index)
+		index += len(entries)
+
+		for _, entry := range entries {
+			// Skip entries that are below the specified level
+			if entry.GetLevel().Number() < in.GetLevel().Number() {
+				continue
+			}
+
+			logsCrawled++
+			if logsCrawled <= in.GetOffset() {
+				continue
+			}
+
+			err := stream.Send(entry)
+			if err != nil {
+				return errors.Wrap(err, "error while sending build log entry")
+			}
+		}
+
+		// All the logs were sent and no more logs will be written
+		if complete && len(entries) == 0 {
+			return nil
+		}
+
+		if len(entries) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
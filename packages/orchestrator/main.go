@@ -238,7 +238,13 @@ func run(port, proxyPort, hyperloopPort uint) (success bool) {
 	// to propagate information about sandbox routing.
 	sandboxes := smap.New[*sandbox.Sandbox]()
 
-	sandboxProxy, err := proxy.NewSandboxProxy(tel.MeterProvider, proxyPort, sandboxes)
+	// The secret shared by the orchestrators authenticates the tunnels between the sandboxes of the team networks.
+	teamNetworkSecret := []byte(os.Getenv("TEAM_NETWORK_SECRET"))
+	if len(teamNetworkSecret) == 0 {
+		zap.L().Warn("TEAM_NETWORK_SECRET is not set, the connections between the sandboxes of the team networks are disabled")
+	}
+
+	sandboxProxy, err := proxy.NewSandboxProxy(tel.MeterProvider, proxyPort, sandboxes, teamNetworkSecret)
 	if err != nil {
 		zap.L().Fatal("failed to create sandbox proxy", zap.Error(err))
 	}
@@ -281,10 +287,12 @@ func run(port, proxyPort, hyperloopPort uint) (success bool) {
 	}
 
 	var sandboxEventBatcher batcher.ClickhouseInsertBatcher[clickhouse.SandboxEvent]
+	var sandboxNetworkUsageBatcher batcher.ClickhouseInsertBatcher[clickhouse.SandboxNetworkUsage]
 
 	clickhouseConnectionString := os.Getenv("CLICKHOUSE_CONNECTION_STRING")
 	if clickhouseConnectionString == "" {
 		sandboxEventBatcher = batcher.NewNoopBatcher[clickhouse.SandboxEvent]()
+		sandboxNetworkUsageBatcher = batcher.NewNoopBatcher[clickhouse.SandboxNetworkUsage]()
 	} else {
 		var err error
 		clickhouseConn, err := clickhouse.NewDriver(clickhouseConnectionString)
@@ -318,6 +326,24 @@ func run(port, proxyPort, hyperloopPort uint) (success bool) {
 		if err != nil {
 			zap.L().Fatal("failed to create clickhouse batcher", zap.Error(err))
 		}
+
+		// The batchers close their connections, so each of them gets its own.
+		networkUsageConn, err := clickhouse.NewDriver(clickhouseConnectionString)
+		if err != nil {
+			zap.L().Fatal("failed to create clickhouse driver", zap.Error(err))
+		}
+
+		sandboxNetworkUsageBatcher, err = batcher.NewSandboxNetworkUsageInsertsBatcher(networkUsageConn, batcher.BatcherOptions{
+			MaxBatchSize: maxBatchSize,
+			MaxDelay:     maxDelay,
+			QueueSize:    bactherQueueSize,
+			ErrorHandler: func(err error) {
+				zap.L().Error("error batching sandbox network usage", zap.Error(err))
+			},
+		})
+		if err != nil {
+			zap.L().Fatal("failed to create clickhouse network usage batcher", zap.Error(err))
+		}
 	}
 
 	var redisClient redis.UniversalClient
@@ -361,9 +387,14 @@ func run(port, proxyPort, hyperloopPort uint) (success bool) {
 		zap.L().Fatal("failed to create sandbox observer", zap.Error(err))
 	}
 
+	networkUsageReporter := metrics.NewNetworkUsageReporter(sandboxNetworkUsageBatcher)
+
 	defaultAllowSandboxInternet := env.GetEnv("ALLOW_SANDBOX_INTERNET", "true") != "false"
 
-	sandboxFactory := sandbox.NewFactory(networkPool, devicePool, featureFlags, defaultAllowSandboxInternet)
+	// The team network names are resolved by the API DNS server, the connections are tunneled through the orchestrator proxies.
+	teamNetworkResolver := network.NewTeamNetworkResolver(env.GetEnv("TEAM_NETWORK_DNS_SERVER", "api.service.consul:5353"), proxyPort, teamNetworkSecret)
+
+	sandboxFactory := sandbox.NewFactory(networkPool, devicePool, featureFlags, defaultAllowSandboxInternet, teamNetworkResolver)
 
 	server.New(server.ServiceConfig{
 		SandboxFactory:   sandboxFactory,
@@ -378,6 +409,7 @@ func run(port, proxyPort, hyperloopPort uint) (success bool) {
 		Persistence:      persistence,
 		FeatureFlags:     featureFlags,
 		SbxEventsService: sbxEventsService,
+		NetworkUsage:     networkUsageReporter,
 	})
 
 	tmplSbxLoggerExternal := sbxlogger.NewLogger(
@@ -412,6 +444,8 @@ func run(port, proxyPort, hyperloopPort uint) (success bool) {
 		sandboxObserver,
 		limiter,
 		sandboxEventBatcher,
+		networkUsageReporter,
+		sandboxNetworkUsageBatcher,
 	)
 
 	// Initialize the template manager only if the service is enabled
@@ -12,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 )
 
 type SnapshotInfo struct {
@@ -27,6 +28,8 @@ type SnapshotInfo struct {
 	EnvdVersion         string
 	EnvdSecured         bool
 	AllowInternetAccess *bool
+	Network             *schema.SandboxNetworkConfig
+	Checkpoint          *schema.SandboxCheckpointConfig
 	AutoPause           bool
 }
 
@@ -77,7 +80,7 @@ func (db *DB) NewSnapshotBuild(
 			return nil, fmt.Errorf("failed to create env '%s': %w", snapshotConfig.SandboxID, err)
 		}
 
-		err = tx.
+		create := tx.
 			Snapshot.
 			Create().
 			SetSandboxID(snapshotConfig.SandboxID).
@@ -89,22 +92,45 @@ func (db *DB) NewSnapshotBuild(
 			SetEnvSecure(snapshotConfig.EnvdSecured).
 			SetNillableAllowInternetAccess(snapshotConfig.AllowInternetAccess).
 			SetOriginNodeID(originNodeID).
-			SetAutoPause(snapshotConfig.AutoPause).
-			Exec(ctx)
+			SetAutoPause(snapshotConfig.AutoPause)
+
+		if snapshotConfig.Network != nil {
+			create.SetNetwork(snapshotConfig.Network)
+		}
+
+		if snapshotConfig.Checkpoint != nil {
+			create.SetCheckpoint(snapshotConfig.Checkpoint)
+		}
+
+		err = create.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
 	} else {
 		e = s.Edges.Env
 		// Update existing snapshot with new metadata and pause time
-		err = tx.
+		update := tx.
 			Snapshot.
 			UpdateOne(s).
 			SetMetadata(snapshotConfig.Metadata).
 			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
 			SetOriginNodeID(originNodeID).
-			SetAutoPause(snapshotConfig.AutoPause).
-			Exec(ctx)
+			SetAutoPause(snapshotConfig.AutoPause)
+
+		// The network configuration can change while the sandbox is running
+		if snapshotConfig.Network != nil {
+			update.SetNetwork(snapshotConfig.Network)
+		} else {
+			update.ClearNetwork()
+		}
+
+		if snapshotConfig.Checkpoint != nil {
+			update.SetCheckpoint(snapshotConfig.Checkpoint)
+		} else {
+			update.ClearCheckpoint()
+		}
+
+		err = update.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to update snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
@@ -0,0 +1,827 @@
+package dockerfile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+// Template is the template build compiled from the Dockerfile.
+type Template struct {
+	FromImage string
+	Steps     []*templatemanager.TemplateStep
+	// Stages are the build stages before the last FROM instruction used by the COPY --from steps, in order.
+	Stages []*templatemanager.TemplateStage
+	// StartCmd is the command compiled from the CMD and ENTRYPOINT instructions, nil when neither is set.
+	StartCmd *string
+	// ReadyProbe is the command probe compiled from the HEALTHCHECK instruction, nil when it's not set or disabled.
+	ReadyProbe *templatemanager.ReadyProbe
+}
+
+// LineError is an error of the Dockerfile instruction starting at the line.
+type LineError struct {
+	Line int
+	Msg  string
+}
+
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// The instructions without any effect on the template.
+var ignoredInstructions = map[string]bool{
+	"EXPOSE":     true,
+	"LABEL":      true,
+	"MAINTAINER": true,
+}
+
+var unsupportedInstructions = map[string]bool{
+	"ONBUILD":    true,
+	"SHELL":      true,
+	"STOPSIGNAL": true,
+	"VOLUME":     true,
+}
+
+var heredocRegex = regexp.MustCompile(`<<-?["']?[A-Za-z_][A-Za-z0-9_]*["']?`)
+
+type instruction struct {
+	line int
+	cmd  string
+	rest string
+}
+
+// command is the CMD or ENTRYPOINT instruction, exec is true for the JSON array form.
+type command struct {
+	args []string
+	exec bool
+}
+
+type parser struct {
+	buildArgs map[string]string
+	// vars are the values of the declared build args and environment variables known when parsing.
+	vars       map[string]string
+	globalArgs map[string]string
+
+	template   Template
+	fromLine   int
+	cmd        *command
+	entrypoint *command
+	readyProbe *templatemanager.ReadyProbe
+	errs       []error
+
+	// stageName is the name of the current stage, the unnamed stages are named by their index.
+	stageName string
+	// stageRefs are the names and indexes of the finished stages mapped to the stage names.
+	stageRefs map[string]string
+}
+
+// Parse compiles the Dockerfile to the template build steps, the build args override the defaults of the ARG instructions.
+// The COPY and ADD steps need the hash of the uploaded files to be set before the build is started, except the steps copying from a stage.
+// The last stage is the template, the previous stages are kept only when their files are copied.
+// All errors are returned together, each prefixed by the line of the instruction.
+func Parse(dockerfile string, buildArgs map[string]string) (*Template, error) {
+	p := &parser{
+		buildArgs:  buildArgs,
+		vars:       map[string]string{},
+		globalArgs: map[string]string{},
+		template:   Template{Steps: []*templatemanager.TemplateStep{}, Stages: []*templatemanager.TemplateStage{}},
+		stageRefs:  map[string]string{},
+	}
+
+	for _, inst := range splitInstructions(dockerfile) {
+		p.parseInstruction(inst)
+	}
+
+	if p.fromLine == 0 && len(p.errs) == 0 {
+		p.errs = append(p.errs, errors.New("the Dockerfile has no FROM instruction"))
+	}
+
+	if len(p.errs) > 0 {
+		return nil, errors.Join(p.errs...)
+	}
+
+	p.template.StartCmd = startCommand(p.entrypoint, p.cmd)
+	p.template.ReadyProbe = p.readyProbe
+	p.template.Stages = usedStages(p.template.Stages, p.template.Steps)
+
+	return &p.template, nil
+}
+
+func (p *parser) fail(inst instruction, format string, args ...any) {
+	p.errs = append(p.errs, LineError{Line: inst.line, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (p *parser) parseInstruction(inst instruction) {
+	if ignoredInstructions[inst.cmd] {
+		return
+	}
+
+	if unsupportedInstructions[inst.cmd] {
+		p.fail(inst, "%s instruction is not supported", inst.cmd)
+
+		return
+	}
+
+	if p.fromLine == 0 && inst.cmd != "FROM" && inst.cmd != "ARG" {
+		p.fail(inst, "%s instruction must be after the FROM instruction", inst.cmd)
+
+		return
+	}
+
+	if (inst.cmd == "RUN" || inst.cmd == "COPY" || inst.cmd == "ADD") && heredocRegex.MatchString(inst.rest) {
+		p.fail(inst, "heredocs are not supported")
+
+		return
+	}
+
+	switch inst.cmd {
+	case "FROM":
+		p.parseFrom(inst)
+	case "ARG":
+		p.parseArg(inst)
+	case "ENV":
+		p.parseEnv(inst)
+	case "RUN":
+		p.parseRun(inst)
+	case "COPY", "ADD":
+		p.parseCopy(inst)
+	case "WORKDIR", "USER":
+		p.parseSingleArg(inst)
+	case "CMD":
+		p.cmd = p.parseCommand(inst)
+	case "ENTRYPOINT":
+		p.entrypoint = p.parseCommand(inst)
+	case "HEALTHCHECK":
+		p.parseHealthcheck(inst)
+	default:
+		p.fail(inst, "unknown instruction %s", inst.cmd)
+	}
+}
+
+func (p *parser) parseFrom(inst instruction) {
+	if p.fromLine != 0 {
+		p.endStage()
+	}
+
+	p.fromLine = inst.line
+	p.stageName = strconv.Itoa(len(p.template.Stages))
+
+	flags, rest := parseFlags(inst.rest)
+	for _, f := range flags {
+		p.fail(inst, "FROM --%s is not supported", f.name)
+	}
+
+	words := strings.Fields(rest)
+	if len(words) != 1 && (len(words) != 3 || !strings.EqualFold(words[1], "AS")) {
+		p.fail(inst, "FROM requires an image and an optional stage name")
+
+		return
+	}
+
+	if len(words) == 3 {
+		name := strings.ToLower(words[2])
+		if _, ok := p.stageRefs[name]; ok {
+			p.fail(inst, "the stage name %s is already used", name)
+
+			return
+		}
+
+		p.stageName = name
+	}
+
+	image := expand(words[0], p.globalArgs)
+	if image == "scratch" {
+		p.fail(inst, "the scratch image can't be used as the template base")
+
+		return
+	}
+
+	if _, ok := p.stageRefs[strings.ToLower(image)]; ok {
+		p.fail(inst, "FROM a previous stage is not supported, copy its files with COPY --from instead")
+
+		return
+	}
+
+	p.template.FromImage = image
+}
+
+// endStage finishes the current stage, the next stage starts without any variables and commands the same way as in Docker.
+func (p *parser) endStage() {
+	fromImage := p.template.FromImage
+	steps := p.template.Steps
+	p.template.Stages = append(p.template.Stages, &templatemanager.TemplateStage{
+		Name:   p.stageName,
+		Source: &templatemanager.TemplateStage_FromImage{FromImage: fromImage},
+		Steps:  steps,
+	})
+
+	p.stageRefs[strconv.Itoa(len(p.template.Stages)-1)] = p.stageName
+	p.stageRefs[p.stageName] = p.stageName
+
+	p.template.FromImage = ""
+	p.template.Steps = []*templatemanager.TemplateStep{}
+	p.vars = map[string]string{}
+	p.cmd = nil
+	p.entrypoint = nil
+	p.readyProbe = nil
+}
+
+func (p *parser) parseArg(inst instruction) {
+	words, err := splitWords(inst.rest)
+	if err != nil {
+		p.fail(inst, "ARG %s", err)
+
+		return
+	}
+
+	if len(words) == 0 {
+		p.fail(inst, "ARG requires a name")
+
+		return
+	}
+
+	args := make([]string, 0, 2*len(words))
+	for _, word := range words {
+		name, value, hasDefault := strings.Cut(word, "=")
+		if name == "" {
+			p.fail(inst, "ARG requires a name")
+
+			return
+		}
+
+		if hasDefault {
+			value = expand(value, p.scope())
+		}
+
+		// The global args are available after FROM only when declared again, they keep their value then.
+		if p.fromLine != 0 && !hasDefault {
+			value, hasDefault = p.globalArgs[name]
+		}
+
+		if buildArg, ok := p.buildArgs[name]; ok {
+			value, hasDefault = buildArg, true
+		}
+
+		if p.fromLine == 0 {
+			if hasDefault {
+				p.globalArgs[name] = value
+			}
+
+			continue
+		}
+
+		// The declared args without any value stay unset.
+		if !hasDefault {
+			continue
+		}
+
+		p.vars[name] = value
+		args = append(args, name, value)
+	}
+
+	if len(args) > 0 {
+		p.addStep("ARG", args...)
+	}
+}
+
+func (p *parser) parseEnv(inst instruction) {
+	words, err := splitWords(inst.rest)
+	if err != nil {
+		p.fail(inst, "ENV %s", err)
+
+		return
+	}
+
+	if len(words) == 0 {
+		p.fail(inst, "ENV requires at least one variable")
+
+		return
+	}
+
+	// The legacy `ENV key value` form sets a single variable to the rest of the line.
+	if !strings.Contains(words[0], "=") {
+		key, value, _ := strings.Cut(strings.TrimSpace(inst.rest), " ")
+		value = expand(strings.TrimSpace(value), p.vars)
+		p.vars[key] = value
+		p.addStep("ENV", key, value)
+
+		return
+	}
+
+	args := make([]string, 0, 2*len(words))
+	for _, word := range words {
+		key, value, found := strings.Cut(word, "=")
+		if !found || key == "" {
+			p.fail(inst, "ENV requires the key=value format, got %q", word)
+
+			return
+		}
+
+		value = expand(value, p.vars)
+		args = append(args, key, value)
+	}
+
+	for i := 0; i < len(args); i += 2 {
+		p.vars[args[i]] = args[i+1]
+	}
+
+	p.addStep("ENV", args...)
+}
+
+func (p *parser) parseRun(inst instruction) {
+	flags, rest := parseFlags(inst.rest)
+
+	var secrets []*templatemanager.TemplateSecretMount
+	valid := true
+	for _, f := range flags {
+		if f.name != "mount" {
+			p.fail(inst, "RUN --%s is not supported", f.name)
+			valid = false
+
+			continue
+		}
+
+		secret, err := parseSecretMount(f.value)
+		if err != nil {
+			p.fail(inst, "RUN --mount %s", err)
+			valid = false
+
+			continue
+		}
+
+		secrets = append(secrets, secret)
+	}
+
+	if !valid {
+		return
+	}
+
+	command, exec := parseExecForm(rest)
+	if len(command) == 0 || command[0] == "" {
+		p.fail(inst, "RUN requires a command")
+
+		return
+	}
+
+	if exec {
+		command = []string{shellJoin(command)}
+	}
+
+	p.template.Steps = append(p.template.Steps, &templatemanager.TemplateStep{
+		Type:    "RUN",
+		Args:    command,
+		Secrets: secrets,
+	})
+}
+
+// parseSecretMount parses the `type=secret,id=name,target=path,env=NAME` mount, the ID defaults to the target file name.
+func parseSecretMount(value string) (*templatemanager.TemplateSecretMount, error) {
+	mount := &templatemanager.TemplateSecretMount{}
+	mountType := ""
+	for _, option := range strings.Split(value, ",") {
+		key, val, _ := strings.Cut(option, "=")
+		switch strings.ToLower(key) {
+		case "type":
+			mountType = val
+		case "id":
+			mount.Id = val
+		case "target", "dst", "destination":
+			mount.Target = &val
+		case "env":
+			mount.Env = &val
+		case "required":
+			// The missing secrets are always rejected when the build is started
+		default:
+			return nil, fmt.Errorf("option %s is not supported", key)
+		}
+	}
+
+	if mountType != "secret" {
+		return nil, fmt.Errorf("type=%s is not supported, only the secret mounts are", mountType)
+	}
+
+	if mount.Id == "" && mount.Target != nil {
+		mount.Id = path.Base(mount.GetTarget())
+	}
+
+	if mount.Id == "" {
+		return nil, errors.New("requires the secret id")
+	}
+
+	return mount, nil
+}
+
+func (p *parser) parseCopy(inst instruction) {
+	flags, rest := parseFlags(inst.rest)
+
+	owner, permissions := "", ""
+	var fromStage *string
+	for _, f := range flags {
+		switch f.name {
+		case "chown":
+			owner = expand(f.value, p.vars)
+		case "chmod":
+			permissions = f.value
+		case "from":
+			if inst.cmd != "COPY" {
+				p.fail(inst, "%s --from is not supported, use COPY --from instead", inst.cmd)
+
+				continue
+			}
+
+			// The names which are not stages are the aliases of the templates
+			from := expand(f.value, p.vars)
+			if stage, ok := p.stageRefs[strings.ToLower(from)]; ok {
+				from = stage
+			}
+
+			fromStage = &from
+		default:
+			p.fail(inst, "%s --%s is not supported", inst.cmd, f.name)
+		}
+	}
+
+	paths, exec := parseExecForm(rest)
+	if !exec {
+		words, err := splitWords(rest)
+		if err != nil {
+			p.fail(inst, "%s %s", inst.cmd, err)
+
+			return
+		}
+
+		paths = words
+	}
+
+	if len(paths) < 2 {
+		p.fail(inst, "%s requires at least one source and a destination", inst.cmd)
+
+		return
+	}
+
+	sources, dest := paths[:len(paths)-1], expand(paths[len(paths)-1], p.vars)
+	for _, source := range sources {
+		if inst.cmd == "ADD" && (strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "git@")) {
+			p.fail(inst, "ADD from remote URLs is not supported, use RUN with curl or git instead")
+
+			return
+		}
+	}
+
+	for _, source := range sources {
+		args := []string{expand(source, p.vars), dest}
+		if owner != "" || permissions != "" {
+			args = append(args, owner)
+		}
+
+		if permissions != "" {
+			args = append(args, permissions)
+		}
+
+		p.template.Steps = append(p.template.Steps, &templatemanager.TemplateStep{
+			Type:      inst.cmd,
+			Args:      args,
+			FromStage: fromStage,
+		})
+	}
+}
+
+func (p *parser) parseSingleArg(inst instruction) {
+	value := strings.TrimSpace(inst.rest)
+	if value == "" {
+		p.fail(inst, "%s requires a value", inst.cmd)
+
+		return
+	}
+
+	p.addStep(inst.cmd, expand(value, p.vars))
+}
+
+func (p *parser) parseCommand(inst instruction) *command {
+	args, exec := parseExecForm(inst.rest)
+	if len(args) == 0 || (len(args) == 1 && args[0] == "") {
+		p.fail(inst, "%s requires a command", inst.cmd)
+
+		return nil
+	}
+
+	return &command{args: args, exec: exec}
+}
+
+// parseHealthcheck compiles the HEALTHCHECK to the command ready probe, the durations are rounded up to whole seconds.
+// The health of the running sandbox isn't checked periodically, the probe is run only until the sandbox is ready.
+func (p *parser) parseHealthcheck(inst instruction) {
+	flags, rest := parseFlags(inst.rest)
+
+	if strings.EqualFold(rest, "NONE") {
+		p.readyProbe = nil
+
+		return
+	}
+
+	probe := &templatemanager.ReadyProbe{}
+	for _, f := range flags {
+		switch f.name {
+		case "interval", "timeout":
+			duration, err := time.ParseDuration(f.value)
+			if err != nil || duration <= 0 {
+				p.fail(inst, "HEALTHCHECK --%s requires a positive duration, got %q", f.name, f.value)
+
+				return
+			}
+
+			seconds := uint32(max((duration+time.Second-1)/time.Second, 1))
+			if f.name == "interval" {
+				probe.IntervalSeconds = &seconds
+			} else {
+				probe.TimeoutSeconds = &seconds
+			}
+		case "retries":
+			retries, err := strconv.ParseInt(f.value, 10, 32)
+			if err != nil || retries < 0 {
+				p.fail(inst, "HEALTHCHECK --retries requires a non-negative number, got %q", f.value)
+
+				return
+			}
+
+			r := uint32(retries)
+			probe.Retries = &r
+		default:
+			p.fail(inst, "HEALTHCHECK --%s is not supported", f.name)
+
+			return
+		}
+	}
+
+	cmd, args, _ := strings.Cut(rest, " ")
+	if !strings.EqualFold(cmd, "CMD") {
+		p.fail(inst, "HEALTHCHECK requires CMD or NONE")
+
+		return
+	}
+
+	command, exec := parseExecForm(strings.TrimSpace(args))
+	if len(command) == 0 || command[0] == "" {
+		p.fail(inst, "HEALTHCHECK requires a command")
+
+		return
+	}
+
+	readyCmd := command[0]
+	if exec {
+		readyCmd = shellJoin(command)
+	}
+
+	probe.Check = &templatemanager.ReadyProbe_Command{Command: readyCmd}
+	p.readyProbe = probe
+}
+
+// scope returns the variables available for the expansion, the global args are used only before FROM.
+func (p *parser) scope() map[string]string {
+	if p.fromLine == 0 {
+		return p.globalArgs
+	}
+
+	return p.vars
+}
+
+func (p *parser) addStep(stepType string, args ...string) {
+	p.template.Steps = append(p.template.Steps, &templatemanager.TemplateStep{
+		Type: stepType,
+		Args: args,
+	})
+}
+
+// usedStages drops the stages whose files are not copied to the template, even through the other stages.
+func usedStages(stages []*templatemanager.TemplateStage, steps []*templatemanager.TemplateStep) []*templatemanager.TemplateStage {
+	used := map[string]bool{}
+	markUsed := func(steps []*templatemanager.TemplateStep) {
+		for _, step := range steps {
+			if fromStage := step.GetFromStage(); fromStage != "" {
+				used[fromStage] = true
+			}
+		}
+	}
+
+	markUsed(steps)
+
+	// The stages can copy only from the previous stages
+	for i := len(stages) - 1; i >= 0; i-- {
+		if used[stages[i].GetName()] {
+			markUsed(stages[i].GetSteps())
+		}
+	}
+
+	result := make([]*templatemanager.TemplateStage, 0, len(stages))
+	for _, stage := range stages {
+		if used[stage.GetName()] {
+			result = append(result, stage)
+		}
+	}
+
+	return result
+}
+
+// startCommand compiles the CMD and ENTRYPOINT to a shell command, the CMD is appended to the exec form ENTRYPOINT the same way as in Docker.
+func startCommand(entrypoint, cmd *command) *string {
+	var startCmd string
+
+	switch {
+	case entrypoint != nil && !entrypoint.exec:
+		startCmd = entrypoint.args[0]
+	case entrypoint != nil:
+		args := entrypoint.args
+		if cmd != nil {
+			if cmd.exec {
+				args = append(args, cmd.args...)
+			} else {
+				args = append(args, "/bin/sh", "-c", cmd.args[0])
+			}
+		}
+
+		startCmd = shellJoin(args)
+	case cmd != nil && cmd.exec:
+		startCmd = shellJoin(cmd.args)
+	case cmd != nil:
+		startCmd = cmd.args[0]
+	default:
+		return nil
+	}
+
+	return &startCmd
+}
+
+// splitInstructions joins the continuation lines and drops the comments and empty lines.
+func splitInstructions(dockerfile string) []instruction {
+	var instructions []instruction
+
+	var current *instruction
+	for i, line := range strings.Split(strings.ReplaceAll(dockerfile, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		// The comments and empty lines are dropped also inside of the continuations.
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		continued := strings.HasSuffix(trimmed, "\\")
+		if continued {
+			trimmed = strings.TrimSpace(strings.TrimSuffix(trimmed, "\\"))
+		}
+
+		if current == nil {
+			cmd, rest, _ := strings.Cut(trimmed, " ")
+			current = &instruction{line: i + 1, cmd: strings.ToUpper(strings.TrimSpace(cmd)), rest: rest}
+		} else {
+			current.rest += " " + trimmed
+		}
+
+		if !continued {
+			current.rest = strings.TrimSpace(current.rest)
+			instructions = append(instructions, *current)
+			current = nil
+		}
+	}
+
+	if current != nil {
+		current.rest = strings.TrimSpace(current.rest)
+		instructions = append(instructions, *current)
+	}
+
+	return instructions
+}
+
+type flag struct {
+	name  string
+	value string
+}
+
+// parseFlags parses the leading `--name=value` flags of the instruction.
+func parseFlags(rest string) ([]flag, string) {
+	var flags []flag
+
+	for strings.HasPrefix(rest, "--") {
+		arg, remaining, _ := strings.Cut(rest, " ")
+		name, value, _ := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		flags = append(flags, flag{name: name, value: value})
+		rest = strings.TrimSpace(remaining)
+	}
+
+	return flags, rest
+}
+
+// parseExecForm parses the JSON array form, the rest is returned as a single shell form argument otherwise.
+func parseExecForm(rest string) ([]string, bool) {
+	if strings.HasPrefix(rest, "[") {
+		var args []string
+		if err := json.Unmarshal([]byte(rest), &args); err == nil {
+			return args, true
+		}
+	}
+
+	return []string{rest}, false
+}
+
+// splitWords splits the instruction arguments by whitespace, the quotes and backslash escapes are removed.
+func splitWords(s string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != '\'' && r == '\\' && i+1 < len(runes):
+			i++
+			word.WriteRune(runes[i])
+			inWord = true
+		case quote != 0:
+			word.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("has an unterminated %c quote", quote)
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
+
+var varRegex = regexp.MustCompile(`\$(?:\{([A-Za-z_][A-Za-z0-9_]*)(?::([-+])([^}]*))?\}|([A-Za-z_][A-Za-z0-9_]*))`)
+
+// expand replaces the known variables, the unknown variables are kept, so they can still be evaluated in the sandbox.
+func expand(s string, vars map[string]string) string {
+	return varRegex.ReplaceAllStringFunc(s, func(match string) string {
+		groups := varRegex.FindStringSubmatch(match)
+		name, modifier, word := groups[1], groups[2], groups[3]
+		if name == "" {
+			name = groups[4]
+		}
+
+		value, ok := vars[name]
+
+		switch modifier {
+		case "-":
+			if !ok || value == "" {
+				return word
+			}
+		case "+":
+			if ok && value != "" {
+				return word
+			}
+
+			return ""
+		}
+
+		if !ok {
+			return match
+		}
+
+		return value
+	})
+}
+
+// shellJoin joins the exec form arguments to a shell command.
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+func shellQuote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`!*?[]{}()<>|&;#~") {
+		return arg
+	}
+
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
@@ -0,0 +1,236 @@
+package dockerfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+func step(stepType string, args ...string) *templatemanager.TemplateStep {
+	return &templatemanager.TemplateStep{Type: stepType, Args: args}
+}
+
+func copyFrom(stage string, args ...string) *templatemanager.TemplateStep {
+	return &templatemanager.TemplateStep{Type: "COPY", Args: args, FromStage: &stage}
+}
+
+func TestParse(t *testing.T) {
+	dockerfile := `# syntax=docker/dockerfile:1
+ARG VERSION=3.12
+FROM python:${VERSION}-slim AS base
+
+LABEL maintainer="team@example.com"
+ARG VERSION
+ARG PIP_INDEX
+ENV APP_HOME=/app \
+    GREETING="hello world"
+WORKDIR $APP_HOME
+RUN apt-get update && \
+    # the comments inside of the continuations are dropped
+    apt-get install -y curl
+RUN ["pip", "install", "requests"]
+COPY --chown=user:user --chmod=755 requirements.txt setup.py ${APP_HOME}/
+USER user
+EXPOSE 8000
+ENTRYPOINT ["python", "-m", "app"]
+CMD ["--port", "8000"]
+`
+
+	template, err := Parse(dockerfile, map[string]string{"PIP_INDEX": "https://pypi.example.com"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "python:3.12-slim", template.FromImage)
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		step("ARG", "VERSION", "3.12"),
+		step("ARG", "PIP_INDEX", "https://pypi.example.com"),
+		step("ENV", "APP_HOME", "/app", "GREETING", "hello world"),
+		step("WORKDIR", "/app"),
+		step("RUN", "apt-get update && apt-get install -y curl"),
+		step("RUN", "pip install requests"),
+		step("COPY", "requirements.txt", "/app/", "user:user", "755"),
+		step("COPY", "setup.py", "/app/", "user:user", "755"),
+		step("USER", "user"),
+	}, template.Steps)
+
+	require.NotNil(t, template.StartCmd)
+	assert.Equal(t, "python -m app --port 8000", *template.StartCmd)
+}
+
+func TestParse_StartCommand(t *testing.T) {
+	tests := []struct {
+		name         string
+		instructions string
+		want         *string
+	}{
+		{name: "none"},
+		{name: "shell cmd", instructions: "CMD npm start", want: ptr("npm start")},
+		{name: "exec cmd with quoting", instructions: `CMD ["echo", "hello world"]`, want: ptr("echo 'hello world'")},
+		{name: "shell entrypoint ignores cmd", instructions: "ENTRYPOINT ./run.sh\nCMD [\"--debug\"]", want: ptr("./run.sh")},
+		{name: "exec entrypoint with shell cmd", instructions: "ENTRYPOINT [\"tini\", \"--\"]\nCMD node index.js", want: ptr("tini -- /bin/sh -c 'node index.js'")},
+		{name: "last cmd wins", instructions: "CMD first\nCMD second", want: ptr("second")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := Parse("FROM ubuntu:22.04\n"+tt.instructions, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, template.StartCmd)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	dockerfile := `FROM ubuntu:22.04 AS base
+STOPSIGNAL SIGTERM
+RUN --mount=type=cache,target=/root/.cache pip install x
+ADD --from=builder /out /out
+ADD https://example.com/file.tar.gz /tmp/
+FROM alpine AS BASE
+FROM base
+RUN <<EOF
+EOF
+`
+
+	_, err := Parse(dockerfile, nil)
+	require.Error(t, err)
+
+	assert.Equal(t, `line 2: STOPSIGNAL instruction is not supported
+line 3: RUN --mount type=cache is not supported, only the secret mounts are
+line 4: ADD --from is not supported, use COPY --from instead
+line 5: ADD from remote URLs is not supported, use RUN with curl or git instead
+line 6: the stage name base is already used
+line 7: FROM a previous stage is not supported, copy its files with COPY --from instead
+line 8: heredocs are not supported
+line 9: unknown instruction EOF`, err.Error())
+}
+
+func TestParse_MultiStage(t *testing.T) {
+	dockerfile := `ARG GO_VERSION=1.24
+FROM golang:${GO_VERSION} AS Builder
+ENV CGO_ENABLED=0
+WORKDIR /src
+RUN go build -o /out/server ./cmd/server
+CMD ["ignored"]
+
+FROM node:22 AS unused
+RUN npm ci
+
+FROM alpine:3.20
+RUN apk add --no-cache ca-certificates
+
+FROM ubuntu:22.04
+ENV APP=/app
+COPY --from=builder /out/server ${APP}/server
+COPY --from=2 /etc/ssl/certs /etc/ssl/certs
+COPY --from=base-tools /usr/local/bin/tool /usr/local/bin/tool
+COPY --from=builder --chown=user:user /src/config.yaml $CGO_ENABLED/config.yaml
+`
+
+	template, err := Parse(dockerfile, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ubuntu:22.04", template.FromImage)
+	assert.Nil(t, template.StartCmd, "the commands of the previous stages are not inherited")
+
+	require.Len(t, template.Stages, 2, "the unused stage is dropped")
+
+	assert.Equal(t, "builder", template.Stages[0].GetName())
+	assert.Equal(t, "golang:1.24", template.Stages[0].GetFromImage())
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		step("ENV", "CGO_ENABLED", "0"),
+		step("WORKDIR", "/src"),
+		step("RUN", "go build -o /out/server ./cmd/server"),
+	}, template.Stages[0].GetSteps())
+
+	assert.Equal(t, "2", template.Stages[1].GetName(), "the unnamed stage is named by its index")
+	assert.Equal(t, "alpine:3.20", template.Stages[1].GetFromImage())
+
+	// The names which are not stages are kept as the template aliases and the variables of the previous stages are not inherited
+	assert.Equal(t, []*templatemanager.TemplateStep{
+		step("ENV", "APP", "/app"),
+		copyFrom("builder", "/out/server", "/app/server"),
+		copyFrom("2", "/etc/ssl/certs", "/etc/ssl/certs"),
+		copyFrom("base-tools", "/usr/local/bin/tool", "/usr/local/bin/tool"),
+		copyFrom("builder", "/src/config.yaml", "$CGO_ENABLED/config.yaml", "user:user"),
+	}, template.Steps)
+}
+
+func TestParse_SecretMounts(t *testing.T) {
+	dockerfile := `FROM node:22
+RUN --mount=type=secret,id=npmrc,target=/root/.npmrc npm ci
+RUN --mount=type=secret,id=token,env=GITHUB_TOKEN --mount=type=secret,target=/run/secrets/aws ./deploy.sh
+`
+
+	template, err := Parse(dockerfile, nil)
+	require.NoError(t, err)
+
+	require.Len(t, template.Steps, 2)
+
+	assert.Equal(t, "npm ci", template.Steps[0].GetArgs()[0])
+	assert.Equal(t, []*templatemanager.TemplateSecretMount{
+		{Id: "npmrc", Target: ptr("/root/.npmrc")},
+	}, template.Steps[0].GetSecrets())
+
+	assert.Equal(t, []*templatemanager.TemplateSecretMount{
+		{Id: "token", Env: ptr("GITHUB_TOKEN")},
+		{Id: "aws", Target: ptr("/run/secrets/aws")},
+	}, template.Steps[1].GetSecrets(), "the ID defaults to the target file name")
+
+	_, err = Parse("FROM node:22\nRUN --mount=type=secret,uid=1000 npm ci\n", nil)
+	require.EqualError(t, err, "line 2: RUN --mount option uid is not supported")
+}
+
+func TestParse_Healthcheck(t *testing.T) {
+	template, err := Parse(`FROM node:22
+HEALTHCHECK --interval=500ms --timeout=3s --retries=5 CMD ["curl", "-f", "http://localhost:3000/"]
+CMD ["node", "server.js"]
+`, nil)
+	require.NoError(t, err)
+
+	interval, timeout, retries := uint32(1), uint32(3), uint32(5)
+	assert.Equal(t, &templatemanager.ReadyProbe{
+		Check:           &templatemanager.ReadyProbe_Command{Command: "curl -f http://localhost:3000/"},
+		IntervalSeconds: &interval,
+		TimeoutSeconds:  &timeout,
+		Retries:         &retries,
+	}, template.ReadyProbe, "the durations are rounded up to the seconds")
+
+	template, err = Parse("FROM node:22\nHEALTHCHECK CMD pg_isready\nHEALTHCHECK NONE\n", nil)
+	require.NoError(t, err)
+	assert.Nil(t, template.ReadyProbe, "the probe is disabled by NONE")
+
+	template, err = Parse("FROM node:22 AS builder\nHEALTHCHECK CMD pg_isready\nFROM node:22\n", nil)
+	require.NoError(t, err)
+	assert.Nil(t, template.ReadyProbe, "the probe of the previous stage is not inherited")
+
+	_, err = Parse("FROM node:22\nHEALTHCHECK --start-period=5s CMD pg_isready\nHEALTHCHECK --retries=-1 CMD pg_isready\nHEALTHCHECK pg_isready\n", nil)
+	require.EqualError(t, err, `line 2: HEALTHCHECK --start-period is not supported
+line 3: HEALTHCHECK --retries requires a non-negative number, got "-1"
+line 4: HEALTHCHECK requires CMD or NONE`)
+}
+
+func TestParse_MissingFrom(t *testing.T) {
+	_, err := Parse("ARG VERSION=1\n", nil)
+	require.EqualError(t, err, "the Dockerfile has no FROM instruction")
+
+	_, err = Parse("RUN echo hello\n", nil)
+	require.EqualError(t, err, "line 1: RUN instruction must be after the FROM instruction")
+}
+
+func TestExpand(t *testing.T) {
+	vars := map[string]string{"NAME": "app", "EMPTY": ""}
+
+	assert.Equal(t, "/srv/app", expand("/srv/$NAME", vars))
+	assert.Equal(t, "/srv/app-1", expand("/srv/${NAME}-1", vars))
+	assert.Equal(t, "default", expand("${EMPTY:-default}", vars))
+	assert.Equal(t, "set", expand("${NAME:+set}", vars))
+	assert.Equal(t, "", expand("${MISSING:+set}", vars))
+	assert.Equal(t, "$HOME/bin", expand("$HOME/bin", vars), "the unknown variables should be kept")
+}
+
+func ptr(s string) *string {
+	return &s
+}
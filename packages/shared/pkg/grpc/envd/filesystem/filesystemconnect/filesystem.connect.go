@@ -43,8 +43,20 @@ const (
 	FilesystemListDirProcedure = "/filesystem.Filesystem/ListDir"
 	// FilesystemRemoveProcedure is the fully-qualified name of the Filesystem's Remove RPC.
 	FilesystemRemoveProcedure = "/filesystem.Filesystem/Remove"
+	// FilesystemCopyProcedure is the fully-qualified name of the Filesystem's Copy RPC.
+	FilesystemCopyProcedure = "/filesystem.Filesystem/Copy"
+	// FilesystemChmodProcedure is the fully-qualified name of the Filesystem's Chmod RPC.
+	FilesystemChmodProcedure = "/filesystem.Filesystem/Chmod"
+	// FilesystemChownProcedure is the fully-qualified name of the Filesystem's Chown RPC.
+	FilesystemChownProcedure = "/filesystem.Filesystem/Chown"
+	// FilesystemSymlinkProcedure is the fully-qualified name of the Filesystem's Symlink RPC.
+	FilesystemSymlinkProcedure = "/filesystem.Filesystem/Symlink"
+	// FilesystemWriteFileProcedure is the fully-qualified name of the Filesystem's WriteFile RPC.
+	FilesystemWriteFileProcedure = "/filesystem.Filesystem/WriteFile"
 	// FilesystemWatchDirProcedure is the fully-qualified name of the Filesystem's WatchDir RPC.
 	FilesystemWatchDirProcedure = "/filesystem.Filesystem/WatchDir"
+	// FilesystemSearchProcedure is the fully-qualified name of the Filesystem's Search RPC.
+	FilesystemSearchProcedure = "/filesystem.Filesystem/Search"
 	// FilesystemCreateWatcherProcedure is the fully-qualified name of the Filesystem's CreateWatcher
 	// RPC.
 	FilesystemCreateWatcherProcedure = "/filesystem.Filesystem/CreateWatcher"
@@ -63,7 +75,13 @@ type FilesystemClient interface {
 	Move(context.Context, *connect.Request[filesystem.MoveRequest]) (*connect.Response[filesystem.MoveResponse], error)
 	ListDir(context.Context, *connect.Request[filesystem.ListDirRequest]) (*connect.Response[filesystem.ListDirResponse], error)
 	Remove(context.Context, *connect.Request[filesystem.RemoveRequest]) (*connect.Response[filesystem.RemoveResponse], error)
+	Copy(context.Context, *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error)
+	Chmod(context.Context, *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error)
+	Chown(context.Context, *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error)
+	Symlink(context.Context, *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error)
+	WriteFile(context.Context, *connect.Request[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error)
 	WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest]) (*connect.ServerStreamForClient[filesystem.WatchDirResponse], error)
+	Search(context.Context, *connect.Request[filesystem.SearchRequest]) (*connect.ServerStreamForClient[filesystem.SearchResponse], error)
 	// Non-streaming versions of WatchDir
 	CreateWatcher(context.Context, *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error)
 	GetWatcherEvents(context.Context, *connect.Request[filesystem.GetWatcherEventsRequest]) (*connect.Response[filesystem.GetWatcherEventsResponse], error)
@@ -111,12 +129,48 @@ func NewFilesystemClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(filesystemMethods.ByName("Remove")),
 			connect.WithClientOptions(opts...),
 		),
+		copy: connect.NewClient[filesystem.CopyRequest, filesystem.CopyResponse](
+			httpClient,
+			baseURL+FilesystemCopyProcedure,
+			connect.WithSchema(filesystemMethods.ByName("Copy")),
+			connect.WithClientOptions(opts...),
+		),
+		chmod: connect.NewClient[filesystem.ChmodRequest, filesystem.ChmodResponse](
+			httpClient,
+			baseURL+FilesystemChmodProcedure,
+			connect.WithSchema(filesystemMethods.ByName("Chmod")),
+			connect.WithClientOptions(opts...),
+		),
+		chown: connect.NewClient[filesystem.ChownRequest, filesystem.ChownResponse](
+			httpClient,
+			baseURL+FilesystemChownProcedure,
+			connect.WithSchema(filesystemMethods.ByName("Chown")),
+			connect.WithClientOptions(opts...),
+		),
+		symlink: connect.NewClient[filesystem.SymlinkRequest, filesystem.SymlinkResponse](
+			httpClient,
+			baseURL+FilesystemSymlinkProcedure,
+			connect.WithSchema(filesystemMethods.ByName("Symlink")),
+			connect.WithClientOptions(opts...),
+		),
+		writeFile: connect.NewClient[filesystem.WriteFileRequest, filesystem.WriteFileResponse](
+			httpClient,
+			baseURL+FilesystemWriteFileProcedure,
+			connect.WithSchema(filesystemMethods.ByName("WriteFile")),
+			connect.WithClientOptions(opts...),
+		),
 		watchDir: connect.NewClient[filesystem.WatchDirRequest, filesystem.WatchDirResponse](
 			httpClient,
 			baseURL+FilesystemWatchDirProcedure,
 			connect.WithSchema(filesystemMethods.ByName("WatchDir")),
 			connect.WithClientOptions(opts...),
 		),
+		search: connect.NewClient[filesystem.SearchRequest, filesystem.SearchResponse](
+			httpClient,
+			baseURL+FilesystemSearchProcedure,
+			connect.WithSchema(filesystemMethods.ByName("Search")),
+			connect.WithClientOptions(opts...),
+		),
 		createWatcher: connect.NewClient[filesystem.CreateWatcherRequest, filesystem.CreateWatcherResponse](
 			httpClient,
 			baseURL+FilesystemCreateWatcherProcedure,
@@ -145,7 +199,13 @@ type filesystemClient struct {
 	move             *connect.Client[filesystem.MoveRequest, filesystem.MoveResponse]
 	listDir          *connect.Client[filesystem.ListDirRequest, filesystem.ListDirResponse]
 	remove           *connect.Client[filesystem.RemoveRequest, filesystem.RemoveResponse]
+	copy             *connect.Client[filesystem.CopyRequest, filesystem.CopyResponse]
+	chmod            *connect.Client[filesystem.ChmodRequest, filesystem.ChmodResponse]
+	chown            *connect.Client[filesystem.ChownRequest, filesystem.ChownResponse]
+	symlink          *connect.Client[filesystem.SymlinkRequest, filesystem.SymlinkResponse]
+	writeFile        *connect.Client[filesystem.WriteFileRequest, filesystem.WriteFileResponse]
 	watchDir         *connect.Client[filesystem.WatchDirRequest, filesystem.WatchDirResponse]
+	search           *connect.Client[filesystem.SearchRequest, filesystem.SearchResponse]
 	createWatcher    *connect.Client[filesystem.CreateWatcherRequest, filesystem.CreateWatcherResponse]
 	getWatcherEvents *connect.Client[filesystem.GetWatcherEventsRequest, filesystem.GetWatcherEventsResponse]
 	removeWatcher    *connect.Client[filesystem.RemoveWatcherRequest, filesystem.RemoveWatcherResponse]
@@ -176,11 +236,41 @@ func (c *filesystemClient) Remove(ctx context.Context, req *connect.Request[file
 	return c.remove.CallUnary(ctx, req)
 }
 
+// Copy calls filesystem.Filesystem.Copy.
+func (c *filesystemClient) Copy(ctx context.Context, req *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error) {
+	return c.copy.CallUnary(ctx, req)
+}
+
+// Chmod calls filesystem.Filesystem.Chmod.
+func (c *filesystemClient) Chmod(ctx context.Context, req *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error) {
+	return c.chmod.CallUnary(ctx, req)
+}
+
+// Chown calls filesystem.Filesystem.Chown.
+func (c *filesystemClient) Chown(ctx context.Context, req *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error) {
+	return c.chown.CallUnary(ctx, req)
+}
+
+// Symlink calls filesystem.Filesystem.Symlink.
+func (c *filesystemClient) Symlink(ctx context.Context, req *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error) {
+	return c.symlink.CallUnary(ctx, req)
+}
+
+// WriteFile calls filesystem.Filesystem.WriteFile.
+func (c *filesystemClient) WriteFile(ctx context.Context, req *connect.Request[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error) {
+	return c.writeFile.CallUnary(ctx, req)
+}
+
 // WatchDir calls filesystem.Filesystem.WatchDir.
 func (c *filesystemClient) WatchDir(ctx context.Context, req *connect.Request[filesystem.WatchDirRequest]) (*connect.ServerStreamForClient[filesystem.WatchDirResponse], error) {
 	return c.watchDir.CallServerStream(ctx, req)
 }
 
+// Search calls filesystem.Filesystem.Search.
+func (c *filesystemClient) Search(ctx context.Context, req *connect.Request[filesystem.SearchRequest]) (*connect.ServerStreamForClient[filesystem.SearchResponse], error) {
+	return c.search.CallServerStream(ctx, req)
+}
+
 // CreateWatcher calls filesystem.Filesystem.CreateWatcher.
 func (c *filesystemClient) CreateWatcher(ctx context.Context, req *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error) {
 	return c.createWatcher.CallUnary(ctx, req)
@@ -203,7 +293,13 @@ type FilesystemHandler interface {
 	Move(context.Context, *connect.Request[filesystem.MoveRequest]) (*connect.Response[filesystem.MoveResponse], error)
 	ListDir(context.Context, *connect.Request[filesystem.ListDirRequest]) (*connect.Response[filesystem.ListDirResponse], error)
 	Remove(context.Context, *connect.Request[filesystem.RemoveRequest]) (*connect.Response[filesystem.RemoveResponse], error)
+	Copy(context.Context, *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error)
+	Chmod(context.Context, *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error)
+	Chown(context.Context, *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error)
+	Symlink(context.Context, *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error)
+	WriteFile(context.Context, *connect.Request[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error)
 	WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest], *connect.ServerStream[filesystem.WatchDirResponse]) error
+	Search(context.Context, *connect.Request[filesystem.SearchRequest], *connect.ServerStream[filesystem.SearchResponse]) error
 	// Non-streaming versions of WatchDir
 	CreateWatcher(context.Context, *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error)
 	GetWatcherEvents(context.Context, *connect.Request[filesystem.GetWatcherEventsRequest]) (*connect.Response[filesystem.GetWatcherEventsResponse], error)
@@ -247,12 +343,48 @@ func NewFilesystemHandler(svc FilesystemHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(filesystemMethods.ByName("Remove")),
 		connect.WithHandlerOptions(opts...),
 	)
+	filesystemCopyHandler := connect.NewUnaryHandler(
+		FilesystemCopyProcedure,
+		svc.Copy,
+		connect.WithSchema(filesystemMethods.ByName("Copy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemChmodHandler := connect.NewUnaryHandler(
+		FilesystemChmodProcedure,
+		svc.Chmod,
+		connect.WithSchema(filesystemMethods.ByName("Chmod")),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemChownHandler := connect.NewUnaryHandler(
+		FilesystemChownProcedure,
+		svc.Chown,
+		connect.WithSchema(filesystemMethods.ByName("Chown")),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemSymlinkHandler := connect.NewUnaryHandler(
+		FilesystemSymlinkProcedure,
+		svc.Symlink,
+		connect.WithSchema(filesystemMethods.ByName("Symlink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	filesystemWriteFileHandler := connect.NewUnaryHandler(
+		FilesystemWriteFileProcedure,
+		svc.WriteFile,
+		connect.WithSchema(filesystemMethods.ByName("WriteFile")),
+		connect.WithHandlerOptions(opts...),
+	)
 	filesystemWatchDirHandler := connect.NewServerStreamHandler(
 		FilesystemWatchDirProcedure,
 		svc.WatchDir,
 		connect.WithSchema(filesystemMethods.ByName("WatchDir")),
 		connect.WithHandlerOptions(opts...),
 	)
+	filesystemSearchHandler := connect.NewServerStreamHandler(
+		FilesystemSearchProcedure,
+		svc.Search,
+		connect.WithSchema(filesystemMethods.ByName("Search")),
+		connect.WithHandlerOptions(opts...),
+	)
 	filesystemCreateWatcherHandler := connect.NewUnaryHandler(
 		FilesystemCreateWatcherProcedure,
 		svc.CreateWatcher,
@@ -283,8 +415,20 @@ func NewFilesystemHandler(svc FilesystemHandler, opts ...connect.HandlerOption)
 			filesystemListDirHandler.ServeHTTP(w, r)
 		case FilesystemRemoveProcedure:
 			filesystemRemoveHandler.ServeHTTP(w, r)
+		case FilesystemCopyProcedure:
+			filesystemCopyHandler.ServeHTTP(w, r)
+		case FilesystemChmodProcedure:
+			filesystemChmodHandler.ServeHTTP(w, r)
+		case FilesystemChownProcedure:
+			filesystemChownHandler.ServeHTTP(w, r)
+		case FilesystemSymlinkProcedure:
+			filesystemSymlinkHandler.ServeHTTP(w, r)
+		case FilesystemWriteFileProcedure:
+			filesystemWriteFileHandler.ServeHTTP(w, r)
 		case FilesystemWatchDirProcedure:
 			filesystemWatchDirHandler.ServeHTTP(w, r)
+		case FilesystemSearchProcedure:
+			filesystemSearchHandler.ServeHTTP(w, r)
 		case FilesystemCreateWatcherProcedure:
 			filesystemCreateWatcherHandler.ServeHTTP(w, r)
 		case FilesystemGetWatcherEventsProcedure:
@@ -320,10 +464,34 @@ func (UnimplementedFilesystemHandler) Remove(context.Context, *connect.Request[f
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Remove is not implemented"))
 }
 
+func (UnimplementedFilesystemHandler) Copy(context.Context, *connect.Request[filesystem.CopyRequest]) (*connect.Response[filesystem.CopyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Copy is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Chmod(context.Context, *connect.Request[filesystem.ChmodRequest]) (*connect.Response[filesystem.ChmodResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Chmod is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Chown(context.Context, *connect.Request[filesystem.ChownRequest]) (*connect.Response[filesystem.ChownResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Chown is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) Symlink(context.Context, *connect.Request[filesystem.SymlinkRequest]) (*connect.Response[filesystem.SymlinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Symlink is not implemented"))
+}
+
+func (UnimplementedFilesystemHandler) WriteFile(context.Context, *connect.Request[filesystem.WriteFileRequest]) (*connect.Response[filesystem.WriteFileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.WriteFile is not implemented"))
+}
+
 func (UnimplementedFilesystemHandler) WatchDir(context.Context, *connect.Request[filesystem.WatchDirRequest], *connect.ServerStream[filesystem.WatchDirResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.WatchDir is not implemented"))
 }
 
+func (UnimplementedFilesystemHandler) Search(context.Context, *connect.Request[filesystem.SearchRequest], *connect.ServerStream[filesystem.SearchResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.Search is not implemented"))
+}
+
 func (UnimplementedFilesystemHandler) CreateWatcher(context.Context, *connect.Request[filesystem.CreateWatcherRequest]) (*connect.Response[filesystem.CreateWatcherResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("filesystem.Filesystem.CreateWatcher is not implemented"))
 }
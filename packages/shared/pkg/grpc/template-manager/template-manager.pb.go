@@ -80,6 +80,7 @@ const (
 	TemplateBuildState_Building  TemplateBuildState = 0
 	TemplateBuildState_Failed    TemplateBuildState = 1
 	TemplateBuildState_Completed TemplateBuildState = 2
+	TemplateBuildState_Cancelled TemplateBuildState = 3
 )
 
 // Enum value maps for TemplateBuildState.
@@ -88,11 +89,13 @@ var (
 		0: "Building",
 		1: "Failed",
 		2: "Completed",
+		3: "Cancelled",
 	}
 	TemplateBuildState_value = map[string]int32{
 		"Building":  0,
 		"Failed":    1,
 		"Completed": 2,
+		"Cancelled": 3,
 	}
 )
 
@@ -250,6 +253,10 @@ type TemplateStep struct {
 	Args      []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 	Force     *bool    `protobuf:"varint,3,opt,name=force,proto3,oneof" json:"force,omitempty"`
 	FilesHash *string  `protobuf:"bytes,4,opt,name=filesHash,proto3,oneof" json:"filesHash,omitempty"`
+	// Name of the build stage to copy the files from, only for the COPY steps
+	FromStage *string `protobuf:"bytes,5,opt,name=fromStage,proto3,oneof" json:"fromStage,omitempty"`
+	// Build secrets available to the RUN step, only for the RUN steps
+	Secrets []*TemplateSecretMount `protobuf:"bytes,6,rep,name=secrets,proto3" json:"secrets,omitempty"`
 }
 
 func (x *TemplateStep) Reset() {
@@ -312,6 +319,86 @@ func (x *TemplateStep) GetFilesHash() string {
 	return ""
 }
 
+func (x *TemplateStep) GetFromStage() string {
+	if x != nil && x.FromStage != nil {
+		return *x.FromStage
+	}
+	return ""
+}
+
+func (x *TemplateStep) GetSecrets() []*TemplateSecretMount {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+// Build secret mounted to the RUN step, it's never saved to the template
+type TemplateSecretMount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Environment variable set to the secret value
+	Env *string `protobuf:"bytes,2,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	// Path of the file with the secret value, defaults to /run/secrets/<id> when the env is not set
+	Target *string `protobuf:"bytes,3,opt,name=target,proto3,oneof" json:"target,omitempty"`
+}
+
+func (x *TemplateSecretMount) Reset() {
+	*x = TemplateSecretMount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateSecretMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateSecretMount) ProtoMessage() {}
+
+func (x *TemplateSecretMount) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateSecretMount.ProtoReflect.Descriptor instead.
+func (*TemplateSecretMount) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TemplateSecretMount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TemplateSecretMount) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *TemplateSecretMount) GetTarget() string {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return ""
+}
+
 type FromTemplateConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -324,7 +411,7 @@ type FromTemplateConfig struct {
 func (x *FromTemplateConfig) Reset() {
 	*x = FromTemplateConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[3]
+		mi := &file_template_manager_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +424,7 @@ func (x *FromTemplateConfig) String() string {
 func (*FromTemplateConfig) ProtoMessage() {}
 
 func (x *FromTemplateConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[3]
+	mi := &file_template_manager_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +437,7 @@ func (x *FromTemplateConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FromTemplateConfig.ProtoReflect.Descriptor instead.
 func (*FromTemplateConfig) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{3}
+	return file_template_manager_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *FromTemplateConfig) GetAlias() string {
@@ -381,7 +468,7 @@ type AWSRegistry struct {
 func (x *AWSRegistry) Reset() {
 	*x = AWSRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[4]
+		mi := &file_template_manager_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +481,7 @@ func (x *AWSRegistry) String() string {
 func (*AWSRegistry) ProtoMessage() {}
 
 func (x *AWSRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[4]
+	mi := &file_template_manager_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +494,7 @@ func (x *AWSRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AWSRegistry.ProtoReflect.Descriptor instead.
 func (*AWSRegistry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{4}
+	return file_template_manager_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AWSRegistry) GetAwsAccessKeyId() string {
@@ -443,7 +530,7 @@ type GCPRegistry struct {
 func (x *GCPRegistry) Reset() {
 	*x = GCPRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[5]
+		mi := &file_template_manager_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -456,7 +543,7 @@ func (x *GCPRegistry) String() string {
 func (*GCPRegistry) ProtoMessage() {}
 
 func (x *GCPRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[5]
+	mi := &file_template_manager_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +556,7 @@ func (x *GCPRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCPRegistry.ProtoReflect.Descriptor instead.
 func (*GCPRegistry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{5}
+	return file_template_manager_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GCPRegistry) GetServiceAccountJson() string {
@@ -492,7 +579,7 @@ type GeneralRegistry struct {
 func (x *GeneralRegistry) Reset() {
 	*x = GeneralRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[6]
+		mi := &file_template_manager_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -505,7 +592,7 @@ func (x *GeneralRegistry) String() string {
 func (*GeneralRegistry) ProtoMessage() {}
 
 func (x *GeneralRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[6]
+	mi := &file_template_manager_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +605,7 @@ func (x *GeneralRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneralRegistry.ProtoReflect.Descriptor instead.
 func (*GeneralRegistry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{6}
+	return file_template_manager_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GeneralRegistry) GetUsername() string {
@@ -552,7 +639,7 @@ type FromImageRegistry struct {
 func (x *FromImageRegistry) Reset() {
 	*x = FromImageRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[7]
+		mi := &file_template_manager_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -565,7 +652,7 @@ func (x *FromImageRegistry) String() string {
 func (*FromImageRegistry) ProtoMessage() {}
 
 func (x *FromImageRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[7]
+	mi := &file_template_manager_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +665,7 @@ func (x *FromImageRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FromImageRegistry.ProtoReflect.Descriptor instead.
 func (*FromImageRegistry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{7}
+	return file_template_manager_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *FromImageRegistry) GetType() isFromImageRegistry_Type {
@@ -631,49 +718,40 @@ func (*FromImageRegistry_Gcp) isFromImageRegistry_Type() {}
 
 func (*FromImageRegistry_General) isFromImageRegistry_Type() {}
 
-type TemplateConfig struct {
+// Git repository cloned in the build sandbox, the COPY steps without the uploaded files copy from its checkout
+type FromGitConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TemplateID         string          `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
-	BuildID            string          `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	MemoryMB           int32           `protobuf:"varint,3,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
-	VCpuCount          int32           `protobuf:"varint,4,opt,name=vCpuCount,proto3" json:"vCpuCount,omitempty"`
-	DiskSizeMB         int32           `protobuf:"varint,5,opt,name=diskSizeMB,proto3" json:"diskSizeMB,omitempty"`
-	KernelVersion      string          `protobuf:"bytes,6,opt,name=kernelVersion,proto3" json:"kernelVersion,omitempty"`
-	FirecrackerVersion string          `protobuf:"bytes,7,opt,name=firecrackerVersion,proto3" json:"firecrackerVersion,omitempty"`
-	StartCommand       string          `protobuf:"bytes,8,opt,name=startCommand,proto3" json:"startCommand,omitempty"`
-	HugePages          bool            `protobuf:"varint,9,opt,name=hugePages,proto3" json:"hugePages,omitempty"`
-	ReadyCommand       string          `protobuf:"bytes,10,opt,name=readyCommand,proto3" json:"readyCommand,omitempty"`
-	Force              *bool           `protobuf:"varint,12,opt,name=force,proto3,oneof" json:"force,omitempty"`
-	Steps              []*TemplateStep `protobuf:"bytes,13,rep,name=steps,proto3" json:"steps,omitempty"`
-	// Types that are assignable to Source:
-	//
-	//	*TemplateConfig_FromImage
-	//	*TemplateConfig_FromTemplate
-	Source            isTemplateConfig_Source `protobuf_oneof:"source"`
-	FromImageRegistry *FromImageRegistry      `protobuf:"bytes,15,opt,name=fromImageRegistry,proto3,oneof" json:"fromImageRegistry,omitempty"`
-	TeamID            string                  `protobuf:"bytes,16,opt,name=teamID,proto3" json:"teamID,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Branch, tag or commit to check out, the default branch is used when not set
+	Ref *string `protobuf:"bytes,2,opt,name=ref,proto3,oneof" json:"ref,omitempty"`
+	// Directory of the repository used as the build context
+	Subdirectory *string `protobuf:"bytes,3,opt,name=subdirectory,proto3,oneof" json:"subdirectory,omitempty"`
+	// Path of the Dockerfile in the build context, the template is built from it instead of the configured source and steps
+	DockerfilePath *string `protobuf:"bytes,4,opt,name=dockerfilePath,proto3,oneof" json:"dockerfilePath,omitempty"`
+	// ID of the build secret with the SSH deploy key of the repository
+	DeployKeySecret *string `protobuf:"bytes,5,opt,name=deployKeySecret,proto3,oneof" json:"deployKeySecret,omitempty"`
 }
 
-func (x *TemplateConfig) Reset() {
-	*x = TemplateConfig{}
+func (x *FromGitConfig) Reset() {
+	*x = FromGitConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[8]
+		mi := &file_template_manager_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TemplateConfig) String() string {
+func (x *FromGitConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TemplateConfig) ProtoMessage() {}
+func (*FromGitConfig) ProtoMessage() {}
 
-func (x *TemplateConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[8]
+func (x *FromGitConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,172 +762,812 @@ func (x *TemplateConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TemplateConfig.ProtoReflect.Descriptor instead.
-func (*TemplateConfig) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use FromGitConfig.ProtoReflect.Descriptor instead.
+func (*FromGitConfig) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TemplateConfig) GetTemplateID() string {
+func (x *FromGitConfig) GetUrl() string {
 	if x != nil {
-		return x.TemplateID
+		return x.Url
 	}
 	return ""
 }
 
-func (x *TemplateConfig) GetBuildID() string {
-	if x != nil {
-		return x.BuildID
+func (x *FromGitConfig) GetRef() string {
+	if x != nil && x.Ref != nil {
+		return *x.Ref
 	}
 	return ""
 }
 
-func (x *TemplateConfig) GetMemoryMB() int32 {
-	if x != nil {
-		return x.MemoryMB
+func (x *FromGitConfig) GetSubdirectory() string {
+	if x != nil && x.Subdirectory != nil {
+		return *x.Subdirectory
 	}
-	return 0
+	return ""
 }
 
-func (x *TemplateConfig) GetVCpuCount() int32 {
-	if x != nil {
-		return x.VCpuCount
+func (x *FromGitConfig) GetDockerfilePath() string {
+	if x != nil && x.DockerfilePath != nil {
+		return *x.DockerfilePath
 	}
-	return 0
+	return ""
 }
 
-func (x *TemplateConfig) GetDiskSizeMB() int32 {
-	if x != nil {
-		return x.DiskSizeMB
+func (x *FromGitConfig) GetDeployKeySecret() string {
+	if x != nil && x.DeployKeySecret != nil {
+		return *x.DeployKeySecret
 	}
-	return 0
+	return ""
 }
 
-func (x *TemplateConfig) GetKernelVersion() string {
-	if x != nil {
-		return x.KernelVersion
-	}
-	return ""
+// Named stage of the multi-stage build, its files can be copied to the template by the COPY steps
+type TemplateStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Steps []*TemplateStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Types that are assignable to Source:
+	//
+	//	*TemplateStage_FromImage
+	//	*TemplateStage_FromTemplate
+	Source isTemplateStage_Source `protobuf_oneof:"source"`
 }
 
-func (x *TemplateConfig) GetFirecrackerVersion() string {
-	if x != nil {
-		return x.FirecrackerVersion
+func (x *TemplateStage) Reset() {
+	*x = TemplateStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *TemplateConfig) GetStartCommand() string {
-	if x != nil {
-		return x.StartCommand
-	}
-	return ""
+func (x *TemplateStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TemplateConfig) GetHugePages() bool {
-	if x != nil {
-		return x.HugePages
+func (*TemplateStage) ProtoMessage() {}
+
+func (x *TemplateStage) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *TemplateConfig) GetReadyCommand() string {
-	if x != nil {
-		return x.ReadyCommand
-	}
-	return ""
+// Deprecated: Use TemplateStage.ProtoReflect.Descriptor instead.
+func (*TemplateStage) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TemplateConfig) GetForce() bool {
-	if x != nil && x.Force != nil {
-		return *x.Force
+func (x *TemplateStage) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *TemplateConfig) GetSteps() []*TemplateStep {
+func (x *TemplateStage) GetSteps() []*TemplateStep {
 	if x != nil {
 		return x.Steps
 	}
 	return nil
 }
 
-func (m *TemplateConfig) GetSource() isTemplateConfig_Source {
+func (m *TemplateStage) GetSource() isTemplateStage_Source {
 	if m != nil {
 		return m.Source
 	}
 	return nil
 }
 
-func (x *TemplateConfig) GetFromImage() string {
-	if x, ok := x.GetSource().(*TemplateConfig_FromImage); ok {
+func (x *TemplateStage) GetFromImage() string {
+	if x, ok := x.GetSource().(*TemplateStage_FromImage); ok {
 		return x.FromImage
 	}
 	return ""
 }
 
-func (x *TemplateConfig) GetFromTemplate() *FromTemplateConfig {
-	if x, ok := x.GetSource().(*TemplateConfig_FromTemplate); ok {
-		return x.FromTemplate
-	}
-	return nil
+func (x *TemplateStage) GetFromTemplate() *FromTemplateConfig {
+	if x, ok := x.GetSource().(*TemplateStage_FromTemplate); ok {
+		return x.FromTemplate
+	}
+	return nil
+}
+
+type isTemplateStage_Source interface {
+	isTemplateStage_Source()
+}
+
+type TemplateStage_FromImage struct {
+	FromImage string `protobuf:"bytes,3,opt,name=fromImage,proto3,oneof"`
+}
+
+type TemplateStage_FromTemplate struct {
+	FromTemplate *FromTemplateConfig `protobuf:"bytes,4,opt,name=fromTemplate,proto3,oneof"`
+}
+
+func (*TemplateStage_FromImage) isTemplateStage_Source() {}
+
+func (*TemplateStage_FromTemplate) isTemplateStage_Source() {}
+
+// HTTP GET readiness check, it passes when the response has the expected status
+type ReadyProbeHTTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Any 2xx status is accepted when not set
+	ExpectedStatus *uint32 `protobuf:"varint,3,opt,name=expectedStatus,proto3,oneof" json:"expectedStatus,omitempty"`
+}
+
+func (x *ReadyProbeHTTP) Reset() {
+	*x = ReadyProbeHTTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyProbeHTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyProbeHTTP) ProtoMessage() {}
+
+func (x *ReadyProbeHTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyProbeHTTP.ProtoReflect.Descriptor instead.
+func (*ReadyProbeHTTP) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReadyProbeHTTP) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ReadyProbeHTTP) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadyProbeHTTP) GetExpectedStatus() uint32 {
+	if x != nil && x.ExpectedStatus != nil {
+		return *x.ExpectedStatus
+	}
+	return 0
+}
+
+// TCP readiness check, it passes when the port accepts connections
+type ReadyProbeTCP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *ReadyProbeTCP) Reset() {
+	*x = ReadyProbeTCP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyProbeTCP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyProbeTCP) ProtoMessage() {}
+
+func (x *ReadyProbeTCP) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyProbeTCP.ProtoReflect.Descriptor instead.
+func (*ReadyProbeTCP) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReadyProbeTCP) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// Readiness probe of the template, it's evaluated when the template is built and on every sandbox start/resume
+type ReadyProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Check:
+	//
+	//	*ReadyProbe_Http
+	//	*ReadyProbe_Tcp
+	//	*ReadyProbe_Command
+	Check           isReadyProbe_Check `protobuf_oneof:"check"`
+	IntervalSeconds *uint32            `protobuf:"varint,4,opt,name=intervalSeconds,proto3,oneof" json:"intervalSeconds,omitempty"`
+	TimeoutSeconds  *uint32            `protobuf:"varint,5,opt,name=timeoutSeconds,proto3,oneof" json:"timeoutSeconds,omitempty"`
+	// Number of the failed checks after the first one before the sandbox is considered not ready
+	Retries *uint32 `protobuf:"varint,6,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+}
+
+func (x *ReadyProbe) Reset() {
+	*x = ReadyProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyProbe) ProtoMessage() {}
+
+func (x *ReadyProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyProbe.ProtoReflect.Descriptor instead.
+func (*ReadyProbe) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *ReadyProbe) GetCheck() isReadyProbe_Check {
+	if m != nil {
+		return m.Check
+	}
+	return nil
+}
+
+func (x *ReadyProbe) GetHttp() *ReadyProbeHTTP {
+	if x, ok := x.GetCheck().(*ReadyProbe_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *ReadyProbe) GetTcp() *ReadyProbeTCP {
+	if x, ok := x.GetCheck().(*ReadyProbe_Tcp); ok {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *ReadyProbe) GetCommand() string {
+	if x, ok := x.GetCheck().(*ReadyProbe_Command); ok {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ReadyProbe) GetIntervalSeconds() uint32 {
+	if x != nil && x.IntervalSeconds != nil {
+		return *x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *ReadyProbe) GetTimeoutSeconds() uint32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ReadyProbe) GetRetries() uint32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+type isReadyProbe_Check interface {
+	isReadyProbe_Check()
+}
+
+type ReadyProbe_Http struct {
+	Http *ReadyProbeHTTP `protobuf:"bytes,1,opt,name=http,proto3,oneof"`
+}
+
+type ReadyProbe_Tcp struct {
+	Tcp *ReadyProbeTCP `protobuf:"bytes,2,opt,name=tcp,proto3,oneof"`
+}
+
+type ReadyProbe_Command struct {
+	Command string `protobuf:"bytes,3,opt,name=command,proto3,oneof"`
+}
+
+func (*ReadyProbe_Http) isReadyProbe_Check() {}
+
+func (*ReadyProbe_Tcp) isReadyProbe_Check() {}
+
+func (*ReadyProbe_Command) isReadyProbe_Check() {}
+
+type TemplateConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID         string          `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	BuildID            string          `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	MemoryMB           int32           `protobuf:"varint,3,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`
+	VCpuCount          int32           `protobuf:"varint,4,opt,name=vCpuCount,proto3" json:"vCpuCount,omitempty"`
+	DiskSizeMB         int32           `protobuf:"varint,5,opt,name=diskSizeMB,proto3" json:"diskSizeMB,omitempty"`
+	KernelVersion      string          `protobuf:"bytes,6,opt,name=kernelVersion,proto3" json:"kernelVersion,omitempty"`
+	FirecrackerVersion string          `protobuf:"bytes,7,opt,name=firecrackerVersion,proto3" json:"firecrackerVersion,omitempty"`
+	StartCommand       string          `protobuf:"bytes,8,opt,name=startCommand,proto3" json:"startCommand,omitempty"`
+	HugePages          bool            `protobuf:"varint,9,opt,name=hugePages,proto3" json:"hugePages,omitempty"`
+	ReadyCommand       string          `protobuf:"bytes,10,opt,name=readyCommand,proto3" json:"readyCommand,omitempty"`
+	Force              *bool           `protobuf:"varint,12,opt,name=force,proto3,oneof" json:"force,omitempty"`
+	Steps              []*TemplateStep `protobuf:"bytes,13,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Types that are assignable to Source:
+	//
+	//	*TemplateConfig_FromImage
+	//	*TemplateConfig_FromTemplate
+	Source            isTemplateConfig_Source `protobuf_oneof:"source"`
+	FromImageRegistry *FromImageRegistry      `protobuf:"bytes,15,opt,name=fromImageRegistry,proto3,oneof" json:"fromImageRegistry,omitempty"`
+	TeamID            string                  `protobuf:"bytes,16,opt,name=teamID,proto3" json:"teamID,omitempty"`
+	Stages            []*TemplateStage        `protobuf:"bytes,17,rep,name=stages,proto3" json:"stages,omitempty"`
+	// Build secrets by their ID, they are available only to the RUN steps mounting them
+	Secrets    map[string]string `protobuf:"bytes,18,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ReadyProbe *ReadyProbe       `protobuf:"bytes,19,opt,name=readyProbe,proto3,oneof" json:"readyProbe,omitempty"`
+	FromGit    *FromGitConfig    `protobuf:"bytes,20,opt,name=fromGit,proto3,oneof" json:"fromGit,omitempty"`
+}
+
+func (x *TemplateConfig) Reset() {
+	*x = TemplateConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateConfig) ProtoMessage() {}
+
+func (x *TemplateConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateConfig.ProtoReflect.Descriptor instead.
+func (*TemplateConfig) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TemplateConfig) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetMemoryMB() int32 {
+	if x != nil {
+		return x.MemoryMB
+	}
+	return 0
+}
+
+func (x *TemplateConfig) GetVCpuCount() int32 {
+	if x != nil {
+		return x.VCpuCount
+	}
+	return 0
+}
+
+func (x *TemplateConfig) GetDiskSizeMB() int32 {
+	if x != nil {
+		return x.DiskSizeMB
+	}
+	return 0
+}
+
+func (x *TemplateConfig) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetFirecrackerVersion() string {
+	if x != nil {
+		return x.FirecrackerVersion
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetStartCommand() string {
+	if x != nil {
+		return x.StartCommand
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetHugePages() bool {
+	if x != nil {
+		return x.HugePages
+	}
+	return false
+}
+
+func (x *TemplateConfig) GetReadyCommand() string {
+	if x != nil {
+		return x.ReadyCommand
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetForce() bool {
+	if x != nil && x.Force != nil {
+		return *x.Force
+	}
+	return false
+}
+
+func (x *TemplateConfig) GetSteps() []*TemplateStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (m *TemplateConfig) GetSource() isTemplateConfig_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetFromImage() string {
+	if x, ok := x.GetSource().(*TemplateConfig_FromImage); ok {
+		return x.FromImage
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetFromTemplate() *FromTemplateConfig {
+	if x, ok := x.GetSource().(*TemplateConfig_FromTemplate); ok {
+		return x.FromTemplate
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetFromImageRegistry() *FromImageRegistry {
+	if x != nil {
+		return x.FromImageRegistry
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetTeamID() string {
+	if x != nil {
+		return x.TeamID
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetStages() []*TemplateStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetReadyProbe() *ReadyProbe {
+	if x != nil {
+		return x.ReadyProbe
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetFromGit() *FromGitConfig {
+	if x != nil {
+		return x.FromGit
+	}
+	return nil
+}
+
+type isTemplateConfig_Source interface {
+	isTemplateConfig_Source()
+}
+
+type TemplateConfig_FromImage struct {
+	FromImage string `protobuf:"bytes,11,opt,name=fromImage,proto3,oneof"`
+}
+
+type TemplateConfig_FromTemplate struct {
+	FromTemplate *FromTemplateConfig `protobuf:"bytes,14,opt,name=fromTemplate,proto3,oneof"`
+}
+
+func (*TemplateConfig_FromImage) isTemplateConfig_Source() {}
+
+func (*TemplateConfig_FromTemplate) isTemplateConfig_Source() {}
+
+type TemplateCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template   *TemplateConfig `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	CacheScope *string         `protobuf:"bytes,2,opt,name=cacheScope,proto3,oneof" json:"cacheScope,omitempty"`
+}
+
+func (x *TemplateCreateRequest) Reset() {
+	*x = TemplateCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateCreateRequest) ProtoMessage() {}
+
+func (x *TemplateCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateCreateRequest.ProtoReflect.Descriptor instead.
+func (*TemplateCreateRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TemplateCreateRequest) GetTemplate() *TemplateConfig {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *TemplateCreateRequest) GetCacheScope() string {
+	if x != nil && x.CacheScope != nil {
+		return *x.CacheScope
+	}
+	return ""
+}
+
+type TemplateStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID string    `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	BuildID    string    `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	Offset     *int32    `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Level      *LogLevel `protobuf:"varint,4,opt,name=level,proto3,enum=LogLevel,oneof" json:"level,omitempty"`
+}
+
+func (x *TemplateStatusRequest) Reset() {
+	*x = TemplateStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateStatusRequest) ProtoMessage() {}
+
+func (x *TemplateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateStatusRequest.ProtoReflect.Descriptor instead.
+func (*TemplateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TemplateStatusRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateStatusRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+func (x *TemplateStatusRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *TemplateStatusRequest) GetLevel() LogLevel {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return LogLevel_Debug
+}
+
+// Data required for deleting a template.
+type TemplateBuildDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildID    string `protobuf:"bytes,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	TemplateID string `protobuf:"bytes,2,opt,name=templateID,proto3" json:"templateID,omitempty"`
+}
+
+func (x *TemplateBuildDeleteRequest) Reset() {
+	*x = TemplateBuildDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildDeleteRequest) ProtoMessage() {}
+
+func (x *TemplateBuildDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildDeleteRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TemplateConfig) GetFromImageRegistry() *FromImageRegistry {
+func (x *TemplateBuildDeleteRequest) GetBuildID() string {
 	if x != nil {
-		return x.FromImageRegistry
+		return x.BuildID
 	}
-	return nil
+	return ""
 }
 
-func (x *TemplateConfig) GetTeamID() string {
+func (x *TemplateBuildDeleteRequest) GetTemplateID() string {
 	if x != nil {
-		return x.TeamID
+		return x.TemplateID
 	}
 	return ""
 }
 
-type isTemplateConfig_Source interface {
-	isTemplateConfig_Source()
-}
-
-type TemplateConfig_FromImage struct {
-	FromImage string `protobuf:"bytes,11,opt,name=fromImage,proto3,oneof"`
-}
-
-type TemplateConfig_FromTemplate struct {
-	FromTemplate *FromTemplateConfig `protobuf:"bytes,14,opt,name=fromTemplate,proto3,oneof"`
-}
-
-func (*TemplateConfig_FromImage) isTemplateConfig_Source() {}
-
-func (*TemplateConfig_FromTemplate) isTemplateConfig_Source() {}
-
-type TemplateCreateRequest struct {
+type TemplateBuildCancelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Template   *TemplateConfig `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
-	CacheScope *string         `protobuf:"bytes,2,opt,name=cacheScope,proto3,oneof" json:"cacheScope,omitempty"`
+	BuildID    string `protobuf:"bytes,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	TemplateID string `protobuf:"bytes,2,opt,name=templateID,proto3" json:"templateID,omitempty"`
 }
 
-func (x *TemplateCreateRequest) Reset() {
-	*x = TemplateCreateRequest{}
+func (x *TemplateBuildCancelRequest) Reset() {
+	*x = TemplateBuildCancelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[9]
+		mi := &file_template_manager_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TemplateCreateRequest) String() string {
+func (x *TemplateBuildCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TemplateCreateRequest) ProtoMessage() {}
+func (*TemplateBuildCancelRequest) ProtoMessage() {}
 
-func (x *TemplateCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[9]
+func (x *TemplateBuildCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,53 +1578,51 @@ func (x *TemplateCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TemplateCreateRequest.ProtoReflect.Descriptor instead.
-func (*TemplateCreateRequest) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TemplateBuildCancelRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildCancelRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TemplateCreateRequest) GetTemplate() *TemplateConfig {
+func (x *TemplateBuildCancelRequest) GetBuildID() string {
 	if x != nil {
-		return x.Template
+		return x.BuildID
 	}
-	return nil
+	return ""
 }
 
-func (x *TemplateCreateRequest) GetCacheScope() string {
-	if x != nil && x.CacheScope != nil {
-		return *x.CacheScope
+func (x *TemplateBuildCancelRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
 	}
 	return ""
 }
 
-type TemplateStatusRequest struct {
+type TemplateBuildSBOMRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TemplateID string    `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
-	BuildID    string    `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	Offset     *int32    `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	Level      *LogLevel `protobuf:"varint,4,opt,name=level,proto3,enum=LogLevel,oneof" json:"level,omitempty"`
+	BuildID    string `protobuf:"bytes,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	TemplateID string `protobuf:"bytes,2,opt,name=templateID,proto3" json:"templateID,omitempty"`
 }
 
-func (x *TemplateStatusRequest) Reset() {
-	*x = TemplateStatusRequest{}
+func (x *TemplateBuildSBOMRequest) Reset() {
+	*x = TemplateBuildSBOMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[10]
+		mi := &file_template_manager_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TemplateStatusRequest) String() string {
+func (x *TemplateBuildSBOMRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TemplateStatusRequest) ProtoMessage() {}
+func (*TemplateBuildSBOMRequest) ProtoMessage() {}
 
-func (x *TemplateStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[10]
+func (x *TemplateBuildSBOMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,66 +1633,53 @@ func (x *TemplateStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TemplateStatusRequest.ProtoReflect.Descriptor instead.
-func (*TemplateStatusRequest) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TemplateBuildSBOMRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildSBOMRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TemplateStatusRequest) GetTemplateID() string {
+func (x *TemplateBuildSBOMRequest) GetBuildID() string {
 	if x != nil {
-		return x.TemplateID
+		return x.BuildID
 	}
 	return ""
 }
 
-func (x *TemplateStatusRequest) GetBuildID() string {
+func (x *TemplateBuildSBOMRequest) GetTemplateID() string {
 	if x != nil {
-		return x.BuildID
+		return x.TemplateID
 	}
 	return ""
 }
 
-func (x *TemplateStatusRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
-	}
-	return 0
-}
-
-func (x *TemplateStatusRequest) GetLevel() LogLevel {
-	if x != nil && x.Level != nil {
-		return *x.Level
-	}
-	return LogLevel_Debug
-}
-
-// Data required for deleting a template.
-type TemplateBuildDeleteRequest struct {
+type TemplateBuildSBOMResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BuildID    string `protobuf:"bytes,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	TemplateID string `protobuf:"bytes,2,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	// CycloneDX JSON document of the packages installed in the template
+	Sbom []byte `protobuf:"bytes,1,opt,name=sbom,proto3" json:"sbom,omitempty"`
+	// JSON record of the template build source, steps and builder
+	Provenance []byte `protobuf:"bytes,2,opt,name=provenance,proto3" json:"provenance,omitempty"`
 }
 
-func (x *TemplateBuildDeleteRequest) Reset() {
-	*x = TemplateBuildDeleteRequest{}
+func (x *TemplateBuildSBOMResponse) Reset() {
+	*x = TemplateBuildSBOMResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[11]
+		mi := &file_template_manager_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TemplateBuildDeleteRequest) String() string {
+func (x *TemplateBuildSBOMResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TemplateBuildDeleteRequest) ProtoMessage() {}
+func (*TemplateBuildSBOMResponse) ProtoMessage() {}
 
-func (x *TemplateBuildDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[11]
+func (x *TemplateBuildSBOMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,23 +1690,23 @@ func (x *TemplateBuildDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TemplateBuildDeleteRequest.ProtoReflect.Descriptor instead.
-func (*TemplateBuildDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TemplateBuildSBOMResponse.ProtoReflect.Descriptor instead.
+func (*TemplateBuildSBOMResponse) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TemplateBuildDeleteRequest) GetBuildID() string {
+func (x *TemplateBuildSBOMResponse) GetSbom() []byte {
 	if x != nil {
-		return x.BuildID
+		return x.Sbom
 	}
-	return ""
+	return nil
 }
 
-func (x *TemplateBuildDeleteRequest) GetTemplateID() string {
+func (x *TemplateBuildSBOMResponse) GetProvenance() []byte {
 	if x != nil {
-		return x.TemplateID
+		return x.Provenance
 	}
-	return ""
+	return nil
 }
 
 type TemplateBuildMetadata struct {
@@ -1013,12 +1716,14 @@ type TemplateBuildMetadata struct {
 
 	RootfsSizeKey  int32  `protobuf:"varint,1,opt,name=rootfsSizeKey,proto3" json:"rootfsSizeKey,omitempty"`
 	EnvdVersionKey string `protobuf:"bytes,2,opt,name=envdVersionKey,proto3" json:"envdVersionKey,omitempty"`
+	// Commit the git source was resolved to, only for the templates built from a git repository
+	GitCommitSha *string `protobuf:"bytes,3,opt,name=gitCommitSha,proto3,oneof" json:"gitCommitSha,omitempty"`
 }
 
 func (x *TemplateBuildMetadata) Reset() {
 	*x = TemplateBuildMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[12]
+		mi := &file_template_manager_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1031,7 +1736,7 @@ func (x *TemplateBuildMetadata) String() string {
 func (*TemplateBuildMetadata) ProtoMessage() {}
 
 func (x *TemplateBuildMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[12]
+	mi := &file_template_manager_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +1749,7 @@ func (x *TemplateBuildMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildMetadata.ProtoReflect.Descriptor instead.
 func (*TemplateBuildMetadata) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{12}
+	return file_template_manager_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TemplateBuildMetadata) GetRootfsSizeKey() int32 {
@@ -1061,6 +1766,13 @@ func (x *TemplateBuildMetadata) GetEnvdVersionKey() string {
 	return ""
 }
 
+func (x *TemplateBuildMetadata) GetGitCommitSha() string {
+	if x != nil && x.GitCommitSha != nil {
+		return *x.GitCommitSha
+	}
+	return ""
+}
+
 type TemplateBuildLogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1075,7 +1787,7 @@ type TemplateBuildLogEntry struct {
 func (x *TemplateBuildLogEntry) Reset() {
 	*x = TemplateBuildLogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[13]
+		mi := &file_template_manager_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1088,7 +1800,7 @@ func (x *TemplateBuildLogEntry) String() string {
 func (*TemplateBuildLogEntry) ProtoMessage() {}
 
 func (x *TemplateBuildLogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[13]
+	mi := &file_template_manager_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1813,7 @@ func (x *TemplateBuildLogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildLogEntry.ProtoReflect.Descriptor instead.
 func (*TemplateBuildLogEntry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{13}
+	return file_template_manager_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TemplateBuildLogEntry) GetTimestamp() *timestamppb.Timestamp {
@@ -1144,7 +1856,7 @@ type TemplateBuildStatusReason struct {
 func (x *TemplateBuildStatusReason) Reset() {
 	*x = TemplateBuildStatusReason{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[14]
+		mi := &file_template_manager_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1157,7 +1869,7 @@ func (x *TemplateBuildStatusReason) String() string {
 func (*TemplateBuildStatusReason) ProtoMessage() {}
 
 func (x *TemplateBuildStatusReason) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[14]
+	mi := &file_template_manager_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,7 +1882,7 @@ func (x *TemplateBuildStatusReason) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildStatusReason.ProtoReflect.Descriptor instead.
 func (*TemplateBuildStatusReason) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{14}
+	return file_template_manager_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TemplateBuildStatusReason) GetMessage() string {
@@ -1203,7 +1915,7 @@ type TemplateBuildStatusResponse struct {
 func (x *TemplateBuildStatusResponse) Reset() {
 	*x = TemplateBuildStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[15]
+		mi := &file_template_manager_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1216,7 +1928,7 @@ func (x *TemplateBuildStatusResponse) String() string {
 func (*TemplateBuildStatusResponse) ProtoMessage() {}
 
 func (x *TemplateBuildStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[15]
+	mi := &file_template_manager_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1229,7 +1941,7 @@ func (x *TemplateBuildStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildStatusResponse.ProtoReflect.Descriptor instead.
 func (*TemplateBuildStatusResponse) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{15}
+	return file_template_manager_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TemplateBuildStatusResponse) GetStatus() TemplateBuildState {
@@ -1289,187 +2001,308 @@ var file_template_manager_proto_rawDesc = []byte{
 	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
 	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a,
-	0x04, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x8c, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x04, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xed, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
 	0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
 	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x19,
 	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
 	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x66, 0x69, 0x6c,
 	0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x48, 0x61, 0x73, 0x68, 0x22, 0x44, 0x0a, 0x12, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x41,
-	0x57, 0x53, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x77,
-	0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x61, 0x77, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
-	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x77, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x61, 0x77, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x22, 0x3d, 0x0a, 0x0b, 0x47, 0x43, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12,
-	0x2e, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x22,
-	0x49, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x46,
-	0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x12, 0x20, 0x0a, 0x03, 0x61, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x41, 0x57, 0x53, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x03, 0x61,
-	0x77, 0x73, 0x12, 0x20, 0x0a, 0x03, 0x67, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x47, 0x43, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52,
-	0x03, 0x67, 0x63, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x84, 0x05, 0x0a, 0x0e, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a,
-	0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
-	0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x42, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4d,
-	0x42, 0x12, 0x24, 0x0a, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x65, 0x63,
-	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x66, 0x69, 0x72, 0x65, 0x63, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68,
-	0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a,
-	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x05,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70,
-	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1e, 0x0a,
-	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a,
-	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x66, 0x72, 0x6f, 0x6d,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x02, 0x52, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x22, 0x78, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x74, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63,
-	0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x15,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12,
-	0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88,
-	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x56, 0x0a, 0x1a, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12,
-	0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x22,
-	0x65, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74,
-	0x66, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x26,
-	0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x76, 0x64, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x83, 0x02, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x19,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x73, 0x74, 0x65, 0x70, 0x22, 0x94, 0x02, 0x0a, 0x1b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x2a, 0x34, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x57, 0x61, 0x72, 0x6e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x10, 0x03, 0x2a, 0x3d, 0x0a, 0x12, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10,
-	0x02, 0x32, 0xbe, 0x02, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
-	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x54, 0x65,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2e, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x03,
+	0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x22, 0x44, 0x0a, 0x12, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x41, 0x57,
+	0x53, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x77, 0x73,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x77, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x77, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61,
+	0x77, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22,
+	0x3d, 0x0a, 0x0b, 0x47, 0x43, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x2e,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x49,
+	0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x46, 0x72,
+	0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12,
+	0x20, 0x0a, 0x03, 0x61, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x41,
+	0x57, 0x53, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x03, 0x61, 0x77,
+	0x73, 0x12, 0x20, 0x0a, 0x03, 0x67, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x47, 0x43, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x03,
+	0x67, 0x63, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x0d, 0x46, 0x72,
+	0x6f, 0x6d, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x15, 0x0a,
+	0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65,
+	0x66, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
+	0x0e, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0e, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4b, 0x65, 0x79,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x72, 0x65,
+	0x66, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x4b, 0x65, 0x79, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0d, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x78, 0x0a, 0x0e, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x48, 0x54, 0x54, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x54, 0x43, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x50, 0x72, 0x6f,
+	0x62, 0x65, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x22,
+	0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x43, 0x50, 0x48, 0x00, 0x52, 0x03, 0x74,
+	0x63, 0x70, 0x12, 0x1a, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2d,
+	0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
+	0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x07, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9c, 0x07, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x12, 0x1c,
+	0x0a, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x12, 0x24, 0x0a, 0x0d,
+	0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x65, 0x63, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x66, 0x69, 0x72, 0x65, 0x63, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x75, 0x67, 0x65, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x66,
+	0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x48, 0x02, 0x52, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x48, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x47, 0x69, 0x74, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x04, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x47, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x47, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xa9,
+	0x01, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x44, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09,
+	0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x56, 0x0a, 0x1a, 0x54, 0x65,
 	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x50, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x69, 0x6c,
-	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61,
-	0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72,
-	0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65, 0x76,
-	0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x44, 0x22, 0x56, 0x0a, 0x1a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x18, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x42, 0x4f, 0x4d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44,
+	0x22, 0x4f, 0x0a, 0x19, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x53, 0x42, 0x4f, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x62, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x62, 0x6f,
+	0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x6f, 0x6f, 0x74, 0x66, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x76, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0c, 0x67, 0x69, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x88,
+	0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x68, 0x61, 0x22, 0x83, 0x02, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x09, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x19, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x17, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x74,
+	0x65, 0x70, 0x22, 0x94, 0x02, 0x0a, 0x1b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x2a, 0x34, 0x0a, 0x08, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x61,
+	0x72, 0x6e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x2a,
+	0x4c, 0x0a, 0x12, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x32, 0xa3, 0x04,
+	0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x40, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x17, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x4c, 0x6f, 0x67, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x4a, 0x0a,
+	0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x13, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x12, 0x1b, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x42, 0x4f, 0x4d, 0x12, 0x19, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x42, 0x4f, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x42, 0x4f, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65,
+	0x76, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1485,59 +2318,84 @@ func file_template_manager_proto_rawDescGZIP() []byte {
 }
 
 var file_template_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_template_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_template_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_template_manager_proto_goTypes = []interface{}{
 	(LogLevel)(0),                       // 0: LogLevel
 	(TemplateBuildState)(0),             // 1: TemplateBuildState
 	(*InitLayerFileUploadRequest)(nil),  // 2: InitLayerFileUploadRequest
 	(*InitLayerFileUploadResponse)(nil), // 3: InitLayerFileUploadResponse
 	(*TemplateStep)(nil),                // 4: TemplateStep
-	(*FromTemplateConfig)(nil),          // 5: FromTemplateConfig
-	(*AWSRegistry)(nil),                 // 6: AWSRegistry
-	(*GCPRegistry)(nil),                 // 7: GCPRegistry
-	(*GeneralRegistry)(nil),             // 8: GeneralRegistry
-	(*FromImageRegistry)(nil),           // 9: FromImageRegistry
-	(*TemplateConfig)(nil),              // 10: TemplateConfig
-	(*TemplateCreateRequest)(nil),       // 11: TemplateCreateRequest
-	(*TemplateStatusRequest)(nil),       // 12: TemplateStatusRequest
-	(*TemplateBuildDeleteRequest)(nil),  // 13: TemplateBuildDeleteRequest
-	(*TemplateBuildMetadata)(nil),       // 14: TemplateBuildMetadata
-	(*TemplateBuildLogEntry)(nil),       // 15: TemplateBuildLogEntry
-	(*TemplateBuildStatusReason)(nil),   // 16: TemplateBuildStatusReason
-	(*TemplateBuildStatusResponse)(nil), // 17: TemplateBuildStatusResponse
-	nil,                                 // 18: TemplateBuildLogEntry.FieldsEntry
-	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 20: google.protobuf.Empty
+	(*TemplateSecretMount)(nil),         // 5: TemplateSecretMount
+	(*FromTemplateConfig)(nil),          // 6: FromTemplateConfig
+	(*AWSRegistry)(nil),                 // 7: AWSRegistry
+	(*GCPRegistry)(nil),                 // 8: GCPRegistry
+	(*GeneralRegistry)(nil),             // 9: GeneralRegistry
+	(*FromImageRegistry)(nil),           // 10: FromImageRegistry
+	(*FromGitConfig)(nil),               // 11: FromGitConfig
+	(*TemplateStage)(nil),               // 12: TemplateStage
+	(*ReadyProbeHTTP)(nil),              // 13: ReadyProbeHTTP
+	(*ReadyProbeTCP)(nil),               // 14: ReadyProbeTCP
+	(*ReadyProbe)(nil),                  // 15: ReadyProbe
+	(*TemplateConfig)(nil),              // 16: TemplateConfig
+	(*TemplateCreateRequest)(nil),       // 17: TemplateCreateRequest
+	(*TemplateStatusRequest)(nil),       // 18: TemplateStatusRequest
+	(*TemplateBuildDeleteRequest)(nil),  // 19: TemplateBuildDeleteRequest
+	(*TemplateBuildCancelRequest)(nil),  // 20: TemplateBuildCancelRequest
+	(*TemplateBuildSBOMRequest)(nil),    // 21: TemplateBuildSBOMRequest
+	(*TemplateBuildSBOMResponse)(nil),   // 22: TemplateBuildSBOMResponse
+	(*TemplateBuildMetadata)(nil),       // 23: TemplateBuildMetadata
+	(*TemplateBuildLogEntry)(nil),       // 24: TemplateBuildLogEntry
+	(*TemplateBuildStatusReason)(nil),   // 25: TemplateBuildStatusReason
+	(*TemplateBuildStatusResponse)(nil), // 26: TemplateBuildStatusResponse
+	nil,                                 // 27: TemplateConfig.SecretsEntry
+	nil,                                 // 28: TemplateBuildLogEntry.FieldsEntry
+	(*timestamppb.Timestamp)(nil),       // 29: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 30: google.protobuf.Empty
 }
 var file_template_manager_proto_depIdxs = []int32{
-	6,  // 0: FromImageRegistry.aws:type_name -> AWSRegistry
-	7,  // 1: FromImageRegistry.gcp:type_name -> GCPRegistry
-	8,  // 2: FromImageRegistry.general:type_name -> GeneralRegistry
-	4,  // 3: TemplateConfig.steps:type_name -> TemplateStep
-	5,  // 4: TemplateConfig.fromTemplate:type_name -> FromTemplateConfig
-	9,  // 5: TemplateConfig.fromImageRegistry:type_name -> FromImageRegistry
-	10, // 6: TemplateCreateRequest.template:type_name -> TemplateConfig
-	0,  // 7: TemplateStatusRequest.level:type_name -> LogLevel
-	19, // 8: TemplateBuildLogEntry.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 9: TemplateBuildLogEntry.level:type_name -> LogLevel
-	18, // 10: TemplateBuildLogEntry.fields:type_name -> TemplateBuildLogEntry.FieldsEntry
-	1,  // 11: TemplateBuildStatusResponse.status:type_name -> TemplateBuildState
-	14, // 12: TemplateBuildStatusResponse.metadata:type_name -> TemplateBuildMetadata
-	15, // 13: TemplateBuildStatusResponse.logEntries:type_name -> TemplateBuildLogEntry
-	16, // 14: TemplateBuildStatusResponse.reason:type_name -> TemplateBuildStatusReason
-	11, // 15: TemplateService.TemplateCreate:input_type -> TemplateCreateRequest
-	12, // 16: TemplateService.TemplateBuildStatus:input_type -> TemplateStatusRequest
-	13, // 17: TemplateService.TemplateBuildDelete:input_type -> TemplateBuildDeleteRequest
-	2,  // 18: TemplateService.InitLayerFileUpload:input_type -> InitLayerFileUploadRequest
-	20, // 19: TemplateService.TemplateCreate:output_type -> google.protobuf.Empty
-	17, // 20: TemplateService.TemplateBuildStatus:output_type -> TemplateBuildStatusResponse
-	20, // 21: TemplateService.TemplateBuildDelete:output_type -> google.protobuf.Empty
-	3,  // 22: TemplateService.InitLayerFileUpload:output_type -> InitLayerFileUploadResponse
-	19, // [19:23] is the sub-list for method output_type
-	15, // [15:19] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	5,  // 0: TemplateStep.secrets:type_name -> TemplateSecretMount
+	7,  // 1: FromImageRegistry.aws:type_name -> AWSRegistry
+	8,  // 2: FromImageRegistry.gcp:type_name -> GCPRegistry
+	9,  // 3: FromImageRegistry.general:type_name -> GeneralRegistry
+	4,  // 4: TemplateStage.steps:type_name -> TemplateStep
+	6,  // 5: TemplateStage.fromTemplate:type_name -> FromTemplateConfig
+	13, // 6: ReadyProbe.http:type_name -> ReadyProbeHTTP
+	14, // 7: ReadyProbe.tcp:type_name -> ReadyProbeTCP
+	4,  // 8: TemplateConfig.steps:type_name -> TemplateStep
+	6,  // 9: TemplateConfig.fromTemplate:type_name -> FromTemplateConfig
+	10, // 10: TemplateConfig.fromImageRegistry:type_name -> FromImageRegistry
+	12, // 11: TemplateConfig.stages:type_name -> TemplateStage
+	27, // 12: TemplateConfig.secrets:type_name -> TemplateConfig.SecretsEntry
+	15, // 13: TemplateConfig.readyProbe:type_name -> ReadyProbe
+	11, // 14: TemplateConfig.fromGit:type_name -> FromGitConfig
+	16, // 15: TemplateCreateRequest.template:type_name -> TemplateConfig
+	0,  // 16: TemplateStatusRequest.level:type_name -> LogLevel
+	29, // 17: TemplateBuildLogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 18: TemplateBuildLogEntry.level:type_name -> LogLevel
+	28, // 19: TemplateBuildLogEntry.fields:type_name -> TemplateBuildLogEntry.FieldsEntry
+	1,  // 20: TemplateBuildStatusResponse.status:type_name -> TemplateBuildState
+	23, // 21: TemplateBuildStatusResponse.metadata:type_name -> TemplateBuildMetadata
+	24, // 22: TemplateBuildStatusResponse.logEntries:type_name -> TemplateBuildLogEntry
+	25, // 23: TemplateBuildStatusResponse.reason:type_name -> TemplateBuildStatusReason
+	17, // 24: TemplateService.TemplateCreate:input_type -> TemplateCreateRequest
+	18, // 25: TemplateService.TemplateBuildStatus:input_type -> TemplateStatusRequest
+	18, // 26: TemplateService.TemplateBuildLogsStream:input_type -> TemplateStatusRequest
+	19, // 27: TemplateService.TemplateBuildDelete:input_type -> TemplateBuildDeleteRequest
+	20, // 28: TemplateService.TemplateBuildCancel:input_type -> TemplateBuildCancelRequest
+	21, // 29: TemplateService.TemplateBuildSBOM:input_type -> TemplateBuildSBOMRequest
+	2,  // 30: TemplateService.InitLayerFileUpload:input_type -> InitLayerFileUploadRequest
+	30, // 31: TemplateService.TemplateCreate:output_type -> google.protobuf.Empty
+	26, // 32: TemplateService.TemplateBuildStatus:output_type -> TemplateBuildStatusResponse
+	24, // 33: TemplateService.TemplateBuildLogsStream:output_type -> TemplateBuildLogEntry
+	30, // 34: TemplateService.TemplateBuildDelete:output_type -> google.protobuf.Empty
+	30, // 35: TemplateService.TemplateBuildCancel:output_type -> google.protobuf.Empty
+	22, // 36: TemplateService.TemplateBuildSBOM:output_type -> TemplateBuildSBOMResponse
+	3,  // 37: TemplateService.InitLayerFileUpload:output_type -> InitLayerFileUploadResponse
+	31, // [31:38] is the sub-list for method output_type
+	24, // [24:31] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_template_manager_proto_init() }
@@ -1583,7 +2441,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FromTemplateConfig); i {
+			switch v := v.(*TemplateSecretMount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,7 +2453,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AWSRegistry); i {
+			switch v := v.(*FromTemplateConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,7 +2465,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCPRegistry); i {
+			switch v := v.(*AWSRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,7 +2477,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeneralRegistry); i {
+			switch v := v.(*GCPRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1631,7 +2489,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FromImageRegistry); i {
+			switch v := v.(*GeneralRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1643,7 +2501,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateConfig); i {
+			switch v := v.(*FromImageRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1655,7 +2513,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateCreateRequest); i {
+			switch v := v.(*FromGitConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1667,7 +2525,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateStatusRequest); i {
+			switch v := v.(*TemplateStage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1679,7 +2537,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildDeleteRequest); i {
+			switch v := v.(*ReadyProbeHTTP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,7 +2549,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildMetadata); i {
+			switch v := v.(*ReadyProbeTCP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,7 +2561,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildLogEntry); i {
+			switch v := v.(*ReadyProbe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1715,7 +2573,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildStatusReason); i {
+			switch v := v.(*TemplateConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1727,6 +2585,114 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildCancelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildSBOMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildSBOMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildStatusReason); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TemplateBuildStatusResponse); i {
 			case 0:
 				return &v.state
@@ -1742,26 +2708,39 @@ func file_template_manager_proto_init() {
 	file_template_manager_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_template_manager_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_template_manager_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_template_manager_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_template_manager_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*FromImageRegistry_Aws)(nil),
 		(*FromImageRegistry_Gcp)(nil),
 		(*FromImageRegistry_General)(nil),
 	}
-	file_template_manager_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_template_manager_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*TemplateStage_FromImage)(nil),
+		(*TemplateStage_FromTemplate)(nil),
+	}
+	file_template_manager_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*ReadyProbe_Http)(nil),
+		(*ReadyProbe_Tcp)(nil),
+		(*ReadyProbe_Command)(nil),
+	}
+	file_template_manager_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*TemplateConfig_FromImage)(nil),
 		(*TemplateConfig_FromTemplate)(nil),
 	}
-	file_template_manager_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_template_manager_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_template_manager_proto_msgTypes[14].OneofWrappers = []interface{}{}
 	file_template_manager_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[24].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_template_manager_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   17,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
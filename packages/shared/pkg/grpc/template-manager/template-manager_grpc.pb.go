@@ -27,8 +27,14 @@ type TemplateServiceClient interface {
 	TemplateCreate(ctx context.Context, in *TemplateCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// TemplateStatus is a gRPC service that streams the status of a template build
 	TemplateBuildStatus(ctx context.Context, in *TemplateStatusRequest, opts ...grpc.CallOption) (*TemplateBuildStatusResponse, error)
+	// TemplateBuildLogsStream is a gRPC service that streams the logs of a template build as they are produced, the stream ends when the build is finished
+	TemplateBuildLogsStream(ctx context.Context, in *TemplateStatusRequest, opts ...grpc.CallOption) (TemplateService_TemplateBuildLogsStreamClient, error)
 	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(ctx context.Context, in *TemplateBuildDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TemplateBuildCancel is a gRPC service that aborts a running template build and tears down its build sandbox
+	TemplateBuildCancel(ctx context.Context, in *TemplateBuildCancelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TemplateBuildSBOM is a gRPC service that returns the SBOM and the provenance record of a finished template build
+	TemplateBuildSBOM(ctx context.Context, in *TemplateBuildSBOMRequest, opts ...grpc.CallOption) (*TemplateBuildSBOMResponse, error)
 	// InitLayerFileUpload requests an upload URL for a tar file containing layer files to be cached for the template build.
 	InitLayerFileUpload(ctx context.Context, in *InitLayerFileUploadRequest, opts ...grpc.CallOption) (*InitLayerFileUploadResponse, error)
 }
@@ -59,6 +65,38 @@ func (c *templateServiceClient) TemplateBuildStatus(ctx context.Context, in *Tem
 	return out, nil
 }
 
+func (c *templateServiceClient) TemplateBuildLogsStream(ctx context.Context, in *TemplateStatusRequest, opts ...grpc.CallOption) (TemplateService_TemplateBuildLogsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TemplateService_ServiceDesc.Streams[0], "/TemplateService/TemplateBuildLogsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &templateServiceTemplateBuildLogsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TemplateService_TemplateBuildLogsStreamClient interface {
+	Recv() (*TemplateBuildLogEntry, error)
+	grpc.ClientStream
+}
+
+type templateServiceTemplateBuildLogsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *templateServiceTemplateBuildLogsStreamClient) Recv() (*TemplateBuildLogEntry, error) {
+	m := new(TemplateBuildLogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *templateServiceClient) TemplateBuildDelete(ctx context.Context, in *TemplateBuildDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildDelete", in, out, opts...)
@@ -68,6 +106,24 @@ func (c *templateServiceClient) TemplateBuildDelete(ctx context.Context, in *Tem
 	return out, nil
 }
 
+func (c *templateServiceClient) TemplateBuildCancel(ctx context.Context, in *TemplateBuildCancelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) TemplateBuildSBOM(ctx context.Context, in *TemplateBuildSBOMRequest, opts ...grpc.CallOption) (*TemplateBuildSBOMResponse, error) {
+	out := new(TemplateBuildSBOMResponse)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildSBOM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *templateServiceClient) InitLayerFileUpload(ctx context.Context, in *InitLayerFileUploadRequest, opts ...grpc.CallOption) (*InitLayerFileUploadResponse, error) {
 	out := new(InitLayerFileUploadResponse)
 	err := c.cc.Invoke(ctx, "/TemplateService/InitLayerFileUpload", in, out, opts...)
@@ -85,8 +141,14 @@ type TemplateServiceServer interface {
 	TemplateCreate(context.Context, *TemplateCreateRequest) (*emptypb.Empty, error)
 	// TemplateStatus is a gRPC service that streams the status of a template build
 	TemplateBuildStatus(context.Context, *TemplateStatusRequest) (*TemplateBuildStatusResponse, error)
+	// TemplateBuildLogsStream is a gRPC service that streams the logs of a template build as they are produced, the stream ends when the build is finished
+	TemplateBuildLogsStream(*TemplateStatusRequest, TemplateService_TemplateBuildLogsStreamServer) error
 	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error)
+	// TemplateBuildCancel is a gRPC service that aborts a running template build and tears down its build sandbox
+	TemplateBuildCancel(context.Context, *TemplateBuildCancelRequest) (*emptypb.Empty, error)
+	// TemplateBuildSBOM is a gRPC service that returns the SBOM and the provenance record of a finished template build
+	TemplateBuildSBOM(context.Context, *TemplateBuildSBOMRequest) (*TemplateBuildSBOMResponse, error)
 	// InitLayerFileUpload requests an upload URL for a tar file containing layer files to be cached for the template build.
 	InitLayerFileUpload(context.Context, *InitLayerFileUploadRequest) (*InitLayerFileUploadResponse, error)
 	mustEmbedUnimplementedTemplateServiceServer()
@@ -102,9 +164,18 @@ func (UnimplementedTemplateServiceServer) TemplateCreate(context.Context, *Templ
 func (UnimplementedTemplateServiceServer) TemplateBuildStatus(context.Context, *TemplateStatusRequest) (*TemplateBuildStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildStatus not implemented")
 }
+func (UnimplementedTemplateServiceServer) TemplateBuildLogsStream(*TemplateStatusRequest, TemplateService_TemplateBuildLogsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method TemplateBuildLogsStream not implemented")
+}
 func (UnimplementedTemplateServiceServer) TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildDelete not implemented")
 }
+func (UnimplementedTemplateServiceServer) TemplateBuildCancel(context.Context, *TemplateBuildCancelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildCancel not implemented")
+}
+func (UnimplementedTemplateServiceServer) TemplateBuildSBOM(context.Context, *TemplateBuildSBOMRequest) (*TemplateBuildSBOMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildSBOM not implemented")
+}
 func (UnimplementedTemplateServiceServer) InitLayerFileUpload(context.Context, *InitLayerFileUploadRequest) (*InitLayerFileUploadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitLayerFileUpload not implemented")
 }
@@ -157,6 +228,27 @@ func _TemplateService_TemplateBuildStatus_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TemplateService_TemplateBuildLogsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TemplateStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TemplateServiceServer).TemplateBuildLogsStream(m, &templateServiceTemplateBuildLogsStreamServer{stream})
+}
+
+type TemplateService_TemplateBuildLogsStreamServer interface {
+	Send(*TemplateBuildLogEntry) error
+	grpc.ServerStream
+}
+
+type templateServiceTemplateBuildLogsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *templateServiceTemplateBuildLogsStreamServer) Send(m *TemplateBuildLogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _TemplateService_TemplateBuildDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TemplateBuildDeleteRequest)
 	if err := dec(in); err != nil {
@@ -175,6 +267,42 @@ func _TemplateService_TemplateBuildDelete_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TemplateService_TemplateBuildCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildCancel(ctx, req.(*TemplateBuildCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_TemplateBuildSBOM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildSBOMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildSBOM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildSBOM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildSBOM(ctx, req.(*TemplateBuildSBOMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TemplateService_InitLayerFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InitLayerFileUploadRequest)
 	if err := dec(in); err != nil {
@@ -212,11 +340,25 @@ var TemplateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateBuildDelete",
 			Handler:    _TemplateService_TemplateBuildDelete_Handler,
 		},
+		{
+			MethodName: "TemplateBuildCancel",
+			Handler:    _TemplateService_TemplateBuildCancel_Handler,
+		},
+		{
+			MethodName: "TemplateBuildSBOM",
+			Handler:    _TemplateService_TemplateBuildSBOM_Handler,
+		},
 		{
 			MethodName: "InitLayerFileUpload",
 			Handler:    _TemplateService_InitLayerFileUpload_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TemplateBuildLogsStream",
+			Handler:       _TemplateService_TemplateBuildLogsStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "template-manager.proto",
 }
@@ -1770,6 +1770,9 @@ func ParseV1TemplateBuildLogsResponse(rsp *http.Response) (*V1TemplateBuildLogsR
 		}
 		response.JSON500 = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (text/event-stream) unsupported
+
 	}
 
 	return response, nil
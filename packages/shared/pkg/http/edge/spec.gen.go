@@ -18,40 +18,41 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaW2/bOBb+KwR3H9Xa6WT3wW9pUswETadFExQLBH6gxWOLU4rUkFQaI/B/X/CiO2Ur",
-	"idPpDPoUV6IOz/nO7TtkH3Aq80IKEEbjxQNWoAspNLh/nM7n9k8qhQFh7E9SFJylxDApZn9oKewznWaQ",
-	"E/vr3wrWeIH/NWtkzvxbPXunlFR4t9slmIJOFSusELzAbwlFCv4sQRu8S/Dp/OTl9zwrTQbCBKkI/Dq7",
-	"+enLb/67NGgtS0Htjv/5HhBfg7oDVZm5S4I85+O3JeP0Sm7eCaO29kGhZAHKMB8Cawacul+EUmblEf6p",
-	"s6KHbL0K+U/RWipkMkBcbhC4TRJstgXgBdZGMbGxMIQHcvUHpC4MONwBP2TwldxcuXW7BOegNdnAUKMr",
-	"uUHhJapgjmnActCG5MVQwk31Csn1wJS1VDkxeIEpMfDKShlK3yXYRjhTQPHitrVVo3ZlcVIhvtwl+JyX",
-	"2oD6XVIYekZICpcXQ23tanR5EbNRg7pjKfwmtRn5rlDsjhhAmdQGEUoVaI2IoCh8igqpzB7Rl0IbItKo",
-	"YtdBBAtr9it5bYgyQM9M3B/oWwbCOcPigL4RjRRsmIUL6ES3tPcypT4UbS1vhA8aCTdO8uTv3fLm6y+g",
-	"NPOZ3rU0vKjiLizfY0n44FzmOYsgdy1Lldo0oIDuJsruBW8Iu5jHB/aMqBXxcRfIvmO6kdvLjEuxlsfM",
-	"jikhfDhyy2JS3GqPwPcP2O8Wcl9+kDAri0FY9QKpAbXndGMrYtA/9eud93CCQZS5VTcDwk1m+wFVhAlr",
-	"UYJLUT1eRuDrl4PBvuetrZDxeVHtJ1WagTaKGKnsY7qBfZt8bC0/WjdRkvtvmYF8ajC2NfksObSaP1GK",
-	"bH+2qb8k6yujm5R6aoc5JOj7thMXQ8N24kN3GU8QF5bDbCQFWTHOzLYqBf0cjKemgbzg1rkrS7RBRdPU",
-	"M/dBUqYhVbuauMWuh7cDiAnzy5sGbiYMbEDtZcZ+10MeChtVUixoNe/eXys1EXQl71vYUFiVtjIy27MT",
-	"/I0o61U/l8RwufYSzhUQA+fEEC43n8OsOEAL7iEtrR4+qwfC2o6JJX47BmzeKmhbgZhGBSepS9thcvhF",
-	"IzuHtx/I/RWIjcmGe38g9ywvc0RL5UdSJlAmS6V7Lv7vadTFYQOXALbsHKoR9UQz8HYPpKSDatvOiFUR",
-	"PZaR0S449QI4PN+p+4DvmTZmyR4dr+QmPlC66Q99YyZD9TTnGhBnwmZL1wj3MCrHvvkBp1Kn8LKDw5PP",
-	"B37O+jWqYcJvZv7WrN8grT+HM7gh2tz7IXrycm1UmZpSAbU662ERnkTT+g6P0DMr3W+/JiU3eHG7HBw4",
-	"hZrplj565+GmPVCD2BYaDkHf5C+YTuUdqG3DgEary9PoIDIZMUhnsuQUrQBlRFAOFK22DvHqMHOcQz3p",
-	"tKCHQXRebhkSq2o3gYtUZ36PiLRRVztZPuJ0HYFTfd49fHxarDUKtHcdqzqPCaibdpmYmPsa0lIxs722",
-	"Fnrwzgr2HrZnpe/7zOqcAbFUMMGC2GaN//fq7NPlq/fQOhsl7it/msvCEYdhxlJT/O7NW/SObgCdfbrE",
-	"Cb6rWD2evz55PXdcpwBBCoYX+JfX89dz25GIyZw6Mz+U2p8biDD439xrlGaQfsVOkqckl7R+eR7edS4L",
-	"3viT7K6wkHlu8NFlmoLW65Jbq3ZJpcksJ2kWOuRBjdxxcvgA6YrPjyr5IYg+mq5GkfWapdN1DR+gQsn7",
-	"7T5Vb4Lk56t6dzKrIiaqowsdXdc1H9l+wOpq9+Xk0hP1mEZHubLon+PFLi9q+/gWKTClEkD9ON3Wvbk6",
-	"iu1XGzCzi5p7l/1r7aIG09BJQc9ST1o9spbF2l996KrZxa/1bBf7sgPavJV0ezQU9zHqXbfWGVXCbkqI",
-	"dWD3VtLOPBRACOTIwT+fAv/8BV3V1F+8uO1W3tvlbplgXeY5sTwWe7AQGbEnwYbY1nOLa7e7nlCEQ6n9",
-	"3vYD68t6OzoUH8XbqZP8j/K2B+uR3h7k/UM9NO5mFTMJFXYkHq48sSiIIjkYUNpp6jiAbcgNA2gP1l3/",
-	"Ja2wGPCNIOvPEpwVQZgBkj9e0uAkRxkmNq35tpmIdJ8C13WZCZQzzpmGVArqKFlEQXftgdv6DA45ciZY",
-	"XuZ4MR8eeAy1rc5PRJmvQFlN92k5ohVn4Tix1qqmnSfz+Txy2LZXyeUL9s3YtDi5d7bz2oXx3y6dr5g2",
-	"rXGja83+ZPa05xWtpsSZZRJ6lCv9CqZ9b4Xq7w4Qp9gwqp/LpOrphnD+ce1Qmkiu8G45nIEmhgvhvHPt",
-	"pf/KGKhd2/fjQRfP3M2cm3SjF0sfiPra3B9xkoJGUniiSTRq3etN8fWF2+yFCMDBg46j0ABXpG0pdRDU",
-	"9r9gsQj//erQ2tMfKKi+Ms7HY+o947y+/ZsYOfabf1LgOIR+Bk07aNqXLHtJZM9fv4L52Pn0WP3kkRf3",
-	"vqM8p590EfghOEXV6WUP4Ql+ru549cxd8urZg/s7YUwYnMhOGhaC9GOMCoP7viMMH96k5w4gl4LCfX2L",
-	"UU0jq+q0d3QC8ZdyGaBKkRG2L9drDSN0/9FcfwSL6sZnWnlurrhedHYYvwV41ARRwdu45G84SNxEjGhS",
-	"vs5rm+q7+vlDPcYOSoINhO5Eb+nycvf/AAAA//+NCgQ0Zy8AAA==",
+	"H4sIAAAAAAAC/+xa3W/bOBL/VwjePaq1083dg9/SpNg1mm6LJigOCPxAi2OLW4rUkpQbI9D/fuCHviVb",
+	"SZxud9GnOiI5nC/OzG+mDziWaSYFCKPx4gEr0JkUGtwf5/O5/SeWwoAw9ifJMs5iYpgUsz+0FPabjhNI",
+	"if31bwUbvMD/mtU0Z35Vz94pJRUuiiLCFHSsWGaJ4AV+SyhS8GcO2uAiwufzs5e/8yI3CQgTqCLw++zl",
+	"5y9/+e/SoI3MBbU3/ud7qPgG1A5UKWYRBXrOxm9zxum13L4TRu3th0zJDJRh3gU2DDh1vwilzNIj/FNr",
+	"R0ez1S7kj6KNVMgkgLjcInCXRNjsM8ALrI1iYouL6oNc/wGxcwMOO+DHBL6W22u3r4hwClqTLfQ5upZb",
+	"FBZRqeYhDlgK2pA061O4LZeQ3PRE2UiVEoMXmBIDryyVPvUiwtbDmQKKF3eNq2q2S4mjUuOrIsKXPNcG",
+	"1O+SQt8yQlJYXvW5tbvR8mpIRg1qx2L4TWozci5TbEcMoERqgwilCrRGRFAUjqJMKnOA9FJoQ0Q8yNhN",
+	"IMHCnsNM3hiiDNALM2wP9C0B4Yxh9YC+EY0UbJlVF9CJZmneZXJ9zNsa1ggHagq3jvLk8257ffoLKM2k",
+	"6EsaFkq/C9sPSBIOXMo0ZQOau5G5iu0zoIB2E2l3nDe43ZDFe/KMsDVg47Yiu4Zpe27nZSzFRp7ydUxx",
+	"4eOem2eT/FZ7DXx/h/1uLvflB3GzPOu5VceRaqV2jG5sRAz8x36/sx6OMIg8tewmQLhJbD6gijBhJYpw",
+	"LsrPqwH1dcNB797LxlXI7LPmfVLFCWijiJHKfqZbOHTJx8b2k2UTJbk/ywykU52xyclnyaGR/IlSZP8z",
+	"Tf0lr74Uun5ST80wxwh933TifKifTrzrroYfiHPL/mskGVkzzsy+DAXdNzj8NA2kGbfGXdtCG9TgM/WV",
+	"e+9RxpIOcOI2uxzedCAmzC9vanUzYWAL6mBl7G89ZqFwUUnFKq2quw/HSk0EXcv7hm4orHMbGZnN2RH+",
+	"RpS1qsclQ3q58RQuFRADl8QQLrefA1bsaQvuIc4tH/5V94g1DTP08Js+YN+tgqYUiGmUcRK7Z9t/HH7T",
+	"yM1h9QO5vwaxNUn/7g/knqV5imiuPCRlAiUyV7pj4v+eD5o4XOAegA07x2JEhWh61u4oKWpptSnngFQD",
+	"fKwGoF0w6hVweL5RDym+I9qYJAd4vJbbYUDp0B/6xkyCKjTnEhBnAnDUEcJ9HKRjV35AVOoYXrX08OT+",
+	"wE+sX2k1IPwa8zewfq1p/Tn04Pra5t4Og52XG6Py2OQKqOVZ94PwpDKta/CB8sxS99dvSM4NXtyteg2n",
+	"EDPd1kff3L+0o9RAtqENp0Gf5K+YjuUO1L6ugEajy9PKQWQSYpBOZM4pWgNKiKAcKFrvncbLZuZ4DfWk",
+	"bkFHB4N4uSHIUFS7DbVI2fN7hKeNmtrR8h6nKw+cavN28/FpvlYz0Lx1LOo8xqFum2Fi4tvXEOeKmf2N",
+	"ldAr7yJj72FvW872L2Z5ToBQUDjCgthkjf/36uLT8tV7aPRGiTvlu7kstDgMM9yuvXvzFr2jW0AXn5Y4",
+	"wruyqsfz12ev51ZamYEgGcML/Mvr+es5jnBGTOLYmXlQan9uYaCC/80toziB+Ct2lHxJsqTV4mVYaw0L",
+	"3sznfWLh5Tngo/M4Bq03ObdSFVHJySwlcRIy5FGOXDs5HEC6rOdHmfwQSJ+MV6PIZsPi6byGAyhT8n5/",
+	"iNXbQPn5rO7OZqXHDPLoXEdXcc17tgdYbe6+nC19oT7E0UlGFt0+3tDwopKP75ECkysB1MPpJu/16Gjo",
+	"vkqAmd1Uz10O77Wbap2GTAp6Fvui1WuWg3Gu21VdiV38Xl/tYh92QJu3ku5PpsVDFXXRjnVG5VBMcbGW",
+	"2r2UtIWHghJCceTUP5+i/vkLmqqOv3hx1468d6tiFWGdpymxdSz2ykJkRJ4IG2JTzx2uzO5yQhaaUoet",
+	"7QHry1p7EBSfxNqxo/yPsrZX1iOt3Xv3DxVoLGZlZbKFQ/5w7QuLjCiSggGlHaeuBrAJua4AmsC6bb+o",
+	"4Ra9eiPQ+jMHta+JGSDp4yn1OjnKMLFt4NsaEeluCVzFZSZQyjhnGmIpqCvJBhh0Yw/c5KfX5EiZsB0R",
+	"vJj3Gx5FNNY/EXm6BmU5PcTlCFecpazNVVV2ns3n84Fm20EmVy+YN4fQ4uTc2XzXzo3/ds/5mmnTgBtt",
+	"aQ4/Zl/2vKIlSpwJSUGP1kq/gmnOrVB17kjhNARG9XMrqQrdEM4/bpyWJhZXuFj1MdBEdyGct8Ze+q/0",
+	"gcq0XTseNfHMTeYc0h0cLH0g6ms9P7KdXo2k8IUm0agx15ti6yt32QsVAEcbHScpA1yQtqHUqaCS/wWD",
+	"RfjvV8f2nv9ATvWVcT7uU+8Z59X0b6Ln2DP/JMdxGvrpNE2naQ5ZDhaRHXv9CuZj6+ip8skjB/c+ozwn",
+	"n7Q18EPUFGWmlx0NT7BzOePVMzfk1bMH9+8EmNDryE4CC4H6KaBCb953AvDhRXouAFkKCvfVFKNEI+uy",
+	"2zuKQPxQLgFUMjJS7cvNRsNIuf/oWn9EF+XEZ1p4rkdcL4odxqcA1p8N3JsZ7ECYV9ooIOl0wp1u/mPg",
+	"SGmr2r46KgcpGpE4hsyD0S53SEEMbAc1MiUW9sEeEQW26UrzGCjKhWE+E/sLmEYbJphOgP790M9tX1mN",
+	"OFUFIxufiur7Q4W9e3GsiOrFCjEVq+L/AwCem3coHDAAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -27,6 +27,8 @@ type EnvBuild struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// FinishedAt holds the value of the "finished_at" field.
 	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// QueuedAt holds the value of the "queued_at" field.
+	QueuedAt *time.Time `json:"queued_at,omitempty"`
 	// EnvID holds the value of the "env_id" field.
 	EnvID string `json:"env_id,omitempty"`
 	// Status holds the value of the "status" field.
@@ -94,7 +96,7 @@ func (*EnvBuild) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case envbuild.FieldEnvID, envbuild.FieldStatus, envbuild.FieldDockerfile, envbuild.FieldStartCmd, envbuild.FieldReadyCmd, envbuild.FieldKernelVersion, envbuild.FieldFirecrackerVersion, envbuild.FieldEnvdVersion, envbuild.FieldClusterNodeID:
 			values[i] = new(sql.NullString)
-		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt:
+		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt, envbuild.FieldQueuedAt:
 			values[i] = new(sql.NullTime)
 		case envbuild.FieldID:
 			values[i] = new(uuid.UUID)
@@ -138,6 +140,13 @@ func (eb *EnvBuild) assignValues(columns []string, values []any) error {
 				eb.FinishedAt = new(time.Time)
 				*eb.FinishedAt = value.Time
 			}
+		case envbuild.FieldQueuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field queued_at", values[i])
+			} else if value.Valid {
+				eb.QueuedAt = new(time.Time)
+				*eb.QueuedAt = value.Time
+			}
 		case envbuild.FieldEnvID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field env_id", values[i])
@@ -281,6 +290,11 @@ func (eb *EnvBuild) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := eb.QueuedAt; v != nil {
+		builder.WriteString("queued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("env_id=")
 	builder.WriteString(eb.EnvID)
 	builder.WriteString(", ")
@@ -22,6 +22,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldFinishedAt holds the string denoting the finished_at field in the database.
 	FieldFinishedAt = "finished_at"
+	// FieldQueuedAt holds the string denoting the queued_at field in the database.
+	FieldQueuedAt = "queued_at"
 	// FieldEnvID holds the string denoting the env_id field in the database.
 	FieldEnvID = "env_id"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -69,6 +71,7 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldFinishedAt,
+	FieldQueuedAt,
 	FieldEnvID,
 	FieldStatus,
 	FieldDockerfile,
@@ -115,9 +118,11 @@ const DefaultStatus = StatusWaiting
 // Status values.
 const (
 	StatusWaiting      Status = "waiting"
+	StatusQueued       Status = "queued"
 	StatusBuilding     Status = "building"
 	StatusSnapshotting Status = "snapshotting"
 	StatusFailed       Status = "failed"
+	StatusCancelled    Status = "cancelled"
 	StatusSuccess      Status = "success"
 	StatusUploaded     Status = "uploaded"
 )
@@ -129,7 +134,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusWaiting, StatusBuilding, StatusSnapshotting, StatusFailed, StatusSuccess, StatusUploaded:
+	case StatusWaiting, StatusQueued, StatusBuilding, StatusSnapshotting, StatusFailed, StatusCancelled, StatusSuccess, StatusUploaded:
 		return nil
 	default:
 		return fmt.Errorf("envbuild: invalid enum value for status field: %q", s)
@@ -159,6 +164,11 @@ func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
 }
 
+// ByQueuedAt orders the results by the queued_at field.
+func ByQueuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueuedAt, opts...).ToFunc()
+}
+
 // ByEnvID orders the results by the env_id field.
 func ByEnvID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnvID, opts...).ToFunc()
@@ -72,6 +72,11 @@ func FinishedAt(v time.Time) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldFinishedAt, v))
 }
 
+// QueuedAt applies equality check predicate on the "queued_at" field. It's identical to QueuedAtEQ.
+func QueuedAt(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldQueuedAt, v))
+}
+
 // EnvID applies equality check predicate on the "env_id" field. It's identical to EnvIDEQ.
 func EnvID(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldEnvID, v))
@@ -262,6 +267,56 @@ func FinishedAtNotNil() predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldNotNull(FieldFinishedAt))
 }
 
+// QueuedAtEQ applies the EQ predicate on the "queued_at" field.
+func QueuedAtEQ(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldQueuedAt, v))
+}
+
+// QueuedAtNEQ applies the NEQ predicate on the "queued_at" field.
+func QueuedAtNEQ(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldQueuedAt, v))
+}
+
+// QueuedAtIn applies the In predicate on the "queued_at" field.
+func QueuedAtIn(vs ...time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldQueuedAt, vs...))
+}
+
+// QueuedAtNotIn applies the NotIn predicate on the "queued_at" field.
+func QueuedAtNotIn(vs ...time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldQueuedAt, vs...))
+}
+
+// QueuedAtGT applies the GT predicate on the "queued_at" field.
+func QueuedAtGT(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldQueuedAt, v))
+}
+
+// QueuedAtGTE applies the GTE predicate on the "queued_at" field.
+func QueuedAtGTE(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldQueuedAt, v))
+}
+
+// QueuedAtLT applies the LT predicate on the "queued_at" field.
+func QueuedAtLT(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldQueuedAt, v))
+}
+
+// QueuedAtLTE applies the LTE predicate on the "queued_at" field.
+func QueuedAtLTE(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldQueuedAt, v))
+}
+
+// QueuedAtIsNil applies the IsNil predicate on the "queued_at" field.
+func QueuedAtIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldQueuedAt))
+}
+
+// QueuedAtNotNil applies the NotNil predicate on the "queued_at" field.
+func QueuedAtNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldQueuedAt))
+}
+
 // EnvIDEQ applies the EQ predicate on the "env_id" field.
 func EnvIDEQ(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldEnvID, v))
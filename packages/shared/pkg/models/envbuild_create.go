@@ -68,6 +68,20 @@ func (ebc *EnvBuildCreate) SetNillableFinishedAt(t *time.Time) *EnvBuildCreate {
 	return ebc
 }
 
+// SetQueuedAt sets the "queued_at" field.
+func (ebc *EnvBuildCreate) SetQueuedAt(t time.Time) *EnvBuildCreate {
+	ebc.mutation.SetQueuedAt(t)
+	return ebc
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableQueuedAt(t *time.Time) *EnvBuildCreate {
+	if t != nil {
+		ebc.SetQueuedAt(*t)
+	}
+	return ebc
+}
+
 // SetEnvID sets the "env_id" field.
 func (ebc *EnvBuildCreate) SetEnvID(s string) *EnvBuildCreate {
 	ebc.mutation.SetEnvID(s)
@@ -376,6 +390,10 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_spec.SetField(envbuild.FieldFinishedAt, field.TypeTime, value)
 		_node.FinishedAt = &value
 	}
+	if value, ok := ebc.mutation.QueuedAt(); ok {
+		_spec.SetField(envbuild.FieldQueuedAt, field.TypeTime, value)
+		_node.QueuedAt = &value
+	}
 	if value, ok := ebc.mutation.Status(); ok {
 		_spec.SetField(envbuild.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -528,6 +546,24 @@ func (u *EnvBuildUpsert) ClearFinishedAt() *EnvBuildUpsert {
 	return u
 }
 
+// SetQueuedAt sets the "queued_at" field.
+func (u *EnvBuildUpsert) SetQueuedAt(v time.Time) *EnvBuildUpsert {
+	u.Set(envbuild.FieldQueuedAt, v)
+	return u
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateQueuedAt() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldQueuedAt)
+	return u
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (u *EnvBuildUpsert) ClearQueuedAt() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldQueuedAt)
+	return u
+}
+
 // SetEnvID sets the "env_id" field.
 func (u *EnvBuildUpsert) SetEnvID(v string) *EnvBuildUpsert {
 	u.Set(envbuild.FieldEnvID, v)
@@ -836,6 +872,27 @@ func (u *EnvBuildUpsertOne) ClearFinishedAt() *EnvBuildUpsertOne {
 	})
 }
 
+// SetQueuedAt sets the "queued_at" field.
+func (u *EnvBuildUpsertOne) SetQueuedAt(v time.Time) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetQueuedAt(v)
+	})
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateQueuedAt() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateQueuedAt()
+	})
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (u *EnvBuildUpsertOne) ClearQueuedAt() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearQueuedAt()
+	})
+}
+
 // SetEnvID sets the "env_id" field.
 func (u *EnvBuildUpsertOne) SetEnvID(v string) *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
@@ -1348,6 +1405,27 @@ func (u *EnvBuildUpsertBulk) ClearFinishedAt() *EnvBuildUpsertBulk {
 	})
 }
 
+// SetQueuedAt sets the "queued_at" field.
+func (u *EnvBuildUpsertBulk) SetQueuedAt(v time.Time) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetQueuedAt(v)
+	})
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateQueuedAt() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateQueuedAt()
+	})
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (u *EnvBuildUpsertBulk) ClearQueuedAt() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearQueuedAt()
+	})
+}
+
 // SetEnvID sets the "env_id" field.
 func (u *EnvBuildUpsertBulk) SetEnvID(v string) *EnvBuildUpsertBulk {
 	return u.Update(func(s *EnvBuildUpsert) {
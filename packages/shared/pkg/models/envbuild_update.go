@@ -66,6 +66,26 @@ func (ebu *EnvBuildUpdate) ClearFinishedAt() *EnvBuildUpdate {
 	return ebu
 }
 
+// SetQueuedAt sets the "queued_at" field.
+func (ebu *EnvBuildUpdate) SetQueuedAt(t time.Time) *EnvBuildUpdate {
+	ebu.mutation.SetQueuedAt(t)
+	return ebu
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableQueuedAt(t *time.Time) *EnvBuildUpdate {
+	if t != nil {
+		ebu.SetQueuedAt(*t)
+	}
+	return ebu
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (ebu *EnvBuildUpdate) ClearQueuedAt() *EnvBuildUpdate {
+	ebu.mutation.ClearQueuedAt()
+	return ebu
+}
+
 // SetEnvID sets the "env_id" field.
 func (ebu *EnvBuildUpdate) SetEnvID(s string) *EnvBuildUpdate {
 	ebu.mutation.SetEnvID(s)
@@ -403,6 +423,12 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ebu.mutation.FinishedAtCleared() {
 		_spec.ClearField(envbuild.FieldFinishedAt, field.TypeTime)
 	}
+	if value, ok := ebu.mutation.QueuedAt(); ok {
+		_spec.SetField(envbuild.FieldQueuedAt, field.TypeTime, value)
+	}
+	if ebu.mutation.QueuedAtCleared() {
+		_spec.ClearField(envbuild.FieldQueuedAt, field.TypeTime)
+	}
 	if value, ok := ebu.mutation.Status(); ok {
 		_spec.SetField(envbuild.FieldStatus, field.TypeEnum, value)
 	}
@@ -558,6 +584,26 @@ func (ebuo *EnvBuildUpdateOne) ClearFinishedAt() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// SetQueuedAt sets the "queued_at" field.
+func (ebuo *EnvBuildUpdateOne) SetQueuedAt(t time.Time) *EnvBuildUpdateOne {
+	ebuo.mutation.SetQueuedAt(t)
+	return ebuo
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableQueuedAt(t *time.Time) *EnvBuildUpdateOne {
+	if t != nil {
+		ebuo.SetQueuedAt(*t)
+	}
+	return ebuo
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (ebuo *EnvBuildUpdateOne) ClearQueuedAt() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearQueuedAt()
+	return ebuo
+}
+
 // SetEnvID sets the "env_id" field.
 func (ebuo *EnvBuildUpdateOne) SetEnvID(s string) *EnvBuildUpdateOne {
 	ebuo.mutation.SetEnvID(s)
@@ -925,6 +971,12 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 	if ebuo.mutation.FinishedAtCleared() {
 		_spec.ClearField(envbuild.FieldFinishedAt, field.TypeTime)
 	}
+	if value, ok := ebuo.mutation.QueuedAt(); ok {
+		_spec.SetField(envbuild.FieldQueuedAt, field.TypeTime, value)
+	}
+	if ebuo.mutation.QueuedAtCleared() {
+		_spec.ClearField(envbuild.FieldQueuedAt, field.TypeTime)
+	}
 	if value, ok := ebuo.mutation.Status(); ok {
 		_spec.SetField(envbuild.FieldStatus, field.TypeEnum, value)
 	}
@@ -3071,6 +3071,7 @@ type EnvBuildMutation struct {
 	created_at            *time.Time
 	updated_at            *time.Time
 	finished_at           *time.Time
+	queued_at             *time.Time
 	status                *envbuild.Status
 	dockerfile            *string
 	start_cmd             *string
@@ -3321,6 +3322,55 @@ func (m *EnvBuildMutation) ResetFinishedAt() {
 	delete(m.clearedFields, envbuild.FieldFinishedAt)
 }
 
+// SetQueuedAt sets the "queued_at" field.
+func (m *EnvBuildMutation) SetQueuedAt(t time.Time) {
+	m.queued_at = &t
+}
+
+// QueuedAt returns the value of the "queued_at" field in the mutation.
+func (m *EnvBuildMutation) QueuedAt() (r time.Time, exists bool) {
+	v := m.queued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueuedAt returns the old "queued_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldQueuedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueuedAt: %w", err)
+	}
+	return oldValue.QueuedAt, nil
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (m *EnvBuildMutation) ClearQueuedAt() {
+	m.queued_at = nil
+	m.clearedFields[envbuild.FieldQueuedAt] = struct{}{}
+}
+
+// QueuedAtCleared returns if the "queued_at" field was cleared in this mutation.
+func (m *EnvBuildMutation) QueuedAtCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldQueuedAt]
+	return ok
+}
+
+// ResetQueuedAt resets all changes to the "queued_at" field.
+func (m *EnvBuildMutation) ResetQueuedAt() {
+	m.queued_at = nil
+	delete(m.clearedFields, envbuild.FieldQueuedAt)
+}
+
 // SetEnvID sets the "env_id" field.
 func (m *EnvBuildMutation) SetEnvID(s string) {
 	m.env = &s
@@ -4032,7 +4082,7 @@ func (m *EnvBuildMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *EnvBuildMutation) Fields() []string {
-	fields := make([]string, 0, 17)
+	fields := make([]string, 0, 18)
 	if m.created_at != nil {
 		fields = append(fields, envbuild.FieldCreatedAt)
 	}
@@ -4042,6 +4092,9 @@ func (m *EnvBuildMutation) Fields() []string {
 	if m.finished_at != nil {
 		fields = append(fields, envbuild.FieldFinishedAt)
 	}
+	if m.queued_at != nil {
+		fields = append(fields, envbuild.FieldQueuedAt)
+	}
 	if m.env != nil {
 		fields = append(fields, envbuild.FieldEnvID)
 	}
@@ -4098,6 +4151,8 @@ func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
 		return m.UpdatedAt()
 	case envbuild.FieldFinishedAt:
 		return m.FinishedAt()
+	case envbuild.FieldQueuedAt:
+		return m.QueuedAt()
 	case envbuild.FieldEnvID:
 		return m.EnvID()
 	case envbuild.FieldStatus:
@@ -4141,6 +4196,8 @@ func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldUpdatedAt(ctx)
 	case envbuild.FieldFinishedAt:
 		return m.OldFinishedAt(ctx)
+	case envbuild.FieldQueuedAt:
+		return m.OldQueuedAt(ctx)
 	case envbuild.FieldEnvID:
 		return m.OldEnvID(ctx)
 	case envbuild.FieldStatus:
@@ -4199,6 +4256,13 @@ func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetFinishedAt(v)
 		return nil
+	case envbuild.FieldQueuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueuedAt(v)
+		return nil
 	case envbuild.FieldEnvID:
 		v, ok := value.(string)
 		if !ok {
@@ -4381,6 +4445,9 @@ func (m *EnvBuildMutation) ClearedFields() []string {
 	if m.FieldCleared(envbuild.FieldFinishedAt) {
 		fields = append(fields, envbuild.FieldFinishedAt)
 	}
+	if m.FieldCleared(envbuild.FieldQueuedAt) {
+		fields = append(fields, envbuild.FieldQueuedAt)
+	}
 	if m.FieldCleared(envbuild.FieldDockerfile) {
 		fields = append(fields, envbuild.FieldDockerfile)
 	}
@@ -4413,6 +4480,9 @@ func (m *EnvBuildMutation) ClearField(name string) error {
 	case envbuild.FieldFinishedAt:
 		m.ClearFinishedAt()
 		return nil
+	case envbuild.FieldQueuedAt:
+		m.ClearQueuedAt()
+		return nil
 	case envbuild.FieldDockerfile:
 		m.ClearDockerfile()
 		return nil
@@ -4445,6 +4515,9 @@ func (m *EnvBuildMutation) ResetField(name string) error {
 	case envbuild.FieldFinishedAt:
 		m.ResetFinishedAt()
 		return nil
+	case envbuild.FieldQueuedAt:
+		m.ResetQueuedAt()
+		return nil
 	case envbuild.FieldEnvID:
 		m.ResetEnvID()
 		return nil
@@ -4581,6 +4654,8 @@ type SnapshotMutation struct {
 	origin_node_id        *string
 	team_id               *uuid.UUID
 	allow_internet_access *bool
+	network               **schema.SandboxNetworkConfig
+	checkpoint            **schema.SandboxCheckpointConfig
 	clearedFields         map[string]struct{}
 	env                   *string
 	clearedenv            bool
@@ -5102,6 +5177,104 @@ func (m *SnapshotMutation) ResetAllowInternetAccess() {
 	delete(m.clearedFields, snapshot.FieldAllowInternetAccess)
 }
 
+// SetNetwork sets the "network" field.
+func (m *SnapshotMutation) SetNetwork(snc *schema.SandboxNetworkConfig) {
+	m.network = &snc
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *SnapshotMutation) Network() (r *schema.SandboxNetworkConfig, exists bool) {
+	v := m.network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetwork returns the old "network" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldNetwork(ctx context.Context) (v *schema.SandboxNetworkConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+	}
+	return oldValue.Network, nil
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (m *SnapshotMutation) ClearNetwork() {
+	m.network = nil
+	m.clearedFields[snapshot.FieldNetwork] = struct{}{}
+}
+
+// NetworkCleared returns if the "network" field was cleared in this mutation.
+func (m *SnapshotMutation) NetworkCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldNetwork]
+	return ok
+}
+
+// ResetNetwork resets all changes to the "network" field.
+func (m *SnapshotMutation) ResetNetwork() {
+	m.network = nil
+	delete(m.clearedFields, snapshot.FieldNetwork)
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (m *SnapshotMutation) SetCheckpoint(scc *schema.SandboxCheckpointConfig) {
+	m.checkpoint = &scc
+}
+
+// Checkpoint returns the value of the "checkpoint" field in the mutation.
+func (m *SnapshotMutation) Checkpoint() (r *schema.SandboxCheckpointConfig, exists bool) {
+	v := m.checkpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckpoint returns the old "checkpoint" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldCheckpoint(ctx context.Context) (v *schema.SandboxCheckpointConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckpoint: %w", err)
+	}
+	return oldValue.Checkpoint, nil
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (m *SnapshotMutation) ClearCheckpoint() {
+	m.checkpoint = nil
+	m.clearedFields[snapshot.FieldCheckpoint] = struct{}{}
+}
+
+// CheckpointCleared returns if the "checkpoint" field was cleared in this mutation.
+func (m *SnapshotMutation) CheckpointCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldCheckpoint]
+	return ok
+}
+
+// ResetCheckpoint resets all changes to the "checkpoint" field.
+func (m *SnapshotMutation) ResetCheckpoint() {
+	m.checkpoint = nil
+	delete(m.clearedFields, snapshot.FieldCheckpoint)
+}
+
 // ClearEnv clears the "env" edge to the Env entity.
 func (m *SnapshotMutation) ClearEnv() {
 	m.clearedenv = true
@@ -5163,7 +5336,7 @@ func (m *SnapshotMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SnapshotMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 13)
 	if m.created_at != nil {
 		fields = append(fields, snapshot.FieldCreatedAt)
 	}
@@ -5197,6 +5370,12 @@ func (m *SnapshotMutation) Fields() []string {
 	if m.allow_internet_access != nil {
 		fields = append(fields, snapshot.FieldAllowInternetAccess)
 	}
+	if m.network != nil {
+		fields = append(fields, snapshot.FieldNetwork)
+	}
+	if m.checkpoint != nil {
+		fields = append(fields, snapshot.FieldCheckpoint)
+	}
 	return fields
 }
 
@@ -5227,6 +5406,10 @@ func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 		return m.TeamID()
 	case snapshot.FieldAllowInternetAccess:
 		return m.AllowInternetAccess()
+	case snapshot.FieldNetwork:
+		return m.Network()
+	case snapshot.FieldCheckpoint:
+		return m.Checkpoint()
 	}
 	return nil, false
 }
@@ -5258,6 +5441,10 @@ func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldTeamID(ctx)
 	case snapshot.FieldAllowInternetAccess:
 		return m.OldAllowInternetAccess(ctx)
+	case snapshot.FieldNetwork:
+		return m.OldNetwork(ctx)
+	case snapshot.FieldCheckpoint:
+		return m.OldCheckpoint(ctx)
 	}
 	return nil, fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -5344,6 +5531,20 @@ func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAllowInternetAccess(v)
 		return nil
+	case snapshot.FieldNetwork:
+		v, ok := value.(*schema.SandboxNetworkConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
+		return nil
+	case snapshot.FieldCheckpoint:
+		v, ok := value.(*schema.SandboxCheckpointConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckpoint(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -5377,6 +5578,12 @@ func (m *SnapshotMutation) ClearedFields() []string {
 	if m.FieldCleared(snapshot.FieldAllowInternetAccess) {
 		fields = append(fields, snapshot.FieldAllowInternetAccess)
 	}
+	if m.FieldCleared(snapshot.FieldNetwork) {
+		fields = append(fields, snapshot.FieldNetwork)
+	}
+	if m.FieldCleared(snapshot.FieldCheckpoint) {
+		fields = append(fields, snapshot.FieldCheckpoint)
+	}
 	return fields
 }
 
@@ -5394,6 +5601,12 @@ func (m *SnapshotMutation) ClearField(name string) error {
 	case snapshot.FieldAllowInternetAccess:
 		m.ClearAllowInternetAccess()
 		return nil
+	case snapshot.FieldNetwork:
+		m.ClearNetwork()
+		return nil
+	case snapshot.FieldCheckpoint:
+		m.ClearCheckpoint()
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot nullable field %s", name)
 }
@@ -5435,6 +5648,12 @@ func (m *SnapshotMutation) ResetField(name string) error {
 	case snapshot.FieldAllowInternetAccess:
 		m.ResetAllowInternetAccess()
 		return nil
+	case snapshot.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	case snapshot.FieldCheckpoint:
+		m.ResetCheckpoint()
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -77,11 +77,11 @@ func init() {
 	// envbuild.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	envbuild.DefaultUpdatedAt = envbuildDescUpdatedAt.Default.(func() time.Time)
 	// envbuildDescKernelVersion is the schema descriptor for kernel_version field.
-	envbuildDescKernelVersion := envbuildFields[13].Descriptor()
+	envbuildDescKernelVersion := envbuildFields[14].Descriptor()
 	// envbuild.DefaultKernelVersion holds the default value on creation for the kernel_version field.
 	envbuild.DefaultKernelVersion = envbuildDescKernelVersion.Default.(string)
 	// envbuildDescReason is the schema descriptor for reason field.
-	envbuildDescReason := envbuildFields[17].Descriptor()
+	envbuildDescReason := envbuildFields[18].Descriptor()
 	// envbuild.DefaultReason holds the default value on creation for the reason field.
 	envbuild.DefaultReason = envbuildDescReason.Default.(schema.BuildReason)
 	snapshotFields := schema.Snapshot{}.Fields()
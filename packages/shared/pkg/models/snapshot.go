@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -42,6 +43,10 @@ type Snapshot struct {
 	TeamID uuid.UUID `json:"team_id,omitempty"`
 	// AllowInternetAccess holds the value of the "allow_internet_access" field.
 	AllowInternetAccess *bool `json:"allow_internet_access,omitempty"`
+	// Network holds the value of the "network" field.
+	Network *schema.SandboxNetworkConfig `json:"network,omitempty"`
+	// Checkpoint holds the value of the "checkpoint" field.
+	Checkpoint *schema.SandboxCheckpointConfig `json:"checkpoint,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SnapshotQuery when eager-loading is set.
 	Edges        SnapshotEdges `json:"edges"`
@@ -75,7 +80,7 @@ func (*Snapshot) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case snapshot.FieldMetadata:
+		case snapshot.FieldMetadata, snapshot.FieldNetwork, snapshot.FieldCheckpoint:
 			values[i] = new([]byte)
 		case snapshot.FieldEnvSecure, snapshot.FieldAutoPause, snapshot.FieldAllowInternetAccess:
 			values[i] = new(sql.NullBool)
@@ -175,6 +180,22 @@ func (s *Snapshot) assignValues(columns []string, values []any) error {
 				s.AllowInternetAccess = new(bool)
 				*s.AllowInternetAccess = value.Bool
 			}
+		case snapshot.FieldNetwork:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Network); err != nil {
+					return fmt.Errorf("unmarshal field network: %w", err)
+				}
+			}
+		case snapshot.FieldCheckpoint:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field checkpoint", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Checkpoint); err != nil {
+					return fmt.Errorf("unmarshal field checkpoint: %w", err)
+				}
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -250,6 +271,12 @@ func (s *Snapshot) String() string {
 		builder.WriteString("allow_internet_access=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(fmt.Sprintf("%v", s.Network))
+	builder.WriteString(", ")
+	builder.WriteString("checkpoint=")
+	builder.WriteString(fmt.Sprintf("%v", s.Checkpoint))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -36,6 +36,10 @@ const (
 	FieldTeamID = "team_id"
 	// FieldAllowInternetAccess holds the string denoting the allow_internet_access field in the database.
 	FieldAllowInternetAccess = "allow_internet_access"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
+	// FieldCheckpoint holds the string denoting the checkpoint field in the database.
+	FieldCheckpoint = "checkpoint"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
 	// Table holds the table name of the snapshot in the database.
@@ -63,6 +67,8 @@ var Columns = []string{
 	FieldOriginNodeID,
 	FieldTeamID,
 	FieldAllowInternetAccess,
+	FieldNetwork,
+	FieldCheckpoint,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
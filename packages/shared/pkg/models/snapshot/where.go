@@ -527,6 +527,26 @@ func AllowInternetAccessNotNil() predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldNotNull(FieldAllowInternetAccess))
 }
 
+// NetworkIsNil applies the IsNil predicate on the "network" field.
+func NetworkIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldNetwork))
+}
+
+// NetworkNotNil applies the NotNil predicate on the "network" field.
+func NetworkNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldNetwork))
+}
+
+// CheckpointIsNil applies the IsNil predicate on the "checkpoint" field.
+func CheckpointIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldCheckpoint))
+}
+
+// CheckpointNotNil applies the NotNil predicate on the "checkpoint" field.
+func CheckpointNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldCheckpoint))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.Snapshot {
 	return predicate.Snapshot(func(s *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -123,6 +124,18 @@ func (sc *SnapshotCreate) SetNillableAllowInternetAccess(b *bool) *SnapshotCreat
 	return sc
 }
 
+// SetNetwork sets the "network" field.
+func (sc *SnapshotCreate) SetNetwork(snc *schema.SandboxNetworkConfig) *SnapshotCreate {
+	sc.mutation.SetNetwork(snc)
+	return sc
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (sc *SnapshotCreate) SetCheckpoint(scc *schema.SandboxCheckpointConfig) *SnapshotCreate {
+	sc.mutation.SetCheckpoint(scc)
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SnapshotCreate) SetID(u uuid.UUID) *SnapshotCreate {
 	sc.mutation.SetID(u)
@@ -295,6 +308,14 @@ func (sc *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
 		_spec.SetField(snapshot.FieldAllowInternetAccess, field.TypeBool, value)
 		_node.AllowInternetAccess = &value
 	}
+	if value, ok := sc.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+		_node.Network = value
+	}
+	if value, ok := sc.mutation.Checkpoint(); ok {
+		_spec.SetField(snapshot.FieldCheckpoint, field.TypeJSON, value)
+		_node.Checkpoint = value
+	}
 	if nodes := sc.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -491,6 +512,42 @@ func (u *SnapshotUpsert) ClearAllowInternetAccess() *SnapshotUpsert {
 	return u
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsert) SetNetwork(v *schema.SandboxNetworkConfig) *SnapshotUpsert {
+	u.Set(snapshot.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateNetwork() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldNetwork)
+	return u
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsert) ClearNetwork() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldNetwork)
+	return u
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (u *SnapshotUpsert) SetCheckpoint(v *schema.SandboxCheckpointConfig) *SnapshotUpsert {
+	u.Set(snapshot.FieldCheckpoint, v)
+	return u
+}
+
+// UpdateCheckpoint sets the "checkpoint" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateCheckpoint() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldCheckpoint)
+	return u
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (u *SnapshotUpsert) ClearCheckpoint() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldCheckpoint)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -689,6 +746,48 @@ func (u *SnapshotUpsertOne) ClearAllowInternetAccess() *SnapshotUpsertOne {
 	})
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsertOne) SetNetwork(v *schema.SandboxNetworkConfig) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateNetwork() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsertOne) ClearNetwork() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (u *SnapshotUpsertOne) SetCheckpoint(v *schema.SandboxCheckpointConfig) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetCheckpoint(v)
+	})
+}
+
+// UpdateCheckpoint sets the "checkpoint" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateCheckpoint() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateCheckpoint()
+	})
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (u *SnapshotUpsertOne) ClearCheckpoint() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearCheckpoint()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1054,6 +1153,48 @@ func (u *SnapshotUpsertBulk) ClearAllowInternetAccess() *SnapshotUpsertBulk {
 	})
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsertBulk) SetNetwork(v *schema.SandboxNetworkConfig) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateNetwork() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsertBulk) ClearNetwork() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (u *SnapshotUpsertBulk) SetCheckpoint(v *schema.SandboxCheckpointConfig) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetCheckpoint(v)
+	})
+}
+
+// UpdateCheckpoint sets the "checkpoint" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateCheckpoint() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateCheckpoint()
+	})
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (u *SnapshotUpsertBulk) ClearCheckpoint() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearCheckpoint()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -170,6 +171,30 @@ func (su *SnapshotUpdate) ClearAllowInternetAccess() *SnapshotUpdate {
 	return su
 }
 
+// SetNetwork sets the "network" field.
+func (su *SnapshotUpdate) SetNetwork(snc *schema.SandboxNetworkConfig) *SnapshotUpdate {
+	su.mutation.SetNetwork(snc)
+	return su
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (su *SnapshotUpdate) ClearNetwork() *SnapshotUpdate {
+	su.mutation.ClearNetwork()
+	return su
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (su *SnapshotUpdate) SetCheckpoint(scc *schema.SandboxCheckpointConfig) *SnapshotUpdate {
+	su.mutation.SetCheckpoint(scc)
+	return su
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (su *SnapshotUpdate) ClearCheckpoint() *SnapshotUpdate {
+	su.mutation.ClearCheckpoint()
+	return su
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (su *SnapshotUpdate) SetEnv(e *Env) *SnapshotUpdate {
 	return su.SetEnvID(e.ID)
@@ -269,6 +294,18 @@ func (su *SnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.AllowInternetAccessCleared() {
 		_spec.ClearField(snapshot.FieldAllowInternetAccess, field.TypeBool)
 	}
+	if value, ok := su.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+	}
+	if su.mutation.NetworkCleared() {
+		_spec.ClearField(snapshot.FieldNetwork, field.TypeJSON)
+	}
+	if value, ok := su.mutation.Checkpoint(); ok {
+		_spec.SetField(snapshot.FieldCheckpoint, field.TypeJSON, value)
+	}
+	if su.mutation.CheckpointCleared() {
+		_spec.ClearField(snapshot.FieldCheckpoint, field.TypeJSON)
+	}
 	if su.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -462,6 +499,30 @@ func (suo *SnapshotUpdateOne) ClearAllowInternetAccess() *SnapshotUpdateOne {
 	return suo
 }
 
+// SetNetwork sets the "network" field.
+func (suo *SnapshotUpdateOne) SetNetwork(snc *schema.SandboxNetworkConfig) *SnapshotUpdateOne {
+	suo.mutation.SetNetwork(snc)
+	return suo
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (suo *SnapshotUpdateOne) ClearNetwork() *SnapshotUpdateOne {
+	suo.mutation.ClearNetwork()
+	return suo
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (suo *SnapshotUpdateOne) SetCheckpoint(scc *schema.SandboxCheckpointConfig) *SnapshotUpdateOne {
+	suo.mutation.SetCheckpoint(scc)
+	return suo
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (suo *SnapshotUpdateOne) ClearCheckpoint() *SnapshotUpdateOne {
+	suo.mutation.ClearCheckpoint()
+	return suo
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (suo *SnapshotUpdateOne) SetEnv(e *Env) *SnapshotUpdateOne {
 	return suo.SetEnvID(e.ID)
@@ -591,6 +652,18 @@ func (suo *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 	if suo.mutation.AllowInternetAccessCleared() {
 		_spec.ClearField(snapshot.FieldAllowInternetAccess, field.TypeBool)
 	}
+	if value, ok := suo.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+	}
+	if suo.mutation.NetworkCleared() {
+		_spec.ClearField(snapshot.FieldNetwork, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Checkpoint(); ok {
+		_spec.SetField(snapshot.FieldCheckpoint, field.TypeJSON, value)
+	}
+	if suo.mutation.CheckpointCleared() {
+		_spec.ClearField(snapshot.FieldCheckpoint, field.TypeJSON)
+	}
 	if suo.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -39,6 +39,29 @@ func (e *SandboxNotFoundError) Error() string {
 	return "sandbox not found"
 }
 
+func NewErrPortAccessDenied(sandboxId string, port uint64, blocked bool) *PortAccessDeniedError {
+	return &PortAccessDeniedError{
+		SandboxId: sandboxId,
+		Port:      port,
+		Blocked:   blocked,
+	}
+}
+
+type PortAccessDeniedError struct {
+	SandboxId string
+	Port      uint64
+	// Blocked is true when the port can't be accessed at all, otherwise the credentials are missing or invalid.
+	Blocked bool
+}
+
+func (e *PortAccessDeniedError) Error() string {
+	if e.Blocked {
+		return "sandbox port is blocked"
+	}
+
+	return "missing or invalid credentials for sandbox port"
+}
+
 func handler(p *pool.ProxyPool, getDestination func(r *http.Request) (*pool.Destination, error)) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		d, err := getDestination(r)
@@ -76,6 +99,19 @@ func handler(p *pool.ProxyPool, getDestination func(r *http.Request) (*pool.Dest
 			return
 		}
 
+		var accessDeniedErr *PortAccessDeniedError
+		if errors.As(err, &accessDeniedErr) {
+			zap.L().Debug("sandbox port access denied", zap.String("host", r.Host), logger.WithSandboxID(accessDeniedErr.SandboxId), zap.Uint64("port", accessDeniedErr.Port))
+
+			if accessDeniedErr.Blocked {
+				http.Error(w, "Access to the sandbox port is blocked", http.StatusForbidden)
+			} else {
+				http.Error(w, "Missing or invalid credentials for the sandbox port", http.StatusUnauthorized)
+			}
+
+			return
+		}
+
 		if err != nil {
 			zap.L().Error("failed to route request", zap.Error(err), zap.String("host", r.Host))
 			http.Error(w, fmt.Sprintf("Unexpected error when routing request: %s", err), http.StatusInternalServerError)
@@ -83,6 +119,12 @@ func handler(p *pool.ProxyPool, getDestination func(r *http.Request) (*pool.Dest
 			return
 		}
 
+		if protocol := TunnelProtocol(r); protocol != "" && d.TerminateTunnels {
+			serveTunnel(w, r, p, d, protocol)
+
+			return
+		}
+
 		d.RequestLogger.Debug("proxying request")
 
 		ctx := context.WithValue(r.Context(), pool.DestinationContextKey{}, d)
@@ -39,17 +39,7 @@ func newProxyClient(
 		ResponseHeaderTimeout: 0,
 		// TCP configuration
 		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-			conn, err := (&net.Dialer{
-				Timeout:   30 * time.Second, // Connect timeout (no timeout by default)
-				KeepAlive: 20 * time.Second, // Lower than our http keepalives (50 seconds)
-			}).DialContext(ctx, network, addr)
-			if err != nil {
-				return nil, err
-			}
-
-			totalConnsCounter.Add(1)
-
-			return tracking.NewConnection(conn, currentConnsCounter), nil
+			return dial(ctx, network, addr, totalConnsCounter, currentConnsCounter)
 		},
 		DisableCompression: true, // No need to request or manipulate compression
 	}
@@ -72,6 +62,11 @@ func newProxyClient(
 				r.SetURL(t.Url)
 				// We are **not** using SetXForwarded() because servers can sometimes modify the content-location header to be http which might break some customer services.
 				r.Out.Host = r.In.Host
+
+				if t.PathPrefix != "" {
+					stripPathPrefix(r.Out.URL, t.PathPrefix)
+					r.Out.Header.Set(forwardedPrefixHeader, t.PathPrefix)
+				}
 			},
 			ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
 				if r.Host == "" {
@@ -118,6 +113,12 @@ func newProxyClient(
 					return nil
 				}
 
+				if t.PathPrefix != "" {
+					if location := r.Header.Get("Location"); location != "" {
+						r.Header.Set("Location", addPathPrefix(location, t.PathPrefix, r.Request.Host))
+					}
+				}
+
 				if r.StatusCode >= 500 {
 					t.RequestLogger.Error(
 						"Reverse proxy error",
@@ -137,6 +138,20 @@ func newProxyClient(
 	}
 }
 
+func dial(ctx context.Context, network, addr string, totalConnsCounter *atomic.Uint64, currentConnsCounter *atomic.Int64) (net.Conn, error) {
+	conn, err := (&net.Dialer{
+		Timeout:   30 * time.Second, // Connect timeout (no timeout by default)
+		KeepAlive: 20 * time.Second, // Lower than our http keepalives (50 seconds)
+	}).DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	totalConnsCounter.Add(1)
+
+	return tracking.NewConnection(conn, currentConnsCounter), nil
+}
+
 func (p *proxyClient) closeIdleConnections() {
 	p.transport.CloseIdleConnections()
 }
@@ -13,9 +13,13 @@ type Destination struct {
 	Url         *url.URL
 	SandboxId   string
 	SandboxPort uint64
+	// PathPrefix is stripped from the request path and added back to the redirects of the response.
+	PathPrefix string
 	// Should we return the error about closed port if there is a problem with a connection to upstream?
 	DefaultToPortError bool
-	RequestLogger      *zap.Logger
+	// TerminateTunnels connects the tunnels directly to the destination host, otherwise the tunnel upgrade is forwarded.
+	TerminateTunnels bool
+	RequestLogger    *zap.Logger
 	// ConnectionKey is used for identifying which keepalive connections are not the same so we can prevent unintended reuse.
 	// This is evaluated before checking for existing connection to the IP:port pair.
 	ConnectionKey                      string
@@ -0,0 +1,61 @@
+package pool
+
+import (
+	"net/url"
+	"strings"
+)
+
+// forwardedPrefixHeader tells the sandbox service the path prefix stripped by the proxy.
+const forwardedPrefixHeader = "X-Forwarded-Prefix"
+
+// stripPathPrefix removes the routing prefix from the request path, the path stays absolute.
+func stripPathPrefix(u *url.URL, prefix string) {
+	u.Path = ensureLeadingSlash(strings.TrimPrefix(u.Path, prefix))
+
+	if u.RawPath != "" {
+		rawPath, ok := strings.CutPrefix(u.RawPath, prefix)
+		if ok {
+			u.RawPath = ensureLeadingSlash(rawPath)
+		} else {
+			// The encoded path is derived from the path again.
+			u.RawPath = ""
+		}
+	}
+}
+
+// addPathPrefix adds the routing prefix to the redirect location pointing to the same host.
+func addPathPrefix(location string, prefix string, host string) string {
+	u, err := url.Parse(location)
+	if err != nil {
+		return location
+	}
+
+	// Relative references without a leading slash are resolved against the prefixed request path by the client.
+	if u.Host == "" && u.Scheme == "" && !strings.HasPrefix(u.Path, "/") {
+		return location
+	}
+
+	if u.Host != "" && !strings.EqualFold(u.Host, host) {
+		return location
+	}
+
+	// The services aware of the forwarded prefix already include it.
+	if u.Path == prefix || strings.HasPrefix(u.Path, prefix+"/") {
+		return location
+	}
+
+	u.Path = prefix + u.Path
+	if u.RawPath != "" {
+		u.RawPath = prefix + u.RawPath
+	}
+
+	return u.String()
+}
+
+func ensureLeadingSlash(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		return "/" + path
+	}
+
+	return path
+}
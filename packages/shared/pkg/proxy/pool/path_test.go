@@ -0,0 +1,55 @@
+package pool
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripPathPrefix(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		wantPath    string
+		wantRawPath string
+	}{
+		{name: "path", url: "http://e2b.app/sandbox/abc/3000/api/items?x=1", wantPath: "/api/items"},
+		{name: "prefix only", url: "http://e2b.app/sandbox/abc/3000", wantPath: "/"},
+		{name: "trailing slash", url: "http://e2b.app/sandbox/abc/3000/", wantPath: "/"},
+		{name: "encoded path", url: "http://e2b.app/sandbox/abc/3000/a%2Fb", wantPath: "/a/b", wantRawPath: "/a%2Fb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			require.NoError(t, err)
+
+			stripPathPrefix(u, "/sandbox/abc/3000")
+
+			assert.Equal(t, tt.wantPath, u.Path)
+			assert.Equal(t, tt.wantRawPath, u.RawPath)
+		})
+	}
+}
+
+func TestAddPathPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		location string
+		want     string
+	}{
+		{name: "absolute path", location: "/login?next=%2F", want: "/sandbox/abc/3000/login?next=%2F"},
+		{name: "same host", location: "https://e2b.app/login", want: "https://e2b.app/sandbox/abc/3000/login"},
+		{name: "other host", location: "https://github.com/login/oauth", want: "https://github.com/login/oauth"},
+		{name: "relative path", location: "login", want: "login"},
+		{name: "already prefixed", location: "/sandbox/abc/3000/login", want: "/sandbox/abc/3000/login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, addPathPrefix(tt.location, "/sandbox/abc/3000", "e2b.app"))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package pool
 
 import (
+	"context"
+	"net"
 	"sync/atomic"
 	"time"
 
@@ -76,6 +78,11 @@ func (p *ProxyPool) Close(connectionKey string) {
 	})
 }
 
+// Dial opens a connection that is not pooled, it's used for the tunnels. The connection is tracked with the pool connections.
+func (p *ProxyPool) Dial(ctx context.Context, network, addr string) (net.Conn, error) {
+	return dial(ctx, network, addr, &p.totalConnsCounter, &p.currentConnsCounter)
+}
+
 func (p *ProxyPool) TotalConnections() uint64 {
 	return p.totalConnsCounter.Load()
 }
@@ -0,0 +1,132 @@
+package proxy
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"slices"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
+)
+
+// PortVisibility controls who can access the sandbox port through the proxies.
+type PortVisibility string
+
+const (
+	PortVisibilityPublic PortVisibility = "public"
+	// PortVisibilityAccessToken requires the envd access token of the sandbox in the AccessTokenHeader.
+	PortVisibilityAccessToken PortVisibility = "accessToken"
+	// PortVisibilityAPIKey requires an API key of the sandbox team in the APIKeyHeader.
+	PortVisibilityAPIKey  PortVisibility = "apiKey"
+	PortVisibilityBlocked PortVisibility = "blocked"
+)
+
+const (
+	AccessTokenHeader = "X-Access-Token"
+	APIKeyHeader      = "X-API-Key"
+)
+
+var tokenHasher = keys.NewSHA256Hashing()
+
+// PortPolicy contains the access rules of the sandbox ports, the ports without a rule are public for the HTTP requests.
+// The credentials are kept only as hashes, so the policy can be stored in the sandbox catalog.
+type PortPolicy struct {
+	Ports map[uint64]PortVisibility `json:"ports,omitempty"`
+	// AccessTokenHash is the hash of the envd access token of the sandbox, see HashAccessToken.
+	AccessTokenHash string `json:"access_token_hash,omitempty"`
+	// APIKeyHashes are the hashes of the team API keys, in the same format as they are stored in the database.
+	APIKeyHashes []string `json:"api_key_hashes,omitempty"`
+}
+
+func HashAccessToken(token string) string {
+	return tokenHasher.Hash([]byte(token))
+}
+
+// Visibility returns the visibility of the port.
+func (p *PortPolicy) Visibility(port uint64) PortVisibility {
+	if p == nil {
+		return PortVisibilityPublic
+	}
+
+	visibility, ok := p.Ports[port]
+	if !ok {
+		return PortVisibilityPublic
+	}
+
+	return visibility
+}
+
+// AuthorizeTunnel checks if the tunnel request has the credentials required to access the sandbox port.
+// The tunnels always require a credential, the access token of the sandbox or a team API key,
+// the explicit port rules only restrict which of them is accepted.
+func (p *PortPolicy) AuthorizeTunnel(r *http.Request, sandboxID string, port uint64) error {
+	switch p.Visibility(port) {
+	case PortVisibilityPublic:
+		if p.hasAccessToken(r) || p.hasAPIKey(r) {
+			return nil
+		}
+	case PortVisibilityAccessToken:
+		if p.hasAccessToken(r) {
+			return nil
+		}
+	case PortVisibilityAPIKey:
+		if p.hasAPIKey(r) {
+			return nil
+		}
+	case PortVisibilityBlocked:
+		return NewErrPortAccessDenied(sandboxID, port, true)
+	}
+
+	return NewErrPortAccessDenied(sandboxID, port, false)
+}
+
+// Authorize checks if the request has the credentials required to access the sandbox port.
+func (p *PortPolicy) Authorize(r *http.Request, sandboxID string, port uint64) error {
+	switch p.Visibility(port) {
+	case PortVisibilityPublic:
+		return nil
+	case PortVisibilityAccessToken:
+		if p.hasAccessToken(r) {
+			return nil
+		}
+	case PortVisibilityAPIKey:
+		if p.hasAPIKey(r) {
+			return nil
+		}
+	case PortVisibilityBlocked:
+		return NewErrPortAccessDenied(sandboxID, port, true)
+	}
+
+	// Unknown visibility is handled as a port requiring credentials that can't be provided.
+	return NewErrPortAccessDenied(sandboxID, port, false)
+}
+
+// RemoveCredentials removes the credential header required by the port, so it's not passed to the sandbox.
+func (p *PortPolicy) RemoveCredentials(r *http.Request, port uint64) {
+	switch p.Visibility(port) {
+	case PortVisibilityAccessToken:
+		r.Header.Del(AccessTokenHeader)
+	case PortVisibilityAPIKey:
+		r.Header.Del(APIKeyHeader)
+	}
+}
+
+func (p *PortPolicy) hasAccessToken(r *http.Request) bool {
+	if p == nil {
+		return false
+	}
+
+	token := r.Header.Get(AccessTokenHeader)
+
+	return token != "" && p.AccessTokenHash != "" &&
+		subtle.ConstantTimeCompare([]byte(HashAccessToken(token)), []byte(p.AccessTokenHash)) == 1
+}
+
+func (p *PortPolicy) hasAPIKey(r *http.Request) bool {
+	if p == nil {
+		return false
+	}
+
+	hash, err := keys.VerifyKey(keys.ApiKeyPrefix, r.Header.Get(APIKeyHeader))
+
+	return err == nil && slices.Contains(p.APIKeyHashes, hash)
+}
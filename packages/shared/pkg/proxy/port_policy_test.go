@@ -0,0 +1,144 @@
+package proxy
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
+)
+
+func TestPortPolicy_Authorize(t *testing.T) {
+	apiKey, err := keys.GenerateKey(keys.ApiKeyPrefix)
+	require.NoError(t, err)
+
+	policy := &PortPolicy{
+		Ports: map[uint64]PortVisibility{
+			3000: PortVisibilityPublic,
+			4000: PortVisibilityAccessToken,
+			5000: PortVisibilityAPIKey,
+			6000: PortVisibilityBlocked,
+		},
+		AccessTokenHash: HashAccessToken("token"),
+		APIKeyHashes:    []string{apiKey.HashedValue},
+	}
+
+	tests := []struct {
+		name    string
+		port    uint64
+		headers map[string]string
+		allowed bool
+		blocked bool
+	}{
+		{name: "port_without_rule", port: 8080, allowed: true},
+		{name: "public_port", port: 3000, allowed: true},
+		{name: "access_token", port: 4000, headers: map[string]string{AccessTokenHeader: "token"}, allowed: true},
+		{name: "invalid_access_token", port: 4000, headers: map[string]string{AccessTokenHeader: "other"}},
+		{name: "missing_access_token", port: 4000},
+		{name: "api_key", port: 5000, headers: map[string]string{APIKeyHeader: apiKey.PrefixedRawValue}, allowed: true},
+		{name: "unknown_api_key", port: 5000, headers: map[string]string{APIKeyHeader: keys.ApiKeyPrefix + "00ff"}},
+		{name: "access_token_for_api_key_port", port: 5000, headers: map[string]string{AccessTokenHeader: "token"}},
+		{name: "blocked_port", port: 6000, headers: map[string]string{AccessTokenHeader: "token", APIKeyHeader: apiKey.PrefixedRawValue}, blocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+
+			err := policy.Authorize(r, "sandbox", tt.port)
+			if tt.allowed {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var accessDeniedErr *PortAccessDeniedError
+			require.True(t, errors.As(err, &accessDeniedErr))
+			assert.Equal(t, tt.blocked, accessDeniedErr.Blocked)
+			assert.Equal(t, tt.port, accessDeniedErr.Port)
+		})
+	}
+}
+
+func TestPortPolicy_Nil(t *testing.T) {
+	var policy *PortPolicy
+
+	r := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+	require.NoError(t, policy.Authorize(r, "sandbox", 3000))
+}
+
+func TestPortPolicy_RemoveCredentials(t *testing.T) {
+	policy := &PortPolicy{
+		Ports: map[uint64]PortVisibility{
+			4000: PortVisibilityAccessToken,
+			5000: PortVisibilityAPIKey,
+		},
+	}
+
+	tests := []struct {
+		name            string
+		port            uint64
+		keepAccessToken bool
+		keepAPIKey      bool
+	}{
+		{name: "port_without_rule", port: 8080, keepAccessToken: true, keepAPIKey: true},
+		{name: "access_token_port", port: 4000, keepAPIKey: true},
+		{name: "api_key_port", port: 5000, keepAccessToken: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+			r.Header.Set(AccessTokenHeader, "token")
+			r.Header.Set(APIKeyHeader, "key")
+
+			policy.RemoveCredentials(r, tt.port)
+
+			assert.Equal(t, tt.keepAccessToken, r.Header.Get(AccessTokenHeader) != "")
+			assert.Equal(t, tt.keepAPIKey, r.Header.Get(APIKeyHeader) != "")
+		})
+	}
+}
+
+func TestPortPolicy_AuthorizeTunnel(t *testing.T) {
+	apiKey, err := keys.GenerateKey(keys.ApiKeyPrefix)
+	require.NoError(t, err)
+
+	policy := &PortPolicy{
+		Ports: map[uint64]PortVisibility{
+			3000: PortVisibilityPublic,
+			4000: PortVisibilityAccessToken,
+			5000: PortVisibilityAPIKey,
+			6000: PortVisibilityBlocked,
+		},
+		AccessTokenHash: HashAccessToken("token"),
+		APIKeyHashes:    []string{apiKey.HashedValue},
+	}
+
+	withToken := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+	withToken.Header.Set(AccessTokenHeader, "token")
+	withAPIKey := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+	withAPIKey.Header.Set(APIKeyHeader, apiKey.PrefixedRawValue)
+	withoutCredentials := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+
+	require.NoError(t, policy.AuthorizeTunnel(withToken, "sandbox", 5432))
+	require.NoError(t, policy.AuthorizeTunnel(withAPIKey, "sandbox", 5432))
+	require.Error(t, policy.AuthorizeTunnel(withoutCredentials, "sandbox", 5432), "ports without a rule require a credential")
+	require.Error(t, policy.AuthorizeTunnel(withoutCredentials, "sandbox", 3000), "public ports require a credential")
+	require.NoError(t, policy.AuthorizeTunnel(withAPIKey, "sandbox", 3000))
+	require.NoError(t, policy.AuthorizeTunnel(withToken, "sandbox", 4000))
+	require.Error(t, policy.AuthorizeTunnel(withAPIKey, "sandbox", 4000))
+	require.NoError(t, policy.AuthorizeTunnel(withAPIKey, "sandbox", 5000))
+	require.Error(t, policy.AuthorizeTunnel(withToken, "sandbox", 5000))
+	require.Error(t, policy.AuthorizeTunnel(withToken, "sandbox", 6000))
+
+	var nilPolicy *PortPolicy
+	require.Error(t, nilPolicy.AuthorizeTunnel(withToken, "sandbox", 5432), "tunnels to sandboxes without credentials are denied")
+}
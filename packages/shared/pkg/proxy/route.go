@@ -0,0 +1,122 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// SandboxPathPrefix is the path prefix of the path-based routing, the path has the `/sandbox/<sandboxID>/<port>/...` format.
+const SandboxPathPrefix = "/sandbox/"
+
+// The route headers are set by the client proxy, so the orchestrator proxy doesn't have to resolve the route again.
+const (
+	SandboxIDHeader   = "X-E2B-Sandbox-Id"
+	SandboxPortHeader = "X-E2B-Sandbox-Port"
+)
+
+// Route identifies the sandbox port the request is routed to.
+type Route struct {
+	SandboxID string
+	Port      uint64
+	// PathPrefix is stripped from the request path before forwarding it to the sandbox and added back to the redirects.
+	PathPrefix string
+}
+
+// HostnameResolver returns the route of the custom hostname, nil when the hostname isn't registered.
+type HostnameResolver func(ctx context.Context, hostname string) (*Route, error)
+
+// ParseRequest resolves the route of the request from the `port-sandboxID.domain` host,
+// then from the `/sandbox/<sandboxID>/<port>/...` path and at last from the custom hostname, if the resolver is set.
+func ParseRequest(r *http.Request, resolveHostname HostnameResolver) (*Route, error) {
+	sandboxID, port, hostErr := ParseHost(r.Host)
+	if hostErr == nil {
+		return &Route{SandboxID: sandboxID, Port: port}, nil
+	}
+
+	route, err := ParsePath(r.URL.Path)
+	if err == nil {
+		return route, nil
+	}
+
+	var invalidHostErr *InvalidHostError
+	if !errors.As(err, &invalidHostErr) {
+		return nil, err
+	}
+
+	if resolveHostname != nil {
+		route, err = resolveHostname(r.Context(), NormalizeHostname(r.Host))
+		if err != nil {
+			return nil, err
+		}
+
+		if route != nil {
+			return route, nil
+		}
+	}
+
+	return nil, hostErr
+}
+
+// ParsePath parses the `/sandbox/<sandboxID>/<port>/...` path.
+func ParsePath(path string) (*Route, error) {
+	rest, ok := strings.CutPrefix(path, SandboxPathPrefix)
+	if !ok {
+		return nil, &InvalidHostError{}
+	}
+
+	parts := strings.SplitN(rest, "/", 3)
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, &InvalidHostError{}
+	}
+
+	sandboxID := parts[0]
+	port, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, &InvalidSandboxPortError{}
+	}
+
+	return &Route{
+		SandboxID:  sandboxID,
+		Port:       port,
+		PathPrefix: SandboxPathPrefix + sandboxID + "/" + parts[1],
+	}, nil
+}
+
+// SetRouteHeaders replaces the route headers of the request, the route headers sent by the client are never kept.
+func SetRouteHeaders(header http.Header, sandboxID string, port uint64) {
+	header.Set(SandboxIDHeader, sandboxID)
+	header.Set(SandboxPortHeader, strconv.FormatUint(port, 10))
+}
+
+// ParseRouteHeaders returns the route from the route headers and removes them from the request, nil when they are not set.
+func ParseRouteHeaders(header http.Header) (*Route, error) {
+	sandboxID := header.Get(SandboxIDHeader)
+	portString := header.Get(SandboxPortHeader)
+
+	header.Del(SandboxIDHeader)
+	header.Del(SandboxPortHeader)
+
+	if sandboxID == "" && portString == "" {
+		return nil, nil
+	}
+
+	port, err := strconv.ParseUint(portString, 10, 64)
+	if err != nil {
+		return nil, &InvalidSandboxPortError{}
+	}
+
+	return &Route{SandboxID: sandboxID, Port: port}, nil
+}
+
+// NormalizeHostname removes the port from the host and lowercases it.
+func NormalizeHostname(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
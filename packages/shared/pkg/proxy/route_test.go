@@ -0,0 +1,95 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequest(t *testing.T) {
+	resolveHostname := func(_ context.Context, hostname string) (*Route, error) {
+		if hostname == "app.example.com" {
+			return &Route{SandboxID: "isv6ril5xadwn1k9t2jye", Port: 8080}, nil
+		}
+
+		return nil, nil
+	}
+
+	tests := []struct {
+		name      string
+		url       string
+		wantRoute *Route
+		wantErr   error
+	}{
+		{
+			name:      "subdomain",
+			url:       "http://3000-isv6ril5xadwn1k9t2jye.e2b.app/sandbox/other/80/",
+			wantRoute: &Route{SandboxID: "isv6ril5xadwn1k9t2jye", Port: 3000},
+		},
+		{
+			name:      "path",
+			url:       "http://e2b.app/sandbox/isv6ril5xadwn1k9t2jye/3000/callback?code=1",
+			wantRoute: &Route{SandboxID: "isv6ril5xadwn1k9t2jye", Port: 3000, PathPrefix: "/sandbox/isv6ril5xadwn1k9t2jye/3000"},
+		},
+		{
+			name:      "path without trailing slash",
+			url:       "http://e2b.app/sandbox/isv6ril5xadwn1k9t2jye/3000",
+			wantRoute: &Route{SandboxID: "isv6ril5xadwn1k9t2jye", Port: 3000, PathPrefix: "/sandbox/isv6ril5xadwn1k9t2jye/3000"},
+		},
+		{
+			name:    "path with invalid port",
+			url:     "http://e2b.app/sandbox/isv6ril5xadwn1k9t2jye/abc/",
+			wantErr: &InvalidSandboxPortError{},
+		},
+		{
+			name:    "path without port",
+			url:     "http://e2b.app/sandbox/isv6ril5xadwn1k9t2jye",
+			wantErr: &InvalidHostError{},
+		},
+		{
+			name:      "custom hostname",
+			url:       "http://App.example.com:443/callback",
+			wantRoute: &Route{SandboxID: "isv6ril5xadwn1k9t2jye", Port: 8080},
+		},
+		{
+			name:    "unknown hostname",
+			url:     "http://other.example.com/",
+			wantErr: &InvalidHostError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.url, nil)
+
+			route, err := ParseRequest(r, resolveHostname)
+			if tt.wantErr != nil {
+				require.IsType(t, tt.wantErr, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRoute, route)
+		})
+	}
+}
+
+func TestRouteHeaders(t *testing.T) {
+	header := http.Header{}
+
+	route, err := ParseRouteHeaders(header)
+	require.NoError(t, err)
+	assert.Nil(t, route)
+
+	SetRouteHeaders(header, "isv6ril5xadwn1k9t2jye", 3000)
+
+	route, err = ParseRouteHeaders(header)
+	require.NoError(t, err)
+	assert.Equal(t, &Route{SandboxID: "isv6ril5xadwn1k9t2jye", Port: 3000}, route)
+	assert.Empty(t, header.Get(SandboxIDHeader), "route headers must not be forwarded to the sandbox")
+}
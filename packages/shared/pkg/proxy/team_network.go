@@ -0,0 +1,139 @@
+package proxy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The team network headers are set by the orchestrator tunneling the connection from a sandbox of the team network.
+// The client proxy removes them from the client requests, and they are signed with the secret shared by the orchestrators,
+// so they can't be used to bypass the port policy by the clients reaching the orchestrator proxy directly.
+const (
+	TeamNetworkTeamHeader      = "X-E2B-Team-Network-Team"
+	TeamNetworkGroupHeader     = "X-E2B-Team-Network-Group"
+	TeamNetworkTimestampHeader = "X-E2B-Team-Network-Timestamp"
+	TeamNetworkSignatureHeader = "X-E2B-Team-Network-Signature"
+)
+
+// teamNetworkSignatureMaxAge limits how long the signed headers can be replayed, it also covers the clock skew between the nodes.
+const teamNetworkSignatureMaxAge = time.Minute
+
+// DefaultTeamNetworkGroup is the group of the team network sandboxes without a group.
+const DefaultTeamNetworkGroup = "default"
+
+// teamNetworkZone is the zone of the team network names resolved by the API DNS server,
+// the names have the `<name>.<group>.<teamID>.team.` format.
+const teamNetworkZone = "team."
+
+// TeamNetwork identifies the private network of the team sandboxes, only the sandboxes with the same group can reach each other.
+type TeamNetwork struct {
+	TeamID string
+	Group  string
+}
+
+// Equal reports whether both sandboxes are in the same team network, the empty group is the default group.
+func (n TeamNetwork) Equal(other TeamNetwork) bool {
+	return n.TeamID == other.TeamID && n.group() == other.group()
+}
+
+// QueryName returns the name of the sandbox in the team network resolved by the API DNS server.
+func (n TeamNetwork) QueryName(name string) string {
+	return strings.ToLower(name) + "." + n.group() + "." + n.TeamID + "." + teamNetworkZone
+}
+
+func (n TeamNetwork) group() string {
+	if n.Group == "" {
+		return DefaultTeamNetworkGroup
+	}
+
+	return n.Group
+}
+
+// ParseTeamNetworkQueryName parses the `<name>.<group>.<teamID>.team.` name, ok is false when the name isn't in the team network zone.
+func ParseTeamNetworkQueryName(queryName string) (network TeamNetwork, name string, ok bool) {
+	rest, found := strings.CutSuffix(strings.ToLower(queryName), "."+teamNetworkZone)
+	if !found {
+		return TeamNetwork{}, "", false
+	}
+
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return TeamNetwork{}, "", false
+	}
+
+	return TeamNetwork{TeamID: parts[2], Group: parts[1]}, parts[0], true
+}
+
+// SetTeamNetworkHeaders marks the request to the sandbox port as coming from a sandbox of the team network.
+// The headers are signed with the secret shared by the orchestrators, the signature is valid only for the sandbox port.
+func SetTeamNetworkHeaders(header http.Header, network TeamNetwork, sandboxID string, port uint64, secret []byte) error {
+	if len(secret) == 0 {
+		return errors.New("team network secret is not configured")
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	header.Set(TeamNetworkTeamHeader, network.TeamID)
+	header.Set(TeamNetworkGroupHeader, network.group())
+	header.Set(TeamNetworkTimestampHeader, timestamp)
+	header.Set(TeamNetworkSignatureHeader, teamNetworkSignature(secret, network.TeamID, network.group(), sandboxID, port, timestamp))
+
+	return nil
+}
+
+// ParseTeamNetworkHeaders verifies the signature of the team network headers for the sandbox port and removes the headers.
+// It returns the team network of the request, nil when the headers are not set.
+func ParseTeamNetworkHeaders(header http.Header, sandboxID string, port uint64, secret []byte) (*TeamNetwork, error) {
+	teamID := header.Get(TeamNetworkTeamHeader)
+	group := header.Get(TeamNetworkGroupHeader)
+	timestamp := header.Get(TeamNetworkTimestampHeader)
+	signature := header.Get(TeamNetworkSignatureHeader)
+
+	DeleteTeamNetworkHeaders(header)
+
+	if teamID == "" {
+		return nil, nil
+	}
+
+	if len(secret) == 0 {
+		return nil, errors.New("team network secret is not configured")
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team network timestamp '%s'", timestamp)
+	}
+
+	if age := time.Since(time.Unix(unix, 0)); age > teamNetworkSignatureMaxAge || age < -teamNetworkSignatureMaxAge {
+		return nil, fmt.Errorf("team network signature expired %s ago", age)
+	}
+
+	expected := teamNetworkSignature(secret, teamID, group, sandboxID, port, timestamp)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, errors.New("invalid team network signature")
+	}
+
+	return &TeamNetwork{TeamID: teamID, Group: group}, nil
+}
+
+func teamNetworkSignature(secret []byte, teamID, group, sandboxID string, port uint64, timestamp string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strings.Join([]string{teamID, group, sandboxID, strconv.FormatUint(port, 10), timestamp}, "\n")))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// DeleteTeamNetworkHeaders removes the team network headers, the headers sent by the clients are never trusted.
+func DeleteTeamNetworkHeaders(header http.Header) {
+	header.Del(TeamNetworkTeamHeader)
+	header.Del(TeamNetworkGroupHeader)
+	header.Del(TeamNetworkTimestampHeader)
+	header.Del(TeamNetworkSignatureHeader)
+}
@@ -0,0 +1,93 @@
+package proxy
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamNetworkQueryName(t *testing.T) {
+	network := TeamNetwork{TeamID: "0b8a3ded-3489-4c61-b1cf-a4e5b5c3f4a0"}
+
+	queryName := network.QueryName("DB")
+	assert.Equal(t, "db.default.0b8a3ded-3489-4c61-b1cf-a4e5b5c3f4a0.team.", queryName)
+
+	parsed, name, ok := ParseTeamNetworkQueryName(queryName)
+	require.True(t, ok)
+	assert.Equal(t, "db", name)
+	assert.True(t, parsed.Equal(network), "the default group should match the empty group")
+	assert.False(t, parsed.Equal(TeamNetwork{TeamID: network.TeamID, Group: "agents"}))
+}
+
+func TestParseTeamNetworkQueryName_Invalid(t *testing.T) {
+	for _, queryName := range []string{
+		"isv6ril5xadwn1k9t2jye.",
+		"db.team.",
+		"db.default.team.",
+		"a.db.default.0b8a3ded.team.",
+		"db..0b8a3ded.team.",
+	} {
+		_, _, ok := ParseTeamNetworkQueryName(queryName)
+		assert.False(t, ok, queryName)
+	}
+}
+
+func TestParseTeamNetworkHeaders(t *testing.T) {
+	secret := []byte("secret")
+
+	header := http.Header{}
+	network, err := ParseTeamNetworkHeaders(header, "sandbox", 5432, secret)
+	require.NoError(t, err)
+	assert.Nil(t, network)
+
+	require.NoError(t, SetTeamNetworkHeaders(header, TeamNetwork{TeamID: "team", Group: "agents"}, "sandbox", 5432, secret))
+
+	network, err = ParseTeamNetworkHeaders(header, "sandbox", 5432, secret)
+	require.NoError(t, err)
+	require.NotNil(t, network)
+	assert.Equal(t, TeamNetwork{TeamID: "team", Group: "agents"}, *network)
+	assert.Empty(t, header.Get(TeamNetworkTeamHeader), "the headers should be removed")
+	assert.Empty(t, header.Get(TeamNetworkGroupHeader), "the headers should be removed")
+	assert.Empty(t, header.Get(TeamNetworkSignatureHeader), "the headers should be removed")
+}
+
+func TestParseTeamNetworkHeaders_Forged(t *testing.T) {
+	secret := []byte("secret")
+	network := TeamNetwork{TeamID: "team", Group: "agents"}
+
+	tests := []struct {
+		name   string
+		modify func(header http.Header)
+		port   uint64
+		secret []byte
+	}{
+		{name: "unsigned", modify: func(header http.Header) { header.Del(TeamNetworkSignatureHeader) }, port: 5432, secret: secret},
+		{name: "other_team", modify: func(header http.Header) { header.Set(TeamNetworkTeamHeader, "other") }, port: 5432, secret: secret},
+		{name: "other_group", modify: func(header http.Header) { header.Set(TeamNetworkGroupHeader, "default") }, port: 5432, secret: secret},
+		{name: "other_port", modify: func(http.Header) {}, port: 22, secret: secret},
+		{name: "other_secret", modify: func(http.Header) {}, port: 5432, secret: []byte("other")},
+		{name: "no_secret", modify: func(http.Header) {}, port: 5432},
+		{name: "expired", modify: func(header http.Header) {
+			header.Set(TeamNetworkTimestampHeader, strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10))
+		}, port: 5432, secret: secret},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			require.NoError(t, SetTeamNetworkHeaders(header, network, "sandbox", 5432, secret))
+			tt.modify(header)
+
+			parsed, err := ParseTeamNetworkHeaders(header, "sandbox", tt.port, tt.secret)
+			require.Error(t, err)
+			assert.Nil(t, parsed)
+			assert.Empty(t, header.Get(TeamNetworkTeamHeader), "the headers should be removed")
+		})
+	}
+
+	require.Error(t, SetTeamNetworkHeaders(http.Header{}, network, "sandbox", 5432, nil), "the headers can't be signed without the secret")
+}
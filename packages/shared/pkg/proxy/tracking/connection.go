@@ -30,3 +30,8 @@ func (c *Connection) Close() error {
 
 	return nil
 }
+
+// NetConn returns the tracked connection, e.g. for the TCP half-close of the tunnels.
+func (c *Connection) NetConn() net.Conn {
+	return c.Conn
+}
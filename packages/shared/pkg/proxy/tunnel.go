@@ -0,0 +1,298 @@
+package proxy
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/pool"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/template"
+)
+
+// The tunnels are HTTP/1.1 upgrade requests to the sandbox port with one of the tunnel protocols in the Upgrade header.
+// After the 101 Switching Protocols response the connection carries the raw TCP stream,
+// or the UDP datagrams each prefixed with its length as a big-endian uint16.
+const (
+	TunnelProtocolTCP = "e2b-tcp"
+	TunnelProtocolUDP = "e2b-udp"
+)
+
+const maxDatagramSize = 65535
+
+// TunnelProtocol returns the tunnel protocol of the upgrade request, empty when it isn't a tunnel request.
+func TunnelProtocol(r *http.Request) string {
+	if !headerContainsToken(r.Header, "Connection", "upgrade") {
+		return ""
+	}
+
+	switch protocol := strings.ToLower(r.Header.Get("Upgrade")); protocol {
+	case TunnelProtocolTCP, TunnelProtocolUDP:
+		return protocol
+	default:
+		return ""
+	}
+}
+
+// NewTunnelConn sends the tunnel upgrade request over the connection to the proxy and returns the tunneled connection.
+// The header must contain the access token of the sandbox or a team API key, the tunnels always require a credential.
+func NewTunnelConn(conn net.Conn, host string, protocol string, header http.Header) (net.Conn, error) {
+	req, err := http.NewRequest(http.MethodGet, "http://"+host+"/", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tunnel request: %w", err)
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", protocol)
+
+	err = req.Write(conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send tunnel request: %w", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	res, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tunnel response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		res.Body.Close()
+
+		return nil, fmt.Errorf("tunnel was rejected with status %s", res.Status)
+	}
+
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
+
+// WriteDatagram writes the length-prefixed datagram to the UDP tunnel.
+func WriteDatagram(w io.Writer, datagram []byte) error {
+	if len(datagram) > maxDatagramSize {
+		return fmt.Errorf("datagram is too large: %d bytes", len(datagram))
+	}
+
+	frame := make([]byte, 2+len(datagram))
+	binary.BigEndian.PutUint16(frame, uint16(len(datagram)))
+	copy(frame[2:], datagram)
+
+	_, err := w.Write(frame)
+
+	return err
+}
+
+// ReadDatagram reads the length-prefixed datagram from the UDP tunnel, the buffer must fit the largest datagram.
+func ReadDatagram(r io.Reader, buf []byte) (int, error) {
+	var header [2]byte
+	_, err := io.ReadFull(r, header[:])
+	if err != nil {
+		return 0, err
+	}
+
+	size := int(binary.BigEndian.Uint16(header[:]))
+	if size > len(buf) {
+		return 0, fmt.Errorf("datagram of %d bytes doesn't fit the buffer", size)
+	}
+
+	return io.ReadFull(r, buf[:size])
+}
+
+// serveTunnel connects the tunnel request to the destination host and copies the data until one of the sides closes.
+func serveTunnel(w http.ResponseWriter, r *http.Request, p *pool.ProxyPool, d *pool.Destination, protocol string) {
+	network := "tcp"
+	if protocol == TunnelProtocolUDP {
+		network = "udp"
+	}
+
+	upstream, err := p.Dial(r.Context(), network, d.Url.Host)
+	if err != nil {
+		d.RequestLogger.Debug("failed to connect tunnel", zap.String("protocol", protocol), zap.Error(err))
+
+		if d.DefaultToPortError {
+			err = template.NewPortClosedError(d.SandboxId, r.Host, d.SandboxPort).HandleError(w, r)
+			if err == nil {
+				return
+			}
+		}
+
+		http.Error(w, "Failed to connect tunnel to sandbox", http.StatusBadGateway)
+
+		return
+	}
+	defer upstream.Close()
+
+	client, buf, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		d.RequestLogger.Error("failed to hijack tunnel connection", zap.Error(err))
+		http.Error(w, "Failed to connect tunnel to sandbox", http.StatusInternalServerError)
+
+		return
+	}
+	defer client.Close()
+
+	_, err = fmt.Fprintf(buf, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: %s\r\n\r\n", protocol)
+	if err == nil {
+		err = buf.Flush()
+	}
+	if err != nil {
+		d.RequestLogger.Debug("failed to write tunnel response", zap.Error(err))
+
+		return
+	}
+
+	d.RequestLogger.Debug("tunnel connected", zap.String("protocol", protocol))
+
+	// The client could have sent the data right after the request, so it's read through the buffer.
+	downstream := &bufferedConn{Conn: client, reader: buf.Reader}
+
+	if protocol == TunnelProtocolUDP {
+		err = copyDatagrams(downstream, upstream)
+	} else {
+		err = copyStream(downstream, upstream)
+	}
+
+	if err != nil && !isClosedConnError(err) {
+		d.RequestLogger.Debug("tunnel closed with error", zap.String("protocol", protocol), zap.Error(err))
+	}
+}
+
+// copyStream copies the data in both directions, the half-closed side is propagated to the other connection.
+func copyStream(downstream, upstream net.Conn) error {
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+
+	pipe := func(i int, dst, src net.Conn) {
+		defer wg.Done()
+
+		_, errs[i] = io.Copy(dst, src)
+
+		if cw, ok := unwrapConn(dst).(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		} else {
+			dst.Close()
+		}
+	}
+
+	wg.Add(2)
+	go pipe(0, upstream, downstream)
+	go pipe(1, downstream, upstream)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// copyDatagrams frames the UDP datagrams from the upstream and unframes the datagrams from the downstream.
+func copyDatagrams(downstream, upstream net.Conn) error {
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		// The UDP connection doesn't end on its own, it's closed when the client closes the tunnel.
+		defer upstream.Close()
+
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, err := ReadDatagram(downstream, buf)
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					errs[0] = err
+				}
+
+				return
+			}
+
+			_, err = upstream.Write(buf[:n])
+			if err != nil {
+				errs[0] = err
+
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		defer downstream.Close()
+
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, err := upstream.Read(buf)
+			if err != nil {
+				errs[1] = err
+
+				return
+			}
+
+			err = WriteDatagram(downstream, buf[:n])
+			if err != nil {
+				errs[1] = err
+
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func isClosedConnError(err error) bool {
+	return errors.Is(err, net.ErrClosed)
+}
+
+// bufferedConn reads the data already buffered when the connection was upgraded first.
+type bufferedConn struct {
+	net.Conn
+
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+func (c *bufferedConn) CloseWrite() error {
+	if cw, ok := unwrapConn(c.Conn).(interface{ CloseWrite() error }); ok {
+		return cw.CloseWrite()
+	}
+
+	return c.Conn.Close()
+}
+
+// unwrapConn returns the underlying connection of the tracked connections, so the TCP half-close can be used.
+func unwrapConn(conn net.Conn) net.Conn {
+	for {
+		switch c := conn.(type) {
+		case *bufferedConn:
+			return c
+		case interface{ NetConn() net.Conn }:
+			conn = c.NetConn()
+		default:
+			return conn
+		}
+	}
+}
+
+func headerContainsToken(header http.Header, name string, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, part := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
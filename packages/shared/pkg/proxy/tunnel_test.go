@@ -0,0 +1,170 @@
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/pool"
+)
+
+// newTunnelProxies creates the proxy forwarding the tunnels to the proxy terminating them at the backend address.
+func newTunnelProxies(t *testing.T, backendAddr string) (*Proxy, uint) {
+	t.Helper()
+
+	terminating, terminatingPort, err := newTestProxy(t, func(r *http.Request) (*pool.Destination, error) {
+		return &pool.Destination{
+			Url:              &url.URL{Scheme: "http", Host: backendAddr},
+			SandboxId:        "test-sandbox",
+			RequestLogger:    zap.NewNop(),
+			ConnectionKey:    "terminating",
+			TerminateTunnels: true,
+		}, nil
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { terminating.Close() })
+
+	forwarding, forwardingPort, err := newTestProxy(t, func(r *http.Request) (*pool.Destination, error) {
+		return &pool.Destination{
+			Url:           &url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", terminatingPort)},
+			SandboxId:     "test-sandbox",
+			RequestLogger: zap.NewNop(),
+			ConnectionKey: "forwarding",
+		}, nil
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { forwarding.Close() })
+
+	return terminating, forwardingPort
+}
+
+func dialTunnel(t *testing.T, port uint, protocol string) net.Conn {
+	t.Helper()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(t.Context(), "tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	require.NoError(t, err)
+
+	tunnel, err := NewTunnelConn(conn, "5432-test-sandbox.e2b.app", protocol, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { tunnel.Close() })
+
+	return tunnel
+}
+
+func TestTunnelTCP(t *testing.T) {
+	var lisCfg net.ListenConfig
+	listener, err := lisCfg.Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		io.Copy(conn, conn)
+	}()
+
+	terminating, port := newTunnelProxies(t, listener.Addr().String())
+
+	tunnel := dialTunnel(t, port, TunnelProtocolTCP)
+
+	_, err = tunnel.Write([]byte("ping"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(tunnel, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+
+	assert.Equal(t, int64(1), terminating.CurrentPoolConnections(), "the tunnel connection to the backend should be tracked")
+}
+
+func TestTunnelUDP(t *testing.T) {
+	var lisCfg net.ListenConfig
+	backend, err := lisCfg.ListenPacket(t.Context(), "udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer backend.Close()
+
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, addr, err := backend.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			backend.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	_, port := newTunnelProxies(t, backend.LocalAddr().String())
+
+	tunnel := dialTunnel(t, port, TunnelProtocolUDP)
+
+	for _, datagram := range []string{"first", "second"} {
+		require.NoError(t, WriteDatagram(tunnel, []byte(datagram)))
+
+		buf := make([]byte, maxDatagramSize)
+		n, err := ReadDatagram(tunnel, buf)
+		require.NoError(t, err)
+		assert.Equal(t, datagram, string(buf[:n]))
+	}
+}
+
+func TestTunnelClosedPort(t *testing.T) {
+	var lisCfg net.ListenConfig
+	listener, err := lisCfg.Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	// The port is closed before the tunnel is connected.
+	addr := listener.Addr().String()
+	listener.Close()
+
+	_, port := newTunnelProxies(t, addr)
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(t.Context(), "tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = NewTunnelConn(conn, "5432-test-sandbox.e2b.app", TunnelProtocolTCP, nil)
+	require.ErrorContains(t, err, "502")
+}
+
+func TestTunnelProtocol(t *testing.T) {
+	tests := []struct {
+		name       string
+		connection string
+		upgrade    string
+		want       string
+	}{
+		{name: "tcp", connection: "Upgrade", upgrade: "e2b-tcp", want: TunnelProtocolTCP},
+		{name: "udp with keep-alive", connection: "keep-alive, upgrade", upgrade: "E2B-UDP", want: TunnelProtocolUDP},
+		{name: "websocket", connection: "Upgrade", upgrade: "websocket"},
+		{name: "missing connection header", upgrade: "e2b-tcp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+
+			if tt.connection != "" {
+				r.Header.Set("Connection", tt.connection)
+			}
+			r.Header.Set("Upgrade", tt.upgrade)
+
+			assert.Equal(t, tt.want, TunnelProtocol(r))
+		})
+	}
+}
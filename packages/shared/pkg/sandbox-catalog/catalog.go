@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 )
 
 type SandboxInfo struct {
@@ -15,16 +17,37 @@ type SandboxInfo struct {
 
 	SandboxStartedAt        time.Time `json:"sandbox_started_at"`          // when sandbox was started
 	SandboxMaxLengthInHours int64     `json:"sandbox_max_length_in_hours"` // how long can sandbox can possibly run (in hours)
+
+	// PortPolicy is checked by the client proxy before forwarding the request, the orchestrator proxy checks it again.
+	PortPolicy *proxy.PortPolicy `json:"port_policy,omitempty"`
+}
+
+// HostnameInfo maps the custom hostname registered by the team to the sandbox port.
+type HostnameInfo struct {
+	SandboxID string `json:"sandbox_id"`
+	TeamID    string `json:"team_id"`
+	Port      uint64 `json:"port"`
 }
 
 type SandboxesCatalog interface {
 	GetSandbox(ctx context.Context, sandboxID string) (*SandboxInfo, error)
 	StoreSandbox(ctx context.Context, sandboxID string, sandboxInfo *SandboxInfo, expiration time.Duration) error
 	DeleteSandbox(ctx context.Context, sandboxID string, executionID string) error
+
+	GetHostname(ctx context.Context, hostname string) (*HostnameInfo, error)
+	// ClaimHostname stores the hostname only when it's not registered or it's registered by the same team,
+	// the check and the store are atomic. The previous registration of the hostname is returned, nil when there was none.
+	ClaimHostname(ctx context.Context, hostname string, hostnameInfo *HostnameInfo, expiration time.Duration) (*HostnameInfo, error)
+	// DeleteHostname removes the hostname only when it's still mapped to the sandbox.
+	DeleteHostname(ctx context.Context, hostname string, sandboxID string) error
 }
 
 type CatalogProvider string
 
 var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/shared/pkg/sandbox-catalog")
 
-var ErrSandboxNotFound = errors.New("sandbox not found")
+var (
+	ErrSandboxNotFound  = errors.New("sandbox not found")
+	ErrHostnameNotFound = errors.New("hostname not found")
+	ErrHostnameTaken    = errors.New("hostname is registered by another team")
+)
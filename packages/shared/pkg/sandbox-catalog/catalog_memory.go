@@ -9,8 +9,9 @@ import (
 )
 
 type MemorySandboxCatalog struct {
-	cache *ttlcache.Cache[string, *SandboxInfo]
-	mtx   sync.RWMutex
+	cache     *ttlcache.Cache[string, *SandboxInfo]
+	hostnames *ttlcache.Cache[string, *HostnameInfo]
+	mtx       sync.RWMutex
 }
 
 const (
@@ -21,8 +22,12 @@ func NewMemorySandboxesCatalog() SandboxesCatalog {
 	cache := ttlcache.New(ttlcache.WithTTL[string, *SandboxInfo](catalogMemoryLocalCacheTtl), ttlcache.WithDisableTouchOnHit[string, *SandboxInfo]())
 	go cache.Start()
 
+	hostnames := ttlcache.New(ttlcache.WithDisableTouchOnHit[string, *HostnameInfo]())
+	go hostnames.Start()
+
 	return &MemorySandboxCatalog{
-		cache: cache,
+		cache:     cache,
+		hostnames: hostnames,
 	}
 }
 
@@ -77,3 +82,59 @@ func (c *MemorySandboxCatalog) DeleteSandbox(ctx context.Context, sandboxID stri
 	c.cache.Delete(sandboxID)
 	return nil
 }
+
+func (c *MemorySandboxCatalog) GetHostname(ctx context.Context, hostname string) (*HostnameInfo, error) {
+	_, span := tracer.Start(ctx, "sandbox-catalog-get-hostname")
+	defer span.End()
+
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	item := c.hostnames.Get(hostname)
+	if item != nil {
+		return item.Value(), nil
+	}
+
+	return nil, ErrHostnameNotFound
+}
+
+func (c *MemorySandboxCatalog) ClaimHostname(ctx context.Context, hostname string, hostnameInfo *HostnameInfo, expiration time.Duration) (*HostnameInfo, error) {
+	_, span := tracer.Start(ctx, "sandbox-catalog-claim-hostname")
+	defer span.End()
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	var previous *HostnameInfo
+	if item := c.hostnames.Get(hostname); item != nil {
+		previous = item.Value()
+	}
+
+	if previous != nil && previous.TeamID != hostnameInfo.TeamID {
+		return previous, ErrHostnameTaken
+	}
+
+	c.hostnames.Set(hostname, hostnameInfo, expiration)
+	return previous, nil
+}
+
+func (c *MemorySandboxCatalog) DeleteHostname(ctx context.Context, hostname string, sandboxID string) error {
+	_, span := tracer.Start(ctx, "sandbox-catalog-delete-hostname")
+	defer span.End()
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	item := c.hostnames.Get(hostname)
+	if item == nil || item.Value() == nil {
+		return nil
+	}
+
+	// The hostname was registered for a different sandbox in the meantime
+	if item.Value().SandboxID != sandboxID {
+		return nil
+	}
+
+	c.hostnames.Delete(hostname)
+	return nil
+}
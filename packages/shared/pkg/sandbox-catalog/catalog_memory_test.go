@@ -0,0 +1,34 @@
+package sandbox_catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryCatalogClaimHostname(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemorySandboxesCatalog()
+
+	previous, err := c.ClaimHostname(ctx, "app.example.com", &HostnameInfo{SandboxID: "a", TeamID: "team", Port: 80}, time.Minute)
+	if err != nil || previous != nil {
+		t.Fatalf("expected new hostname to be claimed, got %v, %v", previous, err)
+	}
+
+	// The team can move the hostname to another sandbox
+	previous, err = c.ClaimHostname(ctx, "app.example.com", &HostnameInfo{SandboxID: "b", TeamID: "team", Port: 80}, time.Minute)
+	if err != nil || previous == nil || previous.SandboxID != "a" {
+		t.Fatalf("expected hostname to be moved from sandbox a, got %v, %v", previous, err)
+	}
+
+	previous, err = c.ClaimHostname(ctx, "app.example.com", &HostnameInfo{SandboxID: "c", TeamID: "other", Port: 80}, time.Minute)
+	if !errors.Is(err, ErrHostnameTaken) || previous == nil || previous.TeamID != "team" {
+		t.Fatalf("expected hostname to be taken, got %v, %v", previous, err)
+	}
+
+	info, err := c.GetHostname(ctx, "app.example.com")
+	if err != nil || info.SandboxID != "b" {
+		t.Fatalf("expected hostname to stay with sandbox b, got %v, %v", info, err)
+	}
+}
@@ -23,17 +23,22 @@ const (
 )
 
 type RedisSandboxCatalog struct {
-	redisClient redis.UniversalClient
-	cache       *ttlcache.Cache[string, *SandboxInfo]
+	redisClient   redis.UniversalClient
+	cache         *ttlcache.Cache[string, *SandboxInfo]
+	hostnameCache *ttlcache.Cache[string, *HostnameInfo]
 }
 
 func NewRedisSandboxesCatalog(redisClient redis.UniversalClient) *RedisSandboxCatalog {
 	cache := ttlcache.New(ttlcache.WithTTL[string, *SandboxInfo](catalogRedisLocalCacheTtl), ttlcache.WithDisableTouchOnHit[string, *SandboxInfo]())
 	go cache.Start()
 
+	hostnameCache := ttlcache.New(ttlcache.WithTTL[string, *HostnameInfo](catalogRedisLocalCacheTtl), ttlcache.WithDisableTouchOnHit[string, *HostnameInfo]())
+	go hostnameCache.Start()
+
 	return &RedisSandboxCatalog{
-		redisClient: redisClient,
-		cache:       cache,
+		redisClient:   redisClient,
+		cache:         cache,
+		hostnameCache: hostnameCache,
 	}
 }
 
@@ -124,6 +129,129 @@ func (c *RedisSandboxCatalog) DeleteSandbox(ctx context.Context, sandboxID strin
 	return nil
 }
 
+func (c *RedisSandboxCatalog) GetHostname(ctx context.Context, hostname string) (*HostnameInfo, error) {
+	spanCtx, span := tracer.Start(ctx, "sandbox-catalog-get-hostname")
+	defer span.End()
+
+	hostnameInfo := c.hostnameCache.Get(hostname)
+	if hostnameInfo != nil {
+		return hostnameInfo.Value(), nil
+	}
+
+	ctx, ctxCancel := context.WithTimeout(spanCtx, catalogRedisTimeout)
+	defer ctxCancel()
+
+	data, err := c.redisClient.Get(ctx, c.getHostnameKey(hostname)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrHostnameNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get hostname info from redis: %w", err)
+	}
+
+	var info *HostnameInfo
+	err = json.Unmarshal(data, &info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal hostname info: %w", err)
+	}
+
+	c.hostnameCache.Set(hostname, info, catalogRedisLocalCacheTtl)
+
+	return info, nil
+}
+
+// claimHostnameScript stores the hostname info (ARGV[1]) only when the key doesn't exist or it's registered by the team (ARGV[2]).
+// It returns whether the hostname was stored and the previous value of the key.
+var claimHostnameScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current then
+	local info = cjson.decode(current)
+	if info.team_id ~= ARGV[2] then
+		return {0, current}
+	end
+end
+
+if tonumber(ARGV[3]) > 0 then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[3])
+else
+	redis.call('SET', KEYS[1], ARGV[1])
+end
+
+return {1, current}
+`)
+
+func (c *RedisSandboxCatalog) ClaimHostname(ctx context.Context, hostname string, hostnameInfo *HostnameInfo, expiration time.Duration) (*HostnameInfo, error) {
+	spanCtx, span := tracer.Start(ctx, "sandbox-catalog-claim-hostname")
+	defer span.End()
+
+	ctx, ctxCancel := context.WithTimeout(spanCtx, catalogRedisTimeout)
+	defer ctxCancel()
+
+	bytes, err := json.Marshal(*hostnameInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal hostname info: %w", err)
+	}
+
+	res, err := claimHostnameScript.Run(ctx, c.redisClient, []string{c.getHostnameKey(hostname)}, string(bytes), hostnameInfo.TeamID, expiration.Milliseconds()).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim hostname in redis: %w", err)
+	}
+
+	if len(res) != 2 {
+		return nil, fmt.Errorf("unexpected claim hostname result: %v", res)
+	}
+
+	var previous *HostnameInfo
+	if data, ok := res[1].(string); ok {
+		err = json.Unmarshal([]byte(data), &previous)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal hostname info: %w", err)
+		}
+	}
+
+	if stored, _ := res[0].(int64); stored != 1 {
+		return previous, ErrHostnameTaken
+	}
+
+	c.hostnameCache.Set(hostname, hostnameInfo, catalogRedisLocalCacheTtl)
+
+	return previous, nil
+}
+
+func (c *RedisSandboxCatalog) DeleteHostname(ctx context.Context, hostname string, sandboxID string) error {
+	spanCtx, span := tracer.Start(ctx, "sandbox-catalog-delete-hostname")
+	defer span.End()
+
+	ctx, ctxCancel := context.WithTimeout(spanCtx, catalogRedisTimeout)
+	defer ctxCancel()
+
+	data, err := c.redisClient.Get(ctx, c.getHostnameKey(hostname)).Bytes()
+	// If hostname does not exist, we can return early
+	if err != nil {
+		return nil
+	}
+
+	var info *HostnameInfo
+	err = json.Unmarshal(data, &info)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal hostname info: %w", err)
+	}
+
+	// The hostname was registered for a different sandbox in the meantime
+	if info.SandboxID != sandboxID {
+		return nil
+	}
+
+	c.redisClient.Del(ctx, c.getHostnameKey(hostname))
+	c.hostnameCache.Delete(hostname)
+	return nil
+}
+
 func (c *RedisSandboxCatalog) getCatalogKey(sandboxID string) string {
 	return fmt.Sprintf("sandbox:catalog:%s", sandboxID)
 }
+
+func (c *RedisSandboxCatalog) getHostnameKey(hostname string) string {
+	return fmt.Sprintf("sandbox:hostname:%s", hostname)
+}
@@ -32,6 +32,8 @@ func (Snapshot) Fields() []ent.Field {
 		field.String("origin_node_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.UUID("team_id", uuid.UUID{}),
 		field.Bool("allow_internet_access").Nillable().Optional(),
+		field.JSON("network", &SandboxNetworkConfig{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Optional(),
+		field.JSON("checkpoint", &SandboxCheckpointConfig{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Optional(),
 	}
 }
 
@@ -46,3 +48,33 @@ func (Snapshot) Mixin() []ent.Mixin {
 		Mixin{},
 	}
 }
+
+type SandboxNetworkConfig struct {
+	// Egress Egress policy of the sandbox, when set the sandbox can connect only to the allowed destinations
+	Egress *SandboxNetworkEgressConfig `json:"egress,omitempty"`
+	// Ports are the access rules of the sandbox ports, the ports without a rule are public
+	Ports []SandboxPortRule `json:"ports,omitempty"`
+	// TeamNetwork connects the sandbox to the private network of the team sandboxes
+	TeamNetwork *SandboxTeamNetworkConfig `json:"teamNetwork,omitempty"`
+}
+
+type SandboxTeamNetworkConfig struct {
+	Name  string `json:"name,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+type SandboxNetworkEgressConfig struct {
+	AllowedCIDRs   []string `json:"allowedCidrs,omitempty"`
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+	AllowedPorts   []uint32 `json:"allowedPorts,omitempty"`
+}
+
+type SandboxPortRule struct {
+	Port       uint32 `json:"port"`
+	Visibility string `json:"visibility"`
+}
+
+type SandboxCheckpointConfig struct {
+	IntervalSeconds uint32 `json:"intervalSeconds"`
+	Retention       uint32 `json:"retention"`
+}
@@ -13,6 +13,9 @@ const (
 	SnapfileName = "snapfile"
 	MetadataName = "metadata.json"
 
+	SBOMName       = "sbom.json"
+	ProvenanceName = "provenance.json"
+
 	HeaderSuffix = ".header"
 )
 
@@ -62,3 +65,11 @@ func (t TemplateFiles) StorageSnapfilePath() string {
 func (t TemplateFiles) StorageMetadataPath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), MetadataName)
 }
+
+func (t TemplateFiles) StorageSBOMPath() string {
+	return fmt.Sprintf("%s/%s", t.StorageDir(), SBOMName)
+}
+
+func (t TemplateFiles) StorageProvenancePath() string {
+	return fmt.Sprintf("%s/%s", t.StorageDir(), ProvenanceName)
+}
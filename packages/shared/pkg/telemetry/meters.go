@@ -84,6 +84,9 @@ const (
 	SandboxDiskUsedGaugeName  GaugeIntType = "e2b.sandbox.disk.used"
 	SandboxDiskTotalGaugeName GaugeIntType = "e2b.sandbox.disk.total"
 
+	SandboxNetworkIngressGaugeName GaugeIntType = "e2b.sandbox.network.ingress"
+	SandboxNetworkEgressGaugeName  GaugeIntType = "e2b.sandbox.network.egress"
+
 	// Team metrics
 	TeamSandboxRunningGaugeName GaugeIntType = "e2b.team.sandbox.running"
 
@@ -164,23 +167,27 @@ var gaugeFloatUnits = map[GaugeFloatType]string{
 }
 
 var gaugeIntDesc = map[GaugeIntType]string{
-	ApiOrchestratorCountMeterName: "Counter of running orchestrators.",
-	SandboxRamUsedGaugeName:       "Amount of RAM used by the sandbox.",
-	SandboxRamTotalGaugeName:      "Amount of RAM available to the sandbox.",
-	SandboxCpuTotalGaugeName:      "Amount of CPU available to the sandbox.",
-	SandboxDiskUsedGaugeName:      "Amount of disk space used by the sandbox.",
-	SandboxDiskTotalGaugeName:     "Amount of disk space available to the sandbox.",
-	TeamSandboxRunningGaugeName:   "The number of sandboxes running for the team in the interval.",
+	ApiOrchestratorCountMeterName:  "Counter of running orchestrators.",
+	SandboxRamUsedGaugeName:        "Amount of RAM used by the sandbox.",
+	SandboxRamTotalGaugeName:       "Amount of RAM available to the sandbox.",
+	SandboxCpuTotalGaugeName:       "Amount of CPU available to the sandbox.",
+	SandboxDiskUsedGaugeName:       "Amount of disk space used by the sandbox.",
+	SandboxDiskTotalGaugeName:      "Amount of disk space available to the sandbox.",
+	SandboxNetworkIngressGaugeName: "Amount of network traffic received by the sandbox.",
+	SandboxNetworkEgressGaugeName:  "Amount of network traffic sent by the sandbox.",
+	TeamSandboxRunningGaugeName:    "The number of sandboxes running for the team in the interval.",
 }
 
 var gaugeIntUnits = map[GaugeIntType]string{
-	ApiOrchestratorCountMeterName: "{orchestrator}",
-	SandboxRamUsedGaugeName:       "{By}",
-	SandboxRamTotalGaugeName:      "{By}",
-	SandboxCpuTotalGaugeName:      "{count}",
-	SandboxDiskUsedGaugeName:      "{By}",
-	SandboxDiskTotalGaugeName:     "{By}",
-	TeamSandboxRunningGaugeName:   "{sandbox}",
+	ApiOrchestratorCountMeterName:  "{orchestrator}",
+	SandboxRamUsedGaugeName:        "{By}",
+	SandboxRamTotalGaugeName:       "{By}",
+	SandboxCpuTotalGaugeName:       "{count}",
+	SandboxDiskUsedGaugeName:       "{By}",
+	SandboxDiskTotalGaugeName:      "{By}",
+	SandboxNetworkIngressGaugeName: "{By}",
+	SandboxNetworkEgressGaugeName:  "{By}",
+	TeamSandboxRunningGaugeName:    "{sandbox}",
 }
 
 func GetCounter(meter metric.Meter, name CounterType) (metric.Int64Counter, error) {
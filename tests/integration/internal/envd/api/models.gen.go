@@ -13,11 +13,20 @@ const (
 	AccessTokenAuthScopes = "AccessTokenAuth.Scopes"
 )
 
+// Defines values for ArchiveFormat.
+const (
+	TarGz ArchiveFormat = "tar.gz"
+	Zip   ArchiveFormat = "zip"
+)
+
 // Defines values for EntryInfoType.
 const (
 	File EntryInfoType = "file"
 )
 
+// ArchiveFormat Format of the archive
+type ArchiveFormat string
+
 // EntryInfo defines model for EntryInfo.
 type EntryInfo struct {
 	// Name Name of the file
@@ -69,6 +78,21 @@ type Metrics struct {
 	Ts *int64 `json:"ts,omitempty"`
 }
 
+// UploadSession defines model for UploadSession.
+type UploadSession struct {
+	// Id ID of the upload
+	Id string `json:"id"`
+
+	// Offset Number of bytes uploaded, the next chunk must start at this offset
+	Offset int64 `json:"offset"`
+
+	// Path Path the file is created at once the upload is completed
+	Path string `json:"path"`
+
+	// Size Declared size of the file in bytes
+	Size *int64 `json:"size,omitempty"`
+}
+
 // FilePath defines model for FilePath.
 type FilePath = string
 
@@ -78,6 +102,12 @@ type Signature = string
 // SignatureExpiration defines model for SignatureExpiration.
 type SignatureExpiration = int
 
+// UploadID defines model for UploadID.
+type UploadID = string
+
+// UploadOffset defines model for UploadOffset.
+type UploadOffset = int64
+
 // User defines model for User.
 type User = string
 
@@ -87,15 +117,30 @@ type FileNotFound = Error
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
+// InvalidChecksum defines model for InvalidChecksum.
+type InvalidChecksum = Error
+
 // InvalidPath defines model for InvalidPath.
 type InvalidPath = Error
 
+// InvalidUploadChunk defines model for InvalidUploadChunk.
+type InvalidUploadChunk = Error
+
 // InvalidUser defines model for InvalidUser.
 type InvalidUser = Error
 
 // NotEnoughDiskSpace defines model for NotEnoughDiskSpace.
 type NotEnoughDiskSpace = Error
 
+// UploadConflict defines model for UploadConflict.
+type UploadConflict = Error
+
+// UploadNotFound defines model for UploadNotFound.
+type UploadNotFound = Error
+
+// UploadSessionSuccess defines model for UploadSessionSuccess.
+type UploadSessionSuccess = UploadSession
+
 // UploadSuccess defines model for UploadSuccess.
 type UploadSuccess = []EntryInfo
 
@@ -134,6 +179,120 @@ type PostFilesParams struct {
 	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
 }
 
+// GetFilesArchiveParams defines parameters for GetFilesArchive.
+type GetFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+
+	// Format Format of the archive.
+	Format *ArchiveFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PostFilesArchiveParams defines parameters for PostFilesArchive.
+type PostFilesArchiveParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+
+	// Format Format of the archive.
+	Format *ArchiveFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PostFilesUploadsJSONBody defines parameters for PostFilesUploads.
+type PostFilesUploadsJSONBody struct {
+	// Size Size of the file in bytes, if known
+	Size *int64 `json:"size,omitempty"`
+}
+
+// PostFilesUploadsParams defines parameters for PostFilesUploads.
+type PostFilesUploadsParams struct {
+	// Path Path to the file, URL encoded. Can be relative to user's home directory.
+	Path *FilePath `form:"path,omitempty" json:"path,omitempty"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// DeleteFilesUploadsUploadIDParams defines parameters for DeleteFilesUploadsUploadID.
+type DeleteFilesUploadsUploadIDParams struct {
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// GetFilesUploadsUploadIDParams defines parameters for GetFilesUploadsUploadID.
+type GetFilesUploadsUploadIDParams struct {
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// PatchFilesUploadsUploadIDParams defines parameters for PatchFilesUploadsUploadID.
+type PatchFilesUploadsUploadIDParams struct {
+	// Offset Offset in bytes of the chunk in the file.
+	Offset UploadOffset `form:"offset" json:"offset"`
+
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
+// PostFilesUploadsUploadIDCompleteJSONBody defines parameters for PostFilesUploadsUploadIDComplete.
+type PostFilesUploadsUploadIDCompleteJSONBody struct {
+	// Sha256 Hex encoded SHA-256 checksum of the whole file
+	Sha256 string `json:"sha256"`
+}
+
+// PostFilesUploadsUploadIDCompleteParams defines parameters for PostFilesUploadsUploadIDComplete.
+type PostFilesUploadsUploadIDCompleteParams struct {
+	// Username User used for setting the owner, or resolving relative paths.
+	Username User `form:"username" json:"username"`
+
+	// Signature Signature used for file access permission verification.
+	Signature *Signature `form:"signature,omitempty" json:"signature,omitempty"`
+
+	// SignatureExpiration Signature expiration used for defining the expiration time of the signature.
+	SignatureExpiration *SignatureExpiration `form:"signature_expiration,omitempty" json:"signature_expiration,omitempty"`
+}
+
 // PostInitJSONBody defines parameters for PostInit.
 type PostInitJSONBody struct {
 	// AccessToken Access token for secure access to envd service
@@ -152,5 +311,11 @@ type PostInitJSONBody struct {
 // PostFilesMultipartRequestBody defines body for PostFiles for multipart/form-data ContentType.
 type PostFilesMultipartRequestBody PostFilesMultipartBody
 
+// PostFilesUploadsJSONRequestBody defines body for PostFilesUploads for application/json ContentType.
+type PostFilesUploadsJSONRequestBody PostFilesUploadsJSONBody
+
+// PostFilesUploadsUploadIDCompleteJSONRequestBody defines body for PostFilesUploadsUploadIDComplete for application/json ContentType.
+type PostFilesUploadsUploadIDCompleteJSONRequestBody PostFilesUploadsUploadIDCompleteJSONBody
+
 // PostInitJSONRequestBody defines body for PostInit for application/json ContentType.
 type PostInitJSONRequestBody PostInitJSONBody
@@ -0,0 +1,79 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestSandboxFork(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	t.Run("keep source", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c)
+
+		keepSource := true
+		resp, err := c.PostSandboxesSandboxIDForkWithResponse(t.Context(), sbx.SandboxID, api.ForkedSandbox{
+			KeepSource: &keepSource,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		require.NotNil(t, resp.JSON201)
+		require.Len(t, *resp.JSON201, 1)
+
+		fork := (*resp.JSON201)[0]
+		t.Cleanup(func() {
+			utils.TeardownSandbox(t, c, fork.SandboxID)
+		})
+		assert.NotEqual(t, sbx.SandboxID, fork.SandboxID)
+
+		// The source keeps running in the same execution.
+		res, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), sbx.SandboxID, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		require.NotNil(t, res.JSON200)
+		assert.Equal(t, api.Running, res.JSON200.State)
+
+		res, err = c.GetSandboxesSandboxIDWithResponse(t.Context(), fork.SandboxID, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		require.NotNil(t, res.JSON200)
+		assert.Equal(t, api.Running, res.JSON200.State)
+	})
+
+	t.Run("pause source", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c)
+
+		keepSource := false
+		resp, err := c.PostSandboxesSandboxIDForkWithResponse(t.Context(), sbx.SandboxID, api.ForkedSandbox{
+			KeepSource: &keepSource,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		require.NotNil(t, resp.JSON201)
+		require.Len(t, *resp.JSON201, 1)
+
+		fork := (*resp.JSON201)[0]
+		t.Cleanup(func() {
+			utils.TeardownSandbox(t, c, fork.SandboxID)
+		})
+
+		res, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), sbx.SandboxID, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		require.NotNil(t, res.JSON200)
+		assert.Equal(t, api.Paused, res.JSON200.State)
+	})
+
+	t.Run("unknown source", func(t *testing.T) {
+		resp, err := c.PostSandboxesSandboxIDForkWithResponse(t.Context(), "unknown", api.ForkedSandbox{}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
@@ -108,3 +108,43 @@ func TestInternetAccessResumedSbx(t *testing.T) {
 		})
 	}
 }
+
+func TestInternetAccessUpdate(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(30)
+
+	client := setup.GetAPIClient()
+
+	resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID: setup.SandboxTemplateID,
+		Timeout:    &sbxTimeout,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), "Expected status code 201 Created, got %d", resp.StatusCode())
+	require.NotNil(t, resp.JSON201, "Expected non-nil response body")
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+	curlArgs := []string{"--connect-timeout", "3", "--max-time", "5", "-Is", "https://www.gstatic.com/generate_204"}
+
+	err = utils.ExecCommand(t, ctx, resp.JSON201, envdClient, "curl", curlArgs...)
+	require.NoError(t, err, "Expected curl command to succeed before internet access is denied")
+
+	respUpdate, err := client.PatchSandboxesSandboxIDNetworkWithResponse(ctx, resp.JSON201.SandboxID, api.PatchSandboxesSandboxIDNetworkJSONRequestBody{
+		AllowInternetAccess: false,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, respUpdate.StatusCode(), "Expected status code 204 No Content, got %d", respUpdate.StatusCode())
+
+	err = utils.ExecCommand(t, ctx, resp.JSON201, envdClient, "curl", curlArgs...)
+	require.Error(t, err, "Expected curl command to fail after internet access is denied")
+	require.Contains(t, err.Error(), "failed with exit code", "Expected connection failure message")
+
+	respUpdate, err = client.PatchSandboxesSandboxIDNetworkWithResponse(ctx, resp.JSON201.SandboxID, api.PatchSandboxesSandboxIDNetworkJSONRequestBody{
+		AllowInternetAccess: true,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, respUpdate.StatusCode(), "Expected status code 204 No Content, got %d", respUpdate.StatusCode())
+
+	err = utils.ExecCommand(t, ctx, resp.JSON201, envdClient, "curl", curlArgs...)
+	require.NoError(t, err, "Expected curl command to succeed after internet access is allowed again")
+}
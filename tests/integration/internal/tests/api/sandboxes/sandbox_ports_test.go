@@ -0,0 +1,73 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestSandboxPortsUpdate(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(30)
+
+	client := setup.GetAPIClient()
+
+	resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID: setup.SandboxTemplateID,
+		Timeout:    &sbxTimeout,
+		Network: &api.SandboxNetworkConfig{
+			Ports: &[]api.SandboxPortRule{
+				{Port: 8080, Visibility: api.Blocked},
+			},
+		},
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), "Expected status code 201 Created, got %d", resp.StatusCode())
+	require.NotNil(t, resp.JSON201, "Expected non-nil response body")
+
+	testCases := []struct {
+		name           string
+		ports          []api.SandboxPortRule
+		expectedStatus int
+	}{
+		{
+			name:           "api key and public ports",
+			ports:          []api.SandboxPortRule{{Port: 8080, Visibility: api.ApiKey}, {Port: 3000, Visibility: api.Public}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "remove all rules",
+			ports:          []api.SandboxPortRule{},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "access token port in a sandbox without access token",
+			ports:          []api.SandboxPortRule{{Port: 8080, Visibility: api.AccessToken}},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "duplicate port",
+			ports:          []api.SandboxPortRule{{Port: 8080, Visibility: api.Blocked}, {Port: 8080, Visibility: api.Public}},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "envd port",
+			ports:          []api.SandboxPortRule{{Port: 49983, Visibility: api.Blocked}},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			respUpdate, err := client.PatchSandboxesSandboxIDPortsWithResponse(ctx, resp.JSON201.SandboxID, api.PatchSandboxesSandboxIDPortsJSONRequestBody{
+				Ports: tc.ports,
+			}, setup.WithAPIKey())
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, respUpdate.StatusCode(), "Expected status code %d, got %d", tc.expectedStatus, respUpdate.StatusCode())
+		})
+	}
+}
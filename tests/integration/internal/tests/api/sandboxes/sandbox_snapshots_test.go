@@ -0,0 +1,60 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestSandboxSnapshotsEmpty(t *testing.T) {
+	ctx := t.Context()
+	client := setup.GetAPIClient()
+
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	resp, err := client.GetSandboxesSandboxIDSnapshotsWithResponse(ctx, sbx.SandboxID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), "Expected status code 200 OK, got %d", resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+	assert.Empty(t, *resp.JSON200)
+}
+
+func TestSandboxRestoreUnknownSnapshot(t *testing.T) {
+	ctx := t.Context()
+	client := setup.GetAPIClient()
+
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	resp, err := client.PostSandboxesSandboxIDRestoreWithResponse(ctx, sbx.SandboxID, &api.PostSandboxesSandboxIDRestoreParams{
+		SnapshotID: uuid.NewString(),
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode(), "Expected status code 404 Not Found, got %d", resp.StatusCode())
+
+	resp, err = client.PostSandboxesSandboxIDRestoreWithResponse(ctx, sbx.SandboxID, &api.PostSandboxesSandboxIDRestoreParams{
+		SnapshotID: "invalid",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode(), "Expected status code 400 Bad Request, got %d", resp.StatusCode())
+}
+
+func TestSandboxCheckpointInvalidInterval(t *testing.T) {
+	ctx := t.Context()
+	client := setup.GetAPIClient()
+
+	sbxTimeout := int32(30)
+	resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID: setup.SandboxTemplateID,
+		Timeout:    &sbxTimeout,
+		Checkpoint: &api.SandboxCheckpointConfig{Interval: 10},
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode(), "Expected status code 400 Bad Request, got %d", resp.StatusCode())
+}
@@ -0,0 +1,50 @@
+package sandboxes
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestSandboxTeamNetworkName(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(30)
+
+	client := setup.GetAPIClient()
+
+	// The group is unique for the test run, so the name isn't used by the sandboxes of the previous runs.
+	group := fmt.Sprintf("test-%d", time.Now().UnixNano())
+	name := "db"
+
+	createSandbox := func(name string) *api.PostSandboxesResponse {
+		resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+			TemplateID: setup.SandboxTemplateID,
+			Timeout:    &sbxTimeout,
+			Network: &api.SandboxNetworkConfig{
+				TeamNetwork: &api.SandboxTeamNetworkConfig{
+					Name:  &name,
+					Group: &group,
+				},
+			},
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	resp := createSandbox(name)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), "Expected status code 201 Created, got %d", resp.StatusCode())
+	require.NotNil(t, resp.JSON201, "Expected non-nil response body")
+
+	resp = createSandbox(name)
+	require.Equal(t, http.StatusConflict, resp.StatusCode(), "Expected status code 409 Conflict, got %d", resp.StatusCode())
+
+	resp = createSandbox("invalid.name")
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode(), "Expected status code 400 Bad Request, got %d", resp.StatusCode())
+}
@@ -0,0 +1,57 @@
+package api_templates
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestCompileDockerfile(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	resp, err := c.PostV2TemplatesDockerfileWithResponse(t.Context(), api.TemplateDockerfile{
+		Dockerfile: "FROM ubuntu:22.04\nARG NAME\nRUN echo $NAME\nCMD [\"sleep\", \"infinity\"]\n",
+		BuildArgs:  &map[string]string{"NAME": "e2b"},
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+	require.NotNil(t, resp.JSON200)
+
+	assert.Equal(t, "ubuntu:22.04", *resp.JSON200.FromImage)
+	require.Len(t, *resp.JSON200.Steps, 2)
+	assert.Equal(t, "sleep infinity", *resp.JSON200.StartCmd)
+}
+
+func TestCompileDockerfileUnsupportedInstruction(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	resp, err := c.PostV2TemplatesDockerfileWithResponse(t.Context(), api.TemplateDockerfile{
+		Dockerfile: "FROM ubuntu:22.04\nHEALTHCHECK CMD true\n",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	assert.Contains(t, string(resp.Body), "line 2: HEALTHCHECK instruction is not supported")
+}
+
+func TestCompileMultiStageDockerfile(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	resp, err := c.PostV2TemplatesDockerfileWithResponse(t.Context(), api.TemplateDockerfile{
+		Dockerfile: "FROM golang:1.24 AS builder\nRUN go build -o /out/app .\nFROM ubuntu:22.04\nCOPY --from=builder /out/app /usr/local/bin/app\n",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+	require.NotNil(t, resp.JSON200)
+
+	assert.Equal(t, "ubuntu:22.04", *resp.JSON200.FromImage)
+	require.Len(t, *resp.JSON200.Stages, 1)
+	assert.Equal(t, "builder", (*resp.JSON200.Stages)[0].Name)
+
+	require.Len(t, *resp.JSON200.Steps, 1)
+	assert.Equal(t, "builder", *(*resp.JSON200.Steps)[0].FromStage)
+}